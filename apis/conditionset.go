@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	rtime "reconciler.io/runtime/time"
 )
@@ -47,17 +49,30 @@ type ConditionsAccessor interface {
 // that a particular resource might expose.  It also holds the "happy condition"
 // for that resource, which we define to be one of Ready or Succeeded depending
 // on whether it is a Living or Batch process respectively.
+//
+// Additional aggregate conditions, each computed from its own dependent conditions, may be
+// declared with WithAggregate.
 type ConditionSet struct {
 	happyType   string
 	happyReason string
 	dependents  []string
+	aggregates  []aggregateCondition
+	reasons     map[string][]string
+}
+
+// aggregateCondition is a condition whose status is computed from its dependent conditions
+type aggregateCondition struct {
+	conditionType string
+	happyReason   string
+	dependents    []string
 }
 
 // ConditionManager allows a resource to operate on its Conditions using higher
 // order operations.
 type ConditionManager interface {
 	// IsHappy looks at the happy condition and returns true if that condition is
-	// set to true.
+	// set to true. When the ConditionSet declares additional aggregate conditions,
+	// each aggregate condition must also be true.
 	IsHappy() bool
 
 	// GetCondition finds and returns the Condition that matches the ConditionType
@@ -72,14 +87,19 @@ type ConditionManager interface {
 	ClearCondition(t string) error
 
 	// MarkTrue sets the status of t to true, and then marks the happy condition to
-	// true if all dependents are true.
+	// true if all dependents are true. Each additional aggregate condition is marked
+	// true if all of its dependents are true. An aggregate condition with a dependent
+	// that is still False adopts the reason and message of the failing dependent.
 	MarkTrue(t string, reason, messageFormat string, messageA ...interface{})
 
 	// MarkUnknown sets the status of t to Unknown and also sets the happy condition
-	// to Unknown if no other dependent condition is in an error state.
+	// to Unknown if no other dependent condition is in an error state. Otherwise, the
+	// happy condition adopts the reason and message of the failing dependent.
 	MarkUnknown(t string, reason, messageFormat string, messageA ...interface{})
 
-	// MarkFalse sets the status of t and the happy condition to False.
+	// MarkFalse sets the status of t and each aggregate condition depending on t,
+	// including the happy condition, to False. The aggregate conditions adopt the
+	// reason and message of t.
 	MarkFalse(t string, reason, messageFormat string, messageA ...interface{})
 
 	// InitializeConditions updates all Conditions in the ConditionSet to Unknown
@@ -119,19 +139,125 @@ func NewBatchConditionSetWithHappyReason(happyReason string, d ...string) Condit
 // important for the caller. The first ConditionType is the overarching status
 // for that will be used to signal the resources' status is Ready or Succeeded.
 func newConditionSet(happyType, happyReason string, dependents ...string) ConditionSet {
+	return ConditionSet{
+		happyType:   happyType,
+		happyReason: happyReason,
+		dependents:  uniqueDependents(happyType, dependents),
+	}
+}
+
+// uniqueDependents returns the dependents without duplicates or the aggregate condition type
+func uniqueDependents(aggregateType string, dependents []string) []string {
 	var deps []string
 	for _, d := range dependents {
 		// Skip duplicates
-		if d == happyType || contains(deps, d) {
+		if d == aggregateType || contains(deps, d) {
 			continue
 		}
 		deps = append(deps, d)
 	}
-	return ConditionSet{
-		happyType:   happyType,
-		happyReason: happyReason,
-		dependents:  deps,
+	return deps
+}
+
+// WithAggregate returns a copy of the ConditionSet with an additional aggregate condition. Like
+// the happy condition, the aggregate condition is marked True with the happy reason when all of
+// its dependent conditions are True, Unknown when a dependent is Unknown and False when a
+// dependent is False. Each aggregate condition is computed independently, a dependent condition
+// may contribute to any number of aggregate conditions, including the happy condition.
+//
+// The resource is only happy when the happy condition and each aggregate condition are True.
+func (r ConditionSet) WithAggregate(aggregateType, happyReason string, dependents ...string) ConditionSet {
+	// copy to avoid mutating aggregates shared with other condition sets
+	aggregates := make([]aggregateCondition, 0, len(r.aggregates)+1)
+	for _, a := range r.aggregates {
+		if a.conditionType != aggregateType {
+			aggregates = append(aggregates, a)
+		}
+	}
+	r.aggregates = append(aggregates, aggregateCondition{
+		conditionType: aggregateType,
+		happyReason:   happyReason,
+		dependents:    uniqueDependents(aggregateType, dependents),
+	})
+	return r
+}
+
+// aggregateConditions returns the happy condition followed by the additional aggregate conditions
+func (r ConditionSet) aggregateConditions() []aggregateCondition {
+	aggregates := make([]aggregateCondition, 0, len(r.aggregates)+1)
+	aggregates = append(aggregates, aggregateCondition{
+		conditionType: r.happyType,
+		happyReason:   r.happyReason,
+		dependents:    r.dependents,
+	})
+	return append(aggregates, r.aggregates...)
+}
+
+// WithReasons returns a copy of the ConditionSet that constrains the reasons for a condition type
+// to the provided values. When reason validation is enabled for the context used to manage the
+// conditions (see WithConditionReasonValidation), marking the condition type with any other
+// reason panics. Condition types without defined reasons are not constrained.
+//
+// Reasons are only checked for the condition type being marked. Reasons propagated from a
+// dependent condition to the happy condition are not checked.
+func (r ConditionSet) WithReasons(conditionType string, reasons ...string) ConditionSet {
+	// copy to avoid mutating reasons shared with other condition sets
+	allReasons := make(map[string][]string, len(r.reasons)+1)
+	for t, v := range r.reasons {
+		allReasons[t] = v
 	}
+	allReasons[conditionType] = append(append([]string{}, r.reasons[conditionType]...), reasons...)
+	r.reasons = allReasons
+	return r
+}
+
+type conditionReasonValidationKey struct{}
+
+// WithConditionReasonValidation returns a context that enables the validation of condition
+// reasons for ConditionSets defining reasons via WithReasons. Intended for use in tests and
+// development, the testing package enables validation for each test case.
+func WithConditionReasonValidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, conditionReasonValidationKey{}, true)
+}
+
+// IsConditionReasonValidation returns true if the context enables the validation of condition
+// reasons.
+func IsConditionReasonValidation(ctx context.Context) bool {
+	enabled, ok := ctx.Value(conditionReasonValidationKey{}).(bool)
+	return ok && enabled
+}
+
+type conditionFinalizingKey struct{}
+
+// WithConditionFinalizing returns a context that freezes the happy condition for the resource
+// being finalized. Marking a dependent condition updates the dependent condition, but does not
+// recompute the happy condition, avoiding a flapping happy condition as dependent conditions
+// degrade before the resource is deleted. The happy condition may still be marked directly.
+// Additional aggregate conditions are frozen in the same way.
+func WithConditionFinalizing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, conditionFinalizingKey{}, true)
+}
+
+// IsConditionFinalizing returns true if the context freezes the happy condition.
+func IsConditionFinalizing(ctx context.Context) bool {
+	finalizing, ok := ctx.Value(conditionFinalizingKey{}).(bool)
+	return ok && finalizing
+}
+
+type conditionObservedGenerationKey struct{}
+
+// WithConditionObservedGeneration returns a context that stamps each condition set by a
+// ConditionManager with the generation of the resource it reflects. A condition that only
+// changes its observed generation retains its last transition time.
+func WithConditionObservedGeneration(ctx context.Context, generation int64) context.Context {
+	return context.WithValue(ctx, conditionObservedGenerationKey{}, generation)
+}
+
+// RetrieveConditionObservedGeneration returns the generation stamped on conditions, or zero if
+// conditions are not stamped.
+func RetrieveConditionObservedGeneration(ctx context.Context) int64 {
+	generation, _ := ctx.Value(conditionObservedGenerationKey{}).(int64)
+	return generation
 }
 
 func contains(ct []string, t string) bool {
@@ -150,8 +276,12 @@ var _ ConditionManager = (*conditionsImpl)(nil)
 // +k8s:deepcopy-gen=false
 type conditionsImpl struct {
 	ConditionSet
-	accessor ConditionsAccessor
-	now      time.Time
+	accessor           ConditionsAccessor
+	now                time.Time
+	validateReasons    bool
+	finalizing         bool
+	observedGeneration int64
+	log                logr.Logger
 }
 
 // Deprecated: use ManageWithContext
@@ -162,20 +292,27 @@ func (r ConditionSet) Manage(status ConditionsAccessor) ConditionManager {
 }
 
 // Manage creates a ConditionManager from an accessor object using the original
-// ConditionSet as a reference. Status must be a pointer to a struct.
+// ConditionSet as a reference. Status must be a pointer to a struct. Changes to the
+// status of a condition are logged at V(1) with the logger from the context.
 func (r ConditionSet) ManageWithContext(ctx context.Context, status ConditionsAccessor) ConditionManager {
 	return conditionsImpl{
-		accessor:     status,
-		ConditionSet: r,
-		now:          rtime.RetrieveNow(ctx),
+		accessor:           status,
+		ConditionSet:       r,
+		now:                rtime.RetrieveNow(ctx),
+		validateReasons:    IsConditionReasonValidation(ctx),
+		finalizing:         IsConditionFinalizing(ctx),
+		observedGeneration: RetrieveConditionObservedGeneration(ctx),
+		log:                logr.FromContextOrDiscard(ctx),
 	}
 }
 
 // IsHappy looks at the happy condition and returns true if that condition is
-// set to true.
+// set to true. Each additional aggregate condition must also be true.
 func (r conditionsImpl) IsHappy() bool {
-	if c := r.GetCondition(r.happyType); c == nil || !ConditionIsTrue(c) {
-		return false
+	for _, a := range r.aggregateConditions() {
+		if c := r.GetCondition(a.conditionType); c == nil || !ConditionIsTrue(c) {
+			return false
+		}
 	}
 	return true
 }
@@ -197,11 +334,19 @@ func (r conditionsImpl) GetCondition(t string) *metav1.Condition {
 
 // SetCondition sets or updates the Condition on Conditions for Condition.Type.
 // If there is an update, Conditions are stored back sorted.
+//
+// When the ConditionManager was created with a context from WithConditionObservedGeneration, a
+// condition without an ObservedGeneration is stamped with the generation.
 func (r conditionsImpl) SetCondition(new metav1.Condition) {
 	if r.accessor == nil {
 		return
 	}
+	if new.ObservedGeneration == 0 {
+		new.ObservedGeneration = r.observedGeneration
+	}
 	t := new.Type
+	transitioned := true
+	previousStatus := metav1.ConditionStatus("")
 	var conditions []metav1.Condition
 	for _, c := range r.accessor.GetConditions() {
 		if c.Type != t {
@@ -212,22 +357,44 @@ func (r conditionsImpl) SetCondition(new metav1.Condition) {
 			if reflect.DeepEqual(&new, &c) {
 				return
 			}
+			previousStatus = c.Status
+			// Observing a new generation is not a transition.
+			c.ObservedGeneration = new.ObservedGeneration
+			transitioned = !reflect.DeepEqual(&new, &c)
 		}
 	}
-	new.LastTransitionTime = metav1.NewTime(r.now).Rfc3339Copy()
+	if transitioned {
+		new.LastTransitionTime = metav1.NewTime(r.now).Rfc3339Copy()
+	}
+	if previousStatus != new.Status {
+		r.log.V(1).Info("condition transitioned", "action", "Transitioned", "type", t, "status", new.Status, "previousStatus", previousStatus, "reason", new.Reason)
+	}
 	conditions = append(conditions, new)
 	// Sorted for convenience of the consumer, i.e. kubectl.
 	sort.Slice(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
 	r.accessor.SetConditions(conditions)
 }
 
+// checkReason panics if reason validation is enabled and the reason is not valid for the
+// condition type.
+func (r conditionsImpl) checkReason(t, reason string) {
+	if !r.validateReasons {
+		return
+	}
+	valid, ok := r.reasons[t]
+	if !ok || contains(valid, reason) {
+		return
+	}
+	panic(fmt.Errorf("reason %q is not valid for condition %q, expected one of %q", reason, t, valid))
+}
+
 func (r conditionsImpl) isTerminal(t string) bool {
-	for _, cond := range r.dependents {
-		if cond == t {
+	for _, a := range r.aggregateConditions() {
+		if t == a.conditionType || contains(a.dependents, t) {
 			return true
 		}
 	}
-	return t == r.happyType
+	return false
 }
 
 // ClearCondition removes the non terminal condition that matches the ConditionType
@@ -262,6 +429,8 @@ func (r conditionsImpl) ClearCondition(t string) error {
 // MarkTrue sets the status of t to true, and then marks the happy condition to
 // true if all other dependents are also true.
 func (r conditionsImpl) MarkTrue(t string, reason, messageFormat string, messageA ...interface{}) {
+	r.checkReason(t, reason)
+
 	// set the specified condition
 	r.SetCondition(metav1.Condition{
 		Type:    t,
@@ -270,12 +439,29 @@ func (r conditionsImpl) MarkTrue(t string, reason, messageFormat string, message
 		Message: fmt.Sprintf(messageFormat, messageA...),
 	})
 
-	if len(r.dependents) == 0 {
+	if r.finalizing {
+		return
+	}
+
+	for _, a := range r.aggregateConditions() {
+		r.markAggregateTrue(a)
+	}
+}
+
+// markAggregateTrue marks the aggregate condition true if all dependents are true.
+func (r conditionsImpl) markAggregateTrue(a aggregateCondition) {
+	if len(a.dependents) == 0 {
+		return
+	}
+
+	// Failed conditions trump true conditions
+	if failed := r.failedDependent(a); failed != nil {
+		r.markAggregateFailed(a, failed)
 		return
 	}
 
 	// check the dependents.
-	for _, cond := range r.dependents {
+	for _, cond := range a.dependents {
 		c := r.GetCondition(cond)
 		// Failed or Unknown conditions trump true conditions
 		if !ConditionIsTrue(c) {
@@ -283,10 +469,10 @@ func (r conditionsImpl) MarkTrue(t string, reason, messageFormat string, message
 		}
 	}
 
-	// set the happy condition
+	// set the aggregate condition
 	r.SetCondition(metav1.Condition{
-		Type:   r.happyType,
-		Reason: r.happyReason,
+		Type:   a.conditionType,
+		Reason: a.happyReason,
 		Status: metav1.ConditionTrue,
 	})
 }
@@ -294,6 +480,8 @@ func (r conditionsImpl) MarkTrue(t string, reason, messageFormat string, message
 // MarkUnknown sets the status of t to Unknown and also sets the happy condition
 // to Unknown if no other dependent condition is in an error state.
 func (r conditionsImpl) MarkUnknown(t string, reason, messageFormat string, messageA ...interface{}) {
+	r.checkReason(t, reason)
+
 	// set the specified condition
 	r.SetCondition(metav1.Condition{
 		Type:    t,
@@ -302,32 +490,36 @@ func (r conditionsImpl) MarkUnknown(t string, reason, messageFormat string, mess
 		Message: fmt.Sprintf(messageFormat, messageA...),
 	})
 
-	if len(r.dependents) == 0 {
+	if r.finalizing {
+		return
+	}
+
+	for _, a := range r.aggregateConditions() {
+		r.markAggregateUnknown(a, t, reason, messageFormat, messageA...)
+	}
+}
+
+// markAggregateUnknown marks the aggregate condition unknown if t is one of its dependents and
+// no other dependent condition is in an error state.
+func (r conditionsImpl) markAggregateUnknown(a aggregateCondition, t string, reason, messageFormat string, messageA ...interface{}) {
+	// Failed conditions trump Unknown conditions
+	if failed := r.failedDependent(a); failed != nil {
+		r.markAggregateFailed(a, failed)
 		return
 	}
 
 	// check the dependents.
 	isDependent := false
-	for _, cond := range r.dependents {
-		c := r.GetCondition(cond)
-		// Failed conditions trump Unknown conditions
-		if ConditionIsFalse(c) {
-			// Double check that the happy condition is also false.
-			happy := r.GetCondition(r.happyType)
-			if !ConditionIsFalse(happy) {
-				r.MarkFalse(r.happyType, reason, messageFormat, messageA...)
-			}
-			return
-		}
+	for _, cond := range a.dependents {
 		if cond == t {
 			isDependent = true
 		}
 	}
 
 	if isDependent {
-		// set the happy condition, if it is one of our dependent subconditions.
+		// set the aggregate condition, if it is one of our dependent subconditions.
 		r.SetCondition(metav1.Condition{
-			Type:    r.happyType,
+			Type:    a.conditionType,
 			Status:  metav1.ConditionUnknown,
 			Reason:  reason,
 			Message: fmt.Sprintf(messageFormat, messageA...),
@@ -335,12 +527,53 @@ func (r conditionsImpl) MarkUnknown(t string, reason, messageFormat string, mess
 	}
 }
 
+// failedDependent returns the False dependent of the aggregate condition whose reason and message
+// the aggregate condition reflects. When the aggregate condition does not reflect a False dependent,
+// the first False dependent is returned. Nil is returned when no dependent is False.
+func (r conditionsImpl) failedDependent(a aggregateCondition) *metav1.Condition {
+	aggregate := r.GetCondition(a.conditionType)
+	var failed *metav1.Condition
+	for _, cond := range a.dependents {
+		c := r.GetCondition(cond)
+		if !ConditionIsFalse(c) {
+			continue
+		}
+		if ConditionIsFalse(aggregate) && aggregate.Reason == c.Reason && aggregate.Message == c.Message {
+			return c
+		}
+		if failed == nil {
+			failed = c
+		}
+	}
+	return failed
+}
+
+// markAggregateFailed marks the aggregate condition False with the reason and message of the
+// failed dependent condition.
+func (r conditionsImpl) markAggregateFailed(a aggregateCondition, failed *metav1.Condition) {
+	aggregate := r.GetCondition(a.conditionType)
+	if ConditionIsFalse(aggregate) && aggregate.Reason == failed.Reason && aggregate.Message == failed.Message {
+		return
+	}
+	r.SetCondition(metav1.Condition{
+		Type:    a.conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  failed.Reason,
+		Message: failed.Message,
+	})
+}
+
 // MarkFalse sets the status of t and the happy condition to False.
 func (r conditionsImpl) MarkFalse(t string, reason, messageFormat string, messageA ...interface{}) {
+	r.checkReason(t, reason)
+	r.markFalse(t, reason, messageFormat, messageA...)
+}
+
+func (r conditionsImpl) markFalse(t string, reason, messageFormat string, messageA ...interface{}) {
 	types := []string{t}
-	for _, cond := range r.dependents {
-		if cond == t {
-			types = append(types, r.happyType)
+	for _, a := range r.aggregateConditions() {
+		if contains(a.dependents, t) && !r.finalizing {
+			types = append(types, a.conditionType)
 		}
 	}
 
@@ -357,24 +590,26 @@ func (r conditionsImpl) MarkFalse(t string, reason, messageFormat string, messag
 // InitializeConditions updates all Conditions in the ConditionSet to Unknown
 // if not set.
 func (r conditionsImpl) InitializeConditions() {
-	happy := r.GetCondition(r.happyType)
-	if happy == nil {
-		happy = &metav1.Condition{
-			Type:   r.happyType,
-			Status: metav1.ConditionUnknown,
-			Reason: "Initializing",
+	for _, a := range r.aggregateConditions() {
+		aggregate := r.GetCondition(a.conditionType)
+		if aggregate == nil {
+			aggregate = &metav1.Condition{
+				Type:   a.conditionType,
+				Status: metav1.ConditionUnknown,
+				Reason: "Initializing",
+			}
+			r.SetCondition(*aggregate)
+		}
+		// If the aggregate state is true, it implies that all of the terminal
+		// subconditions must be true, so initialize any unset conditions to
+		// true if our aggregate condition is true, otherwise unknown.
+		status := metav1.ConditionUnknown
+		if aggregate.Status == metav1.ConditionTrue {
+			status = metav1.ConditionTrue
+		}
+		for _, t := range a.dependents {
+			r.initializeTerminalCondition(t, "Initializing", status)
 		}
-		r.SetCondition(*happy)
-	}
-	// If the happy state is true, it implies that all of the terminal
-	// subconditions must be true, so initialize any unset conditions to
-	// true if our happy condition is true, otherwise unknown.
-	status := metav1.ConditionUnknown
-	if happy.Status == metav1.ConditionTrue {
-		status = metav1.ConditionTrue
-	}
-	for _, t := range r.dependents {
-		r.initializeTerminalCondition(t, "Initializing", status)
 	}
 }
 
@@ -406,3 +641,20 @@ func ConditionIsFalse(c *metav1.Condition) bool {
 func ConditionIsUnknown(c *metav1.Condition) bool {
 	return !ConditionIsTrue(c) && !ConditionIsFalse(c)
 }
+
+// SummarizeConditions returns a compact, human readable summary of the conditions for logging or
+// a printer column, like `Ready=True` or `Ready=False (ImagePullBackOff)`. Each condition is
+// summarized as its type and status, followed by the reason when the status is not True.
+// Conditions are separated by a comma, in the order given. An empty string is returned when there
+// are no conditions.
+func SummarizeConditions(conditions []metav1.Condition) string {
+	summaries := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		summary := fmt.Sprintf("%s=%s", c.Type, c.Status)
+		if c.Status != metav1.ConditionTrue && c.Reason != "" {
+			summary = fmt.Sprintf("%s (%s)", summary, c.Reason)
+		}
+		summaries = append(summaries, summary)
+	}
+	return strings.Join(summaries, ", ")
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package apis
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rtime "reconciler.io/runtime/time"
 )
 
 func TestConditionStatus(t *testing.T) {
@@ -80,3 +86,572 @@ func TestConditionStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestConditionSet_WithReasons(t *testing.T) {
+	const dependent = "Dependent"
+	condSet := NewLivingConditionSet(dependent).
+		WithReasons(dependent, "Available", "Unavailable")
+
+	tests := []struct {
+		name        string
+		validate    bool
+		mark        func(m ConditionManager)
+		shouldPanic bool
+	}{
+		{
+			name:     "valid reason",
+			validate: true,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+				m.MarkTrue(dependent, "Available", "")
+			},
+		},
+		{
+			name:     "invalid reason",
+			validate: true,
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(dependent, "Typo", "")
+			},
+			shouldPanic: true,
+		},
+		{
+			name:     "invalid reason without validation",
+			validate: false,
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(dependent, "Typo", "")
+			},
+		},
+		{
+			name:     "condition without reasons",
+			validate: true,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(ConditionReady, "Anything", "")
+			},
+		},
+		{
+			name:     "propagated reason is not checked",
+			validate: true,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+				m.MarkUnknown(dependent, "Available", "")
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if tc.validate {
+				ctx = WithConditionReasonValidation(ctx)
+			}
+			defer func() {
+				if r := recover(); (r != nil) != tc.shouldPanic {
+					t.Errorf("unexpected panic = %v, shouldPanic %v", r, tc.shouldPanic)
+				}
+			}()
+			tc.mark(condSet.ManageWithContext(ctx, &Status{}))
+		})
+	}
+}
+
+func TestConditionSet_WithReasons_Copy(t *testing.T) {
+	base := NewLivingConditionSet().WithReasons(ConditionReady, "Ready")
+	_ = base.WithReasons(ConditionReady, "Other")
+
+	ctx := WithConditionReasonValidation(context.TODO())
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic, reasons leaked between condition sets")
+		}
+	}()
+	base.ManageWithContext(ctx, &Status{}).MarkTrue(ConditionReady, "Other", "")
+}
+
+func TestConditionSet_Finalizing(t *testing.T) {
+	const dependent = "Dependent"
+	condSet := NewLivingConditionSet(dependent)
+
+	then := metav1.NewTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Hour))
+
+	ready := func() *Status {
+		return &Status{
+			Conditions: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		finalizing bool
+		mark       func(m ConditionManager)
+		expected   []metav1.Condition
+	}{
+		{
+			name: "dependent false recomputes happy",
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionFalse, Reason: "Unavailable", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "Unavailable", LastTransitionTime: now},
+			},
+		},
+		{
+			name:       "dependent false while finalizing",
+			finalizing: true,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionFalse, Reason: "Unavailable", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		},
+		{
+			name:       "dependent unknown while finalizing",
+			finalizing: true,
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(dependent, "Finalizing", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionUnknown, Reason: "Finalizing", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		},
+		{
+			name:       "dependent true while finalizing",
+			finalizing: true,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+				m.MarkTrue(dependent, "Available", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		},
+		{
+			name:       "happy marked directly while finalizing",
+			finalizing: true,
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(ConditionReady, "Finalizing", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Finalizing", LastTransitionTime: now},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rtime.StashNow(context.TODO(), now.Time)
+			if tc.finalizing {
+				ctx = WithConditionFinalizing(ctx)
+			}
+			status := ready()
+			tc.mark(condSet.ManageWithContext(ctx, status))
+			if diff := cmp.Diff(tc.expected, status.Conditions); diff != "" {
+				t.Errorf("unexpected conditions (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConditionSet_ReasonPropagation(t *testing.T) {
+	const created = "Created"
+	const available = "Available"
+	condSet := NewLivingConditionSet(created, available)
+
+	then := metav1.NewTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Hour))
+
+	ready := func() *Status {
+		return &Status{
+			Conditions: []metav1.Condition{
+				{Type: available, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: created, Status: metav1.ConditionTrue, Reason: "Created", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mark     func(m ConditionManager)
+		expected []metav1.Condition
+	}{
+		{
+			name: "failed dependent surfaces on happy",
+			mark: func(m ConditionManager) {
+				m.MarkFalse(created, "ImagePullBackOff", "image %q not found", "example")
+			},
+			expected: []metav1.Condition{
+				{Type: available, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: created, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", Message: `image "example" not found`, LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", Message: `image "example" not found`, LastTransitionTime: now},
+			},
+		},
+		{
+			name: "recovered dependent yields to remaining failure",
+			mark: func(m ConditionManager) {
+				m.MarkFalse(created, "ImagePullBackOff", "")
+				m.MarkFalse(available, "Unavailable", "")
+				m.MarkTrue(available, "Available", "")
+			},
+			expected: []metav1.Condition{
+				{Type: available, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: now},
+				{Type: created, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", LastTransitionTime: now},
+			},
+		},
+		{
+			name: "unknown dependent does not mask failure",
+			mark: func(m ConditionManager) {
+				m.MarkFalse(created, "ImagePullBackOff", "")
+				m.MarkUnknown(available, "Pending", "")
+			},
+			expected: []metav1.Condition{
+				{Type: available, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+				{Type: created, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "ImagePullBackOff", LastTransitionTime: now},
+			},
+		},
+		{
+			name: "unknown dependent without failure",
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(created, "Pending", "")
+			},
+			expected: []metav1.Condition{
+				{Type: available, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: created, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rtime.StashNow(context.TODO(), now.Time)
+			status := ready()
+			tc.mark(condSet.ManageWithContext(ctx, status))
+			if diff := cmp.Diff(tc.expected, status.Conditions); diff != "" {
+				t.Errorf("unexpected conditions (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConditionSet_Logging(t *testing.T) {
+	const dependent = "Dependent"
+	condSet := NewLivingConditionSet(dependent)
+
+	var lines []string
+	log := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: 1})
+	ctx := logr.NewContext(context.TODO(), log)
+
+	status := &Status{}
+	m := condSet.ManageWithContext(ctx, status)
+	m.MarkFalse(dependent, "ImagePullBackOff", "")
+	// unchanged status is not logged
+	m.MarkFalse(dependent, "ImagePullBackOff", "retrying")
+
+	expected := []string{
+		`"level"=1 "msg"="condition transitioned" "action"="Transitioned" "type"="Dependent" "status"="False" "previousStatus"="" "reason"="ImagePullBackOff"`,
+		`"level"=1 "msg"="condition transitioned" "action"="Transitioned" "type"="Ready" "status"="False" "previousStatus"="" "reason"="ImagePullBackOff"`,
+	}
+	if diff := cmp.Diff(expected, lines); diff != "" {
+		t.Errorf("unexpected log lines (-expected, +actual): %s", diff)
+	}
+}
+
+func TestConditionSet_ObservedGeneration(t *testing.T) {
+	const dependent = "Dependent"
+	condSet := NewLivingConditionSet(dependent)
+
+	then := metav1.NewTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Hour))
+
+	ready := func(generation int64) *Status {
+		return &Status{
+			Conditions: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", ObservedGeneration: generation, LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", ObservedGeneration: generation, LastTransitionTime: then},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		given      *Status
+		generation int64
+		mark       func(m ConditionManager)
+		expected   []metav1.Condition
+	}{
+		{
+			name:  "generation not stamped by default",
+			given: ready(0),
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionFalse, Reason: "Unavailable", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "Unavailable", LastTransitionTime: now},
+			},
+		},
+		{
+			name:       "generation stamped on transition",
+			given:      ready(1),
+			generation: 2,
+			mark: func(m ConditionManager) {
+				m.MarkFalse(dependent, "Unavailable", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionFalse, Reason: "Unavailable", ObservedGeneration: 2, LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "Unavailable", ObservedGeneration: 2, LastTransitionTime: now},
+			},
+		},
+		{
+			name:       "generation stamped on initialized conditions",
+			given:      &Status{},
+			generation: 2,
+			mark: func(m ConditionManager) {
+				m.InitializeConditions()
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionUnknown, Reason: "Initializing", ObservedGeneration: 2, LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Initializing", ObservedGeneration: 2, LastTransitionTime: now},
+			},
+		},
+		{
+			name:       "new generation preserves the last transition time",
+			given:      ready(1),
+			generation: 2,
+			mark: func(m ConditionManager) {
+				m.MarkTrue(dependent, "Available", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", ObservedGeneration: 2, LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", ObservedGeneration: 2, LastTransitionTime: then},
+			},
+		},
+		{
+			name:       "same generation is a no-op",
+			given:      ready(2),
+			generation: 2,
+			mark: func(m ConditionManager) {
+				m.MarkTrue(dependent, "Available", "")
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", ObservedGeneration: 2, LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", ObservedGeneration: 2, LastTransitionTime: then},
+			},
+		},
+		{
+			name:       "explicit observed generation is preserved",
+			given:      ready(1),
+			generation: 2,
+			mark: func(m ConditionManager) {
+				m.SetCondition(metav1.Condition{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", ObservedGeneration: 1})
+			},
+			expected: []metav1.Condition{
+				{Type: dependent, Status: metav1.ConditionTrue, Reason: "Available", ObservedGeneration: 1, LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", ObservedGeneration: 1, LastTransitionTime: then},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rtime.StashNow(context.TODO(), now.Time)
+			if tc.generation != 0 {
+				ctx = WithConditionObservedGeneration(ctx, tc.generation)
+			}
+			status := tc.given
+			tc.mark(condSet.ManageWithContext(ctx, status))
+			if diff := cmp.Diff(tc.expected, status.Conditions); diff != "" {
+				t.Errorf("unexpected conditions (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConditionSet_WithAggregate(t *testing.T) {
+	const (
+		conditionA         = "A"
+		conditionB         = "B"
+		conditionC         = "C"
+		conditionAvailable = "Available"
+	)
+	// B contributes to both Ready and Available
+	condSet := NewLivingConditionSet(conditionA, conditionB).
+		WithAggregate(conditionAvailable, "Available", conditionB, conditionC)
+
+	then := metav1.NewTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Hour))
+
+	happy := func() *Status {
+		return &Status{
+			Conditions: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionAvailable, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: conditionB, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionC, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		given         *Status
+		mark          func(m ConditionManager)
+		expected      []metav1.Condition
+		expectedHappy bool
+	}{
+		{
+			name:  "initialize",
+			given: &Status{},
+			mark: func(m ConditionManager) {
+				m.InitializeConditions()
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: conditionAvailable, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: conditionC, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+			},
+		},
+		{
+			name:  "ready without available",
+			given: &Status{},
+			mark: func(m ConditionManager) {
+				m.InitializeConditions()
+				m.MarkTrue(conditionA, "Done", "")
+				m.MarkTrue(conditionB, "Done", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: now},
+				{Type: conditionAvailable, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: now},
+				{Type: conditionC, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: now},
+			},
+		},
+		{
+			name:  "ready and available",
+			given: &Status{},
+			mark: func(m ConditionManager) {
+				m.InitializeConditions()
+				m.MarkTrue(conditionA, "Done", "")
+				m.MarkTrue(conditionB, "Done", "")
+				m.MarkTrue(conditionC, "Done", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: now},
+				{Type: conditionAvailable, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: now},
+				{Type: conditionC, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: now},
+			},
+			expectedHappy: true,
+		},
+		{
+			name:  "false dependent of available only",
+			given: happy(),
+			mark: func(m ConditionManager) {
+				m.MarkFalse(conditionC, "Failed", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionAvailable, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionC, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: then},
+			},
+		},
+		{
+			name:  "false shared dependent",
+			given: happy(),
+			mark: func(m ConditionManager) {
+				m.MarkFalse(conditionB, "Failed", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionAvailable, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: conditionC, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+			},
+		},
+		{
+			name:  "unknown dependent of ready only",
+			given: happy(),
+			mark: func(m ConditionManager) {
+				m.MarkUnknown(conditionA, "Pending", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+				{Type: conditionAvailable, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: then},
+				{Type: conditionB, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionC, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+			},
+		},
+		{
+			name:  "unknown shared dependent while another is false",
+			given: happy(),
+			mark: func(m ConditionManager) {
+				m.MarkFalse(conditionC, "Failed", "")
+				m.MarkUnknown(conditionB, "Pending", "")
+			},
+			expected: []metav1.Condition{
+				{Type: conditionA, Status: metav1.ConditionTrue, Reason: "Done", LastTransitionTime: then},
+				{Type: conditionAvailable, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: conditionB, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+				{Type: conditionC, Status: metav1.ConditionFalse, Reason: "Failed", LastTransitionTime: now},
+				{Type: ConditionReady, Status: metav1.ConditionUnknown, Reason: "Pending", LastTransitionTime: now},
+			},
+		},
+		{
+			name:  "clear aggregate condition",
+			given: happy(),
+			mark: func(m ConditionManager) {
+				if err := m.ClearCondition(conditionAvailable); err == nil {
+					t.Errorf("expected error clearing terminal condition %q", conditionAvailable)
+				}
+			},
+			expected:      happy().Conditions,
+			expectedHappy: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rtime.StashNow(context.TODO(), now.Time)
+			status := tc.given
+			manager := condSet.ManageWithContext(ctx, status)
+			tc.mark(manager)
+			if diff := cmp.Diff(tc.expected, status.Conditions); diff != "" {
+				t.Errorf("unexpected conditions (-expected, +actual): %s", diff)
+			}
+			if actual := manager.IsHappy(); actual != tc.expectedHappy {
+				t.Errorf("unexpected IsHappy() = %v, expected %v", actual, tc.expectedHappy)
+			}
+		})
+	}
+}
+
+func TestConditionSet_WithAggregate_Copy(t *testing.T) {
+	base := NewLivingConditionSet("A")
+	_ = base.WithAggregate("Available", "Available", "B")
+
+	status := &Status{}
+	base.ManageWithContext(context.TODO(), status).InitializeConditions()
+	for _, c := range status.Conditions {
+		if c.Type == "Available" || c.Type == "B" {
+			t.Errorf("unexpected condition %q, aggregates leaked between condition sets", c.Type)
+		}
+	}
+}
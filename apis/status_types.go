@@ -64,3 +64,36 @@ func (s *Status) GetCondition(t string) *metav1.Condition {
 	}
 	return nil
 }
+
+// GetConditionOrUnknown fetches the condition of the specified type. When the condition is not
+// present, a condition of the type with an Unknown status is returned.
+func (s *Status) GetConditionOrUnknown(t string) metav1.Condition {
+	if cond := s.GetCondition(t); cond != nil {
+		return *cond
+	}
+	return metav1.Condition{
+		Type:   t,
+		Status: metav1.ConditionUnknown,
+	}
+}
+
+// IsConditionTrue returns true if the condition of the specified type has a True status
+func (s *Status) IsConditionTrue(t string) bool {
+	return ConditionIsTrue(s.GetCondition(t))
+}
+
+// IsConditionFalse returns true if the condition of the specified type has a False status
+func (s *Status) IsConditionFalse(t string) bool {
+	return ConditionIsFalse(s.GetCondition(t))
+}
+
+// IsConditionUnknown returns true if the condition of the specified type has an Unknown status,
+// or is not present
+func (s *Status) IsConditionUnknown(t string) bool {
+	return ConditionIsUnknown(s.GetCondition(t))
+}
+
+// Summarize returns a compact, human readable summary of the conditions, see SummarizeConditions
+func (s *Status) Summarize() string {
+	return SummarizeConditions(s.Conditions)
+}
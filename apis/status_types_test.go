@@ -0,0 +1,143 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStatus_GetConditionOrUnknown(t *testing.T) {
+	status := &Status{
+		Conditions: []metav1.Condition{
+			{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+		},
+	}
+
+	if diff := cmp.Diff(metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"}, status.GetConditionOrUnknown("Ready")); diff != "" {
+		t.Errorf("present condition (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(metav1.Condition{Type: "Missing", Status: metav1.ConditionUnknown}, status.GetConditionOrUnknown("Missing")); diff != "" {
+		t.Errorf("missing condition (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(metav1.Condition{Type: "Ready", Status: metav1.ConditionUnknown}, (&Status{}).GetConditionOrUnknown("Ready")); diff != "" {
+		t.Errorf("empty status (-expected, +actual): %s", diff)
+	}
+}
+
+func TestStatus_IsCondition(t *testing.T) {
+	status := &Status{
+		Conditions: []metav1.Condition{
+			{Type: "True", Status: metav1.ConditionTrue},
+			{Type: "False", Status: metav1.ConditionFalse},
+			{Type: "Unknown", Status: metav1.ConditionUnknown},
+		},
+	}
+	tests := []struct {
+		name            string
+		conditionType   string
+		expectedTrue    bool
+		expectedFalse   bool
+		expectedUnknown bool
+	}{
+		{
+			name:          "true",
+			conditionType: "True",
+			expectedTrue:  true,
+		},
+		{
+			name:          "false",
+			conditionType: "False",
+			expectedFalse: true,
+		},
+		{
+			name:            "unknown",
+			conditionType:   "Unknown",
+			expectedUnknown: true,
+		},
+		{
+			name:            "missing",
+			conditionType:   "Missing",
+			expectedUnknown: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if expected, actual := c.expectedTrue, status.IsConditionTrue(c.conditionType); expected != actual {
+				t.Errorf("%s: IsConditionTrue() actually = %v, expected %v", c.name, actual, expected)
+			}
+			if expected, actual := c.expectedFalse, status.IsConditionFalse(c.conditionType); expected != actual {
+				t.Errorf("%s: IsConditionFalse() actually = %v, expected %v", c.name, actual, expected)
+			}
+			if expected, actual := c.expectedUnknown, status.IsConditionUnknown(c.conditionType); expected != actual {
+				t.Errorf("%s: IsConditionUnknown() actually = %v, expected %v", c.name, actual, expected)
+			}
+		})
+	}
+}
+
+func TestStatus_Summarize(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   string
+	}{
+		{
+			name:     "no conditions",
+			expected: "",
+		},
+		{
+			name: "true",
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+			},
+			expected: "Ready=True",
+		},
+		{
+			name: "false with reason",
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ImagePullBackOff"},
+			},
+			expected: "Ready=False (ImagePullBackOff)",
+		},
+		{
+			name: "unknown without reason",
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionUnknown},
+			},
+			expected: "Ready=Unknown",
+		},
+		{
+			name: "multiple conditions",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+				{Type: "Ready", Status: metav1.ConditionUnknown, Reason: "Initializing"},
+			},
+			expected: "Deployed=True, Ready=Unknown (Initializing)",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			status := &Status{Conditions: c.conditions}
+			if expected, actual := c.expected, status.Summarize(); expected != actual {
+				t.Errorf("%s: Summarize() actually = %q, expected %q", c.name, actual, expected)
+			}
+		})
+	}
+}
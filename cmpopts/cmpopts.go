@@ -0,0 +1,170 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmpopts provides go-cmp options for comparing Kubernetes resources. The options are
+// used by the testing package to compare expected and actual values and are equally suitable for
+// detecting changes to resources outside of tests.
+package cmpopts
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+// IgnoreAllUnexported is a cmp.Option that ignores unexported fields in all structs
+var IgnoreAllUnexported = cmp.FilterPath(func(p cmp.Path) bool {
+	// from cmp.IgnoreUnexported with type info removed
+	sf, ok := p.Index(-1).(cmp.StructField)
+	if !ok {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(sf.Name())
+	return !unicode.IsUpper(r)
+}, cmp.Ignore())
+
+// IgnoreLastTransitionTime is a cmp.Option that ignores the lastTransitionTime of conditions
+var IgnoreLastTransitionTime = cmp.FilterPath(func(p cmp.Path) bool {
+	str := p.String()
+	gostr := p.GoString()
+	return strings.HasSuffix(str, "LastTransitionTime") ||
+		strings.HasSuffix(gostr, `["lastTransitionTime"]`)
+}, cmp.Ignore())
+
+// EquateApproxTime returns a cmp.Option that treats metav1.Time and metav1.MicroTime values as
+// equal when they are within the margin of each other. Unlike IgnoreLastTransitionTime the value
+// still matters, which is useful for times computed from the clock, like a lease renewal time. A
+// zero time is only equal to another zero time. The margin must be non-negative.
+//
+// Times held by unstructured resources are strings and are compared exactly.
+func EquateApproxTime(margin time.Duration) cmp.Option {
+	if margin < 0 {
+		panic("margin must be a non-negative number")
+	}
+	within := func(x, y time.Time) bool {
+		if x.IsZero() || y.IsZero() {
+			return x.IsZero() && y.IsZero()
+		}
+		d := x.Sub(y)
+		if d < 0 {
+			d = -d
+		}
+		return d <= margin
+	}
+	// pointers are compared explicitly, otherwise their Equal method takes precedence
+	return cmp.Options{
+		cmp.Comparer(func(x, y metav1.Time) bool {
+			return within(x.Time, y.Time)
+		}),
+		cmp.Comparer(func(x, y *metav1.Time) bool {
+			if x == nil || y == nil {
+				return x == y
+			}
+			return within(x.Time, y.Time)
+		}),
+		cmp.Comparer(func(x, y metav1.MicroTime) bool {
+			return within(x.Time, y.Time)
+		}),
+		cmp.Comparer(func(x, y *metav1.MicroTime) bool {
+			if x == nil || y == nil {
+				return x == y
+			}
+			return within(x.Time, y.Time)
+		}),
+	}
+}
+
+// IgnoreTypeMeta is a cmp.Option that ignores the apiVersion and kind of typed resources
+var IgnoreTypeMeta = cmp.FilterPath(func(p cmp.Path) bool {
+	str := p.String()
+	// only ignore for typed resources, compare TypeMeta values for unstructured
+	return strings.HasSuffix(str, "TypeMeta.APIVersion") ||
+		strings.HasSuffix(str, "TypeMeta.Kind")
+}, cmp.Ignore())
+
+// IgnoreCreationTimestamp is a cmp.Option that ignores the creationTimestamp of resources
+var IgnoreCreationTimestamp = cmp.FilterPath(func(p cmp.Path) bool {
+	str := p.String()
+	gostr := p.GoString()
+	return strings.HasSuffix(str, "ObjectMeta.CreationTimestamp") ||
+		strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]any)["creationTimestamp"]`) ||
+		strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]any)["creationTimestamp"]`) ||
+		strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]interface {})["creationTimestamp"]`) ||
+		strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]interface {})["creationTimestamp"]`)
+}, cmp.Ignore())
+
+// IgnoreResourceVersion is a cmp.Option that ignores the resourceVersion of resources
+var IgnoreResourceVersion = cmp.FilterPath(func(p cmp.Path) bool {
+	str := p.String()
+	gostr := p.GoString()
+	return strings.HasSuffix(str, "ObjectMeta.ResourceVersion") ||
+		strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]any)["resourceVersion"]`) ||
+		strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]any)["resourceVersion"]`) ||
+		strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]interface {})["resourceVersion"]`) ||
+		strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]interface {})["resourceVersion"]`)
+}, cmp.Ignore())
+
+// IgnoreServerManagedFields is a cmp.Option that ignores the metadata fields of resources that are
+// managed by the API Server: managedFields, generation, uid, selfLink, creationTimestamp and
+// resourceVersion. Both typed and unstructured resources are supported.
+var IgnoreServerManagedFields = cmp.FilterPath(func(p cmp.Path) bool {
+	str := p.String()
+	gostr := p.GoString()
+	for typedField, jsonField := range serverManagedFields {
+		if strings.HasSuffix(str, "ObjectMeta."+typedField) ||
+			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]any)["`+jsonField+`"]`) ||
+			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]any)["`+jsonField+`"]`) ||
+			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]interface {})["`+jsonField+`"]`) ||
+			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]interface {})["`+jsonField+`"]`) {
+			return true
+		}
+	}
+	return false
+}, cmp.Ignore())
+
+// serverManagedFields maps the ObjectMeta field names of the fields ignored by
+// IgnoreServerManagedFields to their json names
+var serverManagedFields = map[string]string{
+	"ManagedFields":     "managedFields",
+	"Generation":        "generation",
+	"UID":               "uid",
+	"SelfLink":          "selfLink",
+	"CreationTimestamp": "creationTimestamp",
+	"ResourceVersion":   "resourceVersion",
+}
+
+// NormalizeLabelSelector is a cmp.Option that compares label selectors by their string form
+var NormalizeLabelSelector = cmp.Transformer("labels.Selector", func(s labels.Selector) *string {
+	if s == nil || s.Empty() {
+		return nil
+	}
+	return ptr.To[string](s.String())
+})
+
+// NormalizeFieldSelector is a cmp.Option that compares field selectors by their string form
+var NormalizeFieldSelector = cmp.Transformer("fields.Selector", func(s fields.Selector) *string {
+	if s == nil || s.Empty() {
+		return nil
+	}
+	return ptr.To[string](s.String())
+})
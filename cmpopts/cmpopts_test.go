@@ -0,0 +1,267 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmpopts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"reconciler.io/runtime/cmpopts"
+)
+
+type listOptions struct {
+	Labels labels.Selector
+	Fields fields.Selector
+}
+
+func TestNormalizeLabelSelector(t *testing.T) {
+	tests := map[string]struct {
+		a          listOptions
+		b          listOptions
+		shouldDiff bool
+	}{
+		"nil and empty are equivalent": {
+			a:          listOptions{Labels: nil},
+			b:          listOptions{Labels: labels.Everything()},
+			shouldDiff: false,
+		},
+		"same selector": {
+			a:          listOptions{Labels: labels.SelectorFromSet(labels.Set{"foo": "bar"})},
+			b:          listOptions{Labels: labels.SelectorFromSet(labels.Set{"foo": "bar"})},
+			shouldDiff: false,
+		},
+		"different selector": {
+			a:          listOptions{Labels: labels.SelectorFromSet(labels.Set{"foo": "bar"})},
+			b:          listOptions{Labels: labels.SelectorFromSet(labels.Set{"foo": "baz"})},
+			shouldDiff: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := cmp.Diff(tc.a, tc.b, cmpopts.NormalizeLabelSelector)
+			hasDiff := diff != ""
+			if tc.shouldDiff != hasDiff {
+				t.Errorf("unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeFieldSelector(t *testing.T) {
+	tests := map[string]struct {
+		a          listOptions
+		b          listOptions
+		shouldDiff bool
+	}{
+		"nil and empty are equivalent": {
+			a:          listOptions{Fields: nil},
+			b:          listOptions{Fields: fields.Everything()},
+			shouldDiff: false,
+		},
+		"same selector": {
+			a:          listOptions{Fields: fields.OneTermEqualSelector("metadata.name", "foo")},
+			b:          listOptions{Fields: fields.OneTermEqualSelector("metadata.name", "foo")},
+			shouldDiff: false,
+		},
+		"different selector": {
+			a:          listOptions{Fields: fields.OneTermEqualSelector("metadata.name", "foo")},
+			b:          listOptions{Fields: fields.OneTermEqualSelector("metadata.name", "bar")},
+			shouldDiff: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := cmp.Diff(tc.a, tc.b, cmpopts.NormalizeFieldSelector)
+			hasDiff := diff != ""
+			if tc.shouldDiff != hasDiff {
+				t.Errorf("unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIgnoreServerManagedFields(t *testing.T) {
+	typed := func(fn func(*corev1.ConfigMap)) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-name",
+			},
+			Data: map[string]string{"foo": "bar"},
+		}
+		if fn != nil {
+			fn(cm)
+		}
+		return cm
+	}
+	serverManaged := func(cm *corev1.ConfigMap) {
+		cm.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}}
+		cm.Generation = 2
+		cm.UID = types.UID("3b298fdb-b0b6-4603-9708-939e05daf183")
+		cm.SelfLink = "/api/v1/namespaces/my-namespace/configmaps/my-name"
+		cm.CreationTimestamp = metav1.Now()
+		cm.ResourceVersion = "999"
+	}
+	unstructuredFrom := func(cm *corev1.ConfigMap) *unstructured.Unstructured {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &unstructured.Unstructured{Object: u}
+	}
+
+	tests := map[string]struct {
+		a          any
+		b          any
+		shouldDiff bool
+	}{
+		"typed server managed fields": {
+			a:          typed(nil),
+			b:          typed(serverManaged),
+			shouldDiff: false,
+		},
+		"typed other metadata": {
+			a: typed(nil),
+			b: typed(func(cm *corev1.ConfigMap) {
+				cm.Labels = map[string]string{"foo": "bar"}
+			}),
+			shouldDiff: true,
+		},
+		"typed data": {
+			a: typed(nil),
+			b: typed(func(cm *corev1.ConfigMap) {
+				cm.Data["foo"] = "baz"
+			}),
+			shouldDiff: true,
+		},
+		"unstructured server managed fields": {
+			a:          unstructuredFrom(typed(nil)),
+			b:          unstructuredFrom(typed(serverManaged)),
+			shouldDiff: false,
+		},
+		"unstructured other metadata": {
+			a: unstructuredFrom(typed(nil)),
+			b: unstructuredFrom(typed(func(cm *corev1.ConfigMap) {
+				cm.Labels = map[string]string{"foo": "bar"}
+			})),
+			shouldDiff: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := cmp.Diff(tc.a, tc.b, cmpopts.IgnoreServerManagedFields)
+			hasDiff := diff != ""
+			if tc.shouldDiff != hasDiff {
+				t.Errorf("unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEquateApproxTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type lease struct {
+		RenewTime   *metav1.MicroTime
+		AcquireTime metav1.Time
+	}
+
+	tests := map[string]struct {
+		a          any
+		b          any
+		shouldDiff bool
+	}{
+		"equal times": {
+			a: metav1.NewTime(now),
+			b: metav1.NewTime(now),
+		},
+		"times within margin": {
+			a: metav1.NewTime(now),
+			b: metav1.NewTime(now.Add(-time.Second)),
+		},
+		"times at margin": {
+			a: metav1.NewTime(now),
+			b: metav1.NewTime(now.Add(5 * time.Second)),
+		},
+		"times beyond margin": {
+			a:          metav1.NewTime(now),
+			b:          metav1.NewTime(now.Add(6 * time.Second)),
+			shouldDiff: true,
+		},
+		"zero times": {
+			a: metav1.Time{},
+			b: metav1.Time{},
+		},
+		"zero and non-zero times": {
+			a:          metav1.Time{},
+			b:          metav1.NewTime(now),
+			shouldDiff: true,
+		},
+		"nested times within margin": {
+			a: lease{
+				RenewTime:   &metav1.MicroTime{Time: now},
+				AcquireTime: metav1.NewTime(now),
+			},
+			b: lease{
+				RenewTime:   &metav1.MicroTime{Time: now.Add(time.Second)},
+				AcquireTime: metav1.NewTime(now.Add(2 * time.Second)),
+			},
+		},
+		"nested times beyond margin": {
+			a: lease{
+				RenewTime: &metav1.MicroTime{Time: now},
+			},
+			b: lease{
+				RenewTime: &metav1.MicroTime{Time: now.Add(time.Minute)},
+			},
+			shouldDiff: true,
+		},
+		"nested nil time": {
+			a: lease{
+				RenewTime: &metav1.MicroTime{Time: now},
+			},
+			b:          lease{},
+			shouldDiff: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := cmp.Diff(tc.a, tc.b, cmpopts.EquateApproxTime(5*time.Second))
+			hasDiff := diff != ""
+			if tc.shouldDiff != hasDiff {
+				t.Errorf("unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEquateApproxTime_NegativeMargin(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected to panic")
+		}
+	}()
+	cmpopts.EquateApproxTime(-time.Second)
+}
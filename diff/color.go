@@ -0,0 +1,47 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// AddedColor and RemovedColor are used to colorize added and removed lines of a diff. Color is
+// disabled when stdout is not a terminal, or when the NO_COLOR environment variable is set.
+var (
+	AddedColor   = color.New(color.FgGreen)
+	RemovedColor = color.New(color.FgRed)
+)
+
+// Colorize colors each added and removed line of a diff.
+func Colorize(diff string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			b.WriteString(AddedColor.Sprint(line))
+		case strings.HasPrefix(line, "-"):
+			b.WriteString(RemovedColor.Sprint(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diff renders human readable diffs between resources. The testing package uses the same
+// machinery for assertion messages, so diffs logged by a reconciler are formatted the same as diffs
+// reported by a failing test.
+package diff
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	rcmpopts "reconciler.io/runtime/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Differ renders the difference between two resources. An empty string is returned for
+// equivalent resources.
+type Differ interface {
+	Resource(expected, actual client.Object) string
+}
+
+// DefaultDiffer is a basic implementation of the Differ interface. Fields managed by the API
+// server that do not reflect a meaningful change, like the resourceVersion, creationTimestamp and
+// the lastTransitionTime of conditions, are ignored, consistent with reconcilers.ResourceChanged.
+var DefaultDiffer Differ = &differ{}
+
+type differ struct{}
+
+func (*differ) Resource(expected, actual client.Object) string {
+	return cmp.Diff(expected, actual, rcmpopts.IgnoreAllUnexported,
+		rcmpopts.IgnoreLastTransitionTime,
+		rcmpopts.IgnoreTypeMeta,
+		rcmpopts.IgnoreCreationTimestamp,
+		rcmpopts.IgnoreResourceVersion,
+		cmpopts.EquateEmpty())
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/diff"
+	"reconciler.io/runtime/internal/resources/dies"
+)
+
+func TestDefaultDiffer_Resource(t *testing.T) {
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-resource")
+		})
+
+	tests := map[string]struct {
+		a          *dies.TestResourceDie
+		b          *dies.TestResourceDie
+		shouldDiff string
+	}{
+		"equivalent resources": {
+			a: resource,
+			b: resource,
+		},
+		"server managed fields are ignored": {
+			a: resource,
+			b: resource.
+				APIVersion("testing.reconciler.runtime/v1").
+				Kind("TestResource").
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("999")
+					d.CreationTimestamp(metav1.Now())
+				}),
+		},
+		"changed field": {
+			a: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}),
+			b: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "baz")
+				}),
+			shouldDiff: `"foo": "baz"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := diff.DefaultDiffer.Resource(tc.a.DieReleasePtr(), tc.b.DieReleasePtr())
+			if (d != "") != (tc.shouldDiff != "") || !strings.Contains(d, tc.shouldDiff) {
+				t.Errorf("unexpected diff, expected to contain %q: %s", tc.shouldDiff, d)
+			}
+		})
+	}
+}
+
+func TestColorize(t *testing.T) {
+	d := " {\n-\tfoo\n+\tbar\n }"
+
+	t.Run("no color", func(t *testing.T) {
+		restore := color.NoColor
+		defer func() { color.NoColor = restore }()
+		color.NoColor = true
+
+		if expected, actual := d+"\n", diff.Colorize(d); expected != actual {
+			t.Errorf("expected uncolored diff %q, got %q", expected, actual)
+		}
+	})
+
+	t.Run("color", func(t *testing.T) {
+		restore := color.NoColor
+		defer func() { color.NoColor = restore }()
+		color.NoColor = false
+
+		expected := " {\n" + diff.RemovedColor.Sprint("-\tfoo") + "\n" + diff.AddedColor.Sprint("+\tbar") + "\n }\n"
+		if actual := diff.Colorize(d); expected != actual {
+			t.Errorf("expected colored diff %q, got %q", expected, actual)
+		}
+		if !strings.Contains(expected, "\x1b[") {
+			t.Errorf("expected escape sequences in colored diff %q", expected)
+		}
+	})
+}
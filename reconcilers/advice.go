@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"reconciler.io/runtime/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -121,22 +122,24 @@ func (r *Advice[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr
 func (r *Advice[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	if r.Reconciler == nil {
-		return fmt.Errorf("Advice %q must implement Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("Advice %q must implement Reconciler", r.Name))
 	}
 	if r.Before == nil && r.Around == nil && r.After == nil {
-		return fmt.Errorf("Advice %q must implement at least one of Before, Around or After", r.Name)
+		errs = append(errs, fmt.Errorf("Advice %q must implement at least one of Before, Around or After", r.Name))
 	}
 
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("Advice %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("Advice %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *Advice[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -27,6 +27,7 @@ import (
 	"github.com/go-logr/logr"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -200,29 +201,31 @@ func (r *AggregateReconciler[T]) SetupWithManagerYieldingController(ctx context.
 func (r *AggregateReconciler[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Request value
 	if r.Request.Name == "" {
-		return fmt.Errorf("AggregateReconciler %q must define Request", r.Name)
+		errs = append(errs, fmt.Errorf("AggregateReconciler %q must define Request", r.Name))
 	}
 
 	// validate AggregateObjectManager value
 	if r.AggregateObjectManager == nil {
-		return fmt.Errorf("AggregateReconciler %q must define AggregateObjectManager", r.Name)
+		errs = append(errs, fmt.Errorf("AggregateReconciler %q must define AggregateObjectManager", r.Name))
 	}
 
 	// validate Reconciler value
 	if r.Reconciler == nil && r.DesiredResource == nil {
-		return fmt.Errorf("AggregateReconciler %q must define Reconciler and/or DesiredResource", r.Name)
+		errs = append(errs, fmt.Errorf("AggregateReconciler %q must define Reconciler and/or DesiredResource", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("AggregateReconciler %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("AggregateReconciler %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *AggregateReconciler[T]) Reconcile(ctx context.Context, req Request) (Result, error) {
@@ -244,6 +247,7 @@ func (r *AggregateReconciler[T]) Reconcile(ctx context.Context, req Request) (Re
 
 	ctx = rtime.StashNow(ctx, time.Now())
 	ctx = StashRequest(ctx, req)
+	ctx = StashReconcilerName(ctx, r.Name)
 	ctx = StashConfig(ctx, c)
 	ctx = StashOriginalConfig(ctx, r.Config)
 	ctx = StashOriginalResourceType(ctx, r.Type)
@@ -290,6 +294,7 @@ func (r *AggregateReconciler[T]) reconcile(ctx context.Context, req Request) (Re
 			return Result{}, err
 		}
 	}
+	ctx = StashOriginalResource(ctx, resource.DeepCopyObject().(T))
 
 	if resource.GetDeletionTimestamp() != nil {
 		// resource is being deleted, nothing to do
@@ -307,6 +312,7 @@ func (r *AggregateReconciler[T]) reconcile(ctx context.Context, req Request) (Re
 		APIReader: c.APIReader,
 		Recorder:  c.Recorder,
 		Tracker:   tracker.New(c.Scheme(), 0),
+		Clock:     c.Clock,
 	})
 	desired, err := r.desiredResource(ctx, resource)
 	if err != nil {
@@ -559,7 +559,7 @@ func TestAggregateReconciler_Validate(t *testing.T) {
 		{
 			name:       "empty",
 			reconciler: &reconcilers.AggregateReconciler[*resources.TestResource]{},
-			shouldErr:  `AggregateReconciler "TestResourceAggregateReconciler" must define Request`,
+			shouldErr:  `[AggregateReconciler "TestResourceAggregateReconciler" must define Request, AggregateReconciler "TestResourceAggregateReconciler" must define AggregateObjectManager]`,
 		},
 		{
 			name: "valid",
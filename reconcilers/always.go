@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"reconciler.io/runtime/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -50,10 +51,22 @@ func (r Always[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr
 }
 
 func (r Always[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	return reconcileAll(ctx, resource, r, false)
+}
+
+// reconcileAll calls each reconciler in order regardless of previous errors, aggregating the
+// results and joining the errors. The joined error will only match ErrQuiet if all contributing
+// errors match ErrQuiet.
+//
+// When halt is true, reconcilers after a reconciler that returns ErrHaltSubReconcilers are
+// skipped and the returned error matches ErrHaltSubReconcilers, even if it does not match
+// ErrQuiet due to a previous error.
+func reconcileAll[T client.Object](ctx context.Context, resource T, reconcilers []SubReconciler[T], halt bool) (Result, error) {
 	aggregateResult := Result{}
 	aggregateErrors := []error{}
 	aggregateNonQuietErrors := []error{}
-	for i, reconciler := range r {
+	halted := false
+	for i, reconciler := range reconcilers {
 		log := logr.FromContextOrDiscard(ctx).
 			WithName(fmt.Sprintf("%d", i))
 		ctx := logr.NewContext(ctx, log)
@@ -65,26 +78,53 @@ func (r Always[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
 			if !errors.Is(err, ErrQuiet) {
 				aggregateNonQuietErrors = append(aggregateNonQuietErrors, err)
 			}
+			if halt && errors.Is(err, ErrHaltSubReconcilers) {
+				halted = true
+				break
+			}
 		}
 	}
 	// only return an ErrQuiet if all errors are quiet
 	if err := errors.Join(aggregateNonQuietErrors...); err != nil {
+		if halted {
+			return aggregateResult, &haltedError{err: err}
+		}
 		return aggregateResult, err
 	}
 	return aggregateResult, errors.Join(aggregateErrors...)
 }
 
+// haltedError matches ErrHaltSubReconcilers so that parent reconcilers stop processing, without
+// matching ErrQuiet as the wrapped errors are not quiet.
+type haltedError struct {
+	err error
+}
+
+func (e *haltedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *haltedError) Unwrap() error {
+	return e.err
+}
+
+func (e *haltedError) Is(target error) bool {
+	return target == ErrHaltSubReconcilers
+}
+
 func (r *Always[T]) Validate(ctx context.Context) error {
+	errs := []error{}
+
 	// validate Always
 	if validation.IsRecursive(ctx) {
 		for i, reconciler := range *r {
 			if v, ok := reconciler.(validation.Validator); ok {
 				if err := v.Validate(ctx); err != nil {
-					return fmt.Errorf("Always must have a valid Always[%d]: %w", i, err)
+					errs = append(errs, fmt.Errorf("Always must have a valid Always[%d]: %w", i, err))
 				}
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
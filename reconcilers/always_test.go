@@ -408,6 +408,22 @@ func TestAlways_Validate(t *testing.T) {
 			},
 			shouldErr: `Always must have a valid Always[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "multiple invalid reconcilers",
+			reconciler: &reconcilers.Always[*resources.TestResource]{
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			shouldErr: `[Always must have a valid Always[0]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult, Always must have a valid Always[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -0,0 +1,232 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/runtime/apis"
+	rtime "reconciler.io/runtime/time"
+	"reconciler.io/runtime/validation"
+)
+
+const (
+	// ConditionBackoff is marked True on the status of a resource a BackoffReconciler is
+	// requeueing because it is not ready. The message holds the number of consecutive reconciles
+	// the resource was not ready, and the last transition time is the time of the last attempt.
+	ConditionBackoff = "Backoff"
+	// BackoffNotReadyReason is the reason of the ConditionBackoff condition.
+	BackoffNotReadyReason = "NotReady"
+)
+
+// backoffMessageFormat is the message of the ConditionBackoff condition, the attempts are parsed
+// from the message
+const backoffMessageFormat = "resource is not ready after %d attempts"
+
+var _ SubReconciler[client.Object] = (*BackoffReconciler[client.Object])(nil)
+
+// BackoffReconciler requeues the reconciled resource with an exponential backoff until it is
+// ready. After the nested reconciler returns without error, a resource that is not ready is
+// requeued after InitialBackoff, doubling for each consecutive attempt up to MaxBackoff. Once the
+// resource is ready, the attempts are reset and no requeue is added to the nested reconciler's
+// result.
+//
+// The attempt counter is persisted with the ConditionBackoff condition on the status of the
+// reconciled resource, and is saved by the status update of the ResourceReconciler along with any
+// other change to the status. Resources whose status does not define conditions are requeued after
+// InitialBackoff for each attempt. A reconcile request received before the current backoff
+// elapses, for example from a watched resource changing, is requeued for the remainder of the
+// backoff without counting as an attempt.
+type BackoffReconciler[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `BackoffReconciler`.  Ideally unique,
+	// but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	// IsReady returns true when the resource no longer needs to be requeued.
+	//
+	// Defaults to checking the resource's status for a Ready condition with a status of True.
+	//
+	// +optional
+	IsReady func(ctx context.Context, resource Type) bool
+
+	// InitialBackoff is the duration to requeue the resource after the first attempt it is not
+	// ready.
+	//
+	// Defaults to one second.
+	//
+	// +optional
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the duration to requeue the resource after.
+	//
+	// Defaults to five minutes.
+	//
+	// +optional
+	MaxBackoff time.Duration
+
+	lazyInit sync.Once
+}
+
+func (r *BackoffReconciler[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "BackoffReconciler"
+		}
+		if r.IsReady == nil {
+			r.IsReady = func(ctx context.Context, resource T) bool {
+				return meta.IsStatusConditionTrue(resourceConditions(resource), apis.ConditionReady)
+			}
+		}
+		if r.InitialBackoff == 0 {
+			r.InitialBackoff = time.Second
+		}
+		if r.MaxBackoff == 0 {
+			r.MaxBackoff = 5 * time.Minute
+		}
+	})
+}
+
+func (r *BackoffReconciler[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	if err := r.Reconciler.SetupWithManager(ctx, mgr, bldr); err != nil {
+		return err
+	}
+	if r.Setup == nil {
+		return nil
+	}
+	return r.Setup(ctx, mgr, bldr)
+}
+
+func (r *BackoffReconciler[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate backoff durations
+	if r.InitialBackoff < 0 {
+		errs = append(errs, fmt.Errorf("BackoffReconciler %q must not define a negative InitialBackoff", r.Name))
+	}
+	if r.MaxBackoff < r.InitialBackoff {
+		errs = append(errs, fmt.Errorf("BackoffReconciler %q must define a MaxBackoff no less than InitialBackoff", r.Name))
+	}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("BackoffReconciler %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("BackoffReconciler %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *BackoffReconciler[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	result, err := r.Reconciler.Reconcile(ctx, resource)
+	if err != nil || resource.GetDeletionTimestamp() != nil {
+		return result, err
+	}
+
+	conditions := apis.ConditionSet{}.ManageWithContext(ctx, &resourceConditionsAccessor{obj: resource})
+	attempts, lastAttempt := r.attempts(conditions)
+	if r.IsReady(ctx, resource) {
+		if attempts != 0 {
+			log.Info("resource is ready, resetting backoff", "attempts", attempts)
+		}
+		// the condition is not terminal, an error is never returned
+		_ = conditions.ClearCondition(ConditionBackoff)
+		return result, nil
+	}
+
+	now := rtime.RetrieveNow(ctx)
+	if attempts != 0 {
+		if next := lastAttempt.Add(r.backoff(attempts)); now.Before(next) {
+			// the current backoff has not elapsed
+			return WithMaxRequeue(result, next.Sub(now)), nil
+		}
+	}
+
+	attempts++
+	log.Info("resource is not ready, backing off", "attempts", attempts)
+	// the message changes for each attempt, so the last transition time is the time of the attempt
+	conditions.MarkTrue(ConditionBackoff, BackoffNotReadyReason, backoffMessageFormat, attempts)
+	return WithMaxRequeue(result, r.backoff(attempts)), nil
+}
+
+// attempts returns the attempt counter persisted with the ConditionBackoff condition and the time
+// of the last attempt. A missing or invalid condition is treated as no attempts.
+func (r *BackoffReconciler[T]) attempts(conditions apis.ConditionManager) (int, time.Time) {
+	condition := conditions.GetCondition(ConditionBackoff)
+	if condition == nil || !apis.ConditionIsTrue(condition) {
+		return 0, time.Time{}
+	}
+	var attempts int
+	if _, err := fmt.Sscanf(condition.Message, backoffMessageFormat, &attempts); err != nil || attempts < 0 {
+		return 0, time.Time{}
+	}
+	return attempts, condition.LastTransitionTime.Time
+}
+
+// backoff returns the duration to wait after the attempt
+func (r *BackoffReconciler[T]) backoff(attempts int) time.Duration {
+	backoff := r.InitialBackoff
+	for i := 1; i < attempts && backoff < r.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.MaxBackoff)
+}
@@ -0,0 +1,424 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestBackoffReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+			)
+		})
+	resourceReady := resource.
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+			)
+		})
+	readyUnknown := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	readyTrue := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready")
+	withAttempts := func(attempts int, lastAttempt time.Time, ready *diemetav1.ConditionDie) func(d *dies.TestResourceStatusDie) {
+		return func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.
+					Type(reconcilers.ConditionBackoff).
+					Status(metav1.ConditionTrue).
+					Reason(reconcilers.BackoffNotReadyReason).
+					Message(fmt.Sprintf("resource is not ready after %d attempts", attempts)).
+					LastTransitionTime(metav1.NewTime(lastAttempt)),
+				ready,
+			)
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"ready resource is not requeued": {
+			Now:      now,
+			Resource: resourceReady.DieReleasePtr(),
+		},
+		"first attempt": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			ExpectResource: resource.
+				StatusDie(withAttempts(1, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Second},
+		},
+		"backoff doubles each attempt": {
+			Now: now,
+			Resource: resource.
+				StatusDie(withAttempts(2, now.Add(-2*time.Second), readyUnknown)).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				StatusDie(withAttempts(3, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 4 * time.Second},
+		},
+		"backoff is capped": {
+			Now: now,
+			Resource: resource.
+				StatusDie(withAttempts(100, now.Add(-10*time.Minute), readyUnknown)).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				StatusDie(withAttempts(101, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 5 * time.Minute},
+		},
+		"early reconcile requeues for the remaining backoff": {
+			Now: now,
+			Resource: resource.
+				StatusDie(withAttempts(3, now.Add(-1*time.Second), readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 3 * time.Second},
+		},
+		"invalid attempts are reset": {
+			Now: now,
+			Resource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.
+							Type(reconcilers.ConditionBackoff).
+							Status(metav1.ConditionTrue).
+							Reason(reconcilers.BackoffNotReadyReason).
+							Message("not a counter").
+							LastTransitionTime(metav1.NewTime(now.Add(-1*time.Second))),
+						readyUnknown,
+					)
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				StatusDie(withAttempts(1, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Second},
+		},
+		"ready resource resets attempts": {
+			Now: now,
+			Resource: resourceReady.
+				StatusDie(withAttempts(3, now.Add(-4*time.Second), readyTrue)).
+				DieReleasePtr(),
+			ExpectResource: resourceReady.DieReleasePtr(),
+		},
+		"custom backoff durations": {
+			Now: now,
+			Resource: resource.
+				StatusDie(withAttempts(1, now.Add(-10*time.Second), readyUnknown)).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"InitialBackoff": 10 * time.Second,
+				"MaxBackoff":     15 * time.Second,
+			},
+			ExpectResource: resource.
+				StatusDie(withAttempts(2, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 15 * time.Second},
+		},
+		"custom IsReady": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"IsReady": func(ctx context.Context, resource *resources.TestResource) bool {
+					return true
+				},
+			},
+		},
+		"preserves shorter requeue from reconciler": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Result": reconcilers.Result{RequeueAfter: 500 * time.Millisecond},
+			},
+			ExpectResource: resource.
+				StatusDie(withAttempts(1, now, readyUnknown)).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 500 * time.Millisecond},
+		},
+		"reconciler error": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Err": fmt.Errorf("reconciler error"),
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		r := &reconcilers.BackoffReconciler[*resources.TestResource]{
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+					var result reconcilers.Result
+					if r, ok := rtc.Metadata["Result"]; ok {
+						result = r.(reconcilers.Result)
+					}
+					var err error
+					if e, ok := rtc.Metadata["Err"]; ok {
+						err = e.(error)
+					}
+					return result, err
+				},
+			},
+		}
+		if isReady, ok := rtc.Metadata["IsReady"]; ok {
+			r.IsReady = isReady.(func(context.Context, *resources.TestResource) bool)
+		}
+		if initialBackoff, ok := rtc.Metadata["InitialBackoff"]; ok {
+			r.InitialBackoff = initialBackoff.(time.Duration)
+		}
+		if maxBackoff, ok := rtc.Metadata["MaxBackoff"]; ok {
+			r.MaxBackoff = maxBackoff.(time.Duration)
+		}
+		return r
+	})
+}
+
+func TestBackoffReconciler_StatusUpdate(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+			)
+		})
+	backoff := diemetav1.ConditionBlank.
+		Type(reconcilers.ConditionBackoff).
+		Status(metav1.ConditionTrue).
+		Reason(reconcilers.BackoffNotReadyReason)
+
+	rts := rtesting.ReconcilerTests{
+		"each attempt is a single status update": {
+			Now: now,
+			Request: reconcilers.Request{
+				NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
+			},
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				resource,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				resource.
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.ConditionsDie(
+							backoff.Message("resource is not ready after 1 attempts"),
+							diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+						)
+					}),
+			},
+			ExpectActions: []rtesting.ActionRef{
+				{Verb: "update", Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: testNamespace, Name: testName, SubResource: "status"},
+			},
+			// the result is suppressed by the status update, the update is observed by the informer
+			// and the following reconcile requeues for the remaining backoff
+			ExpectedResult: reconcilers.Result{},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return &reconcilers.ResourceReconciler[*resources.TestResource]{
+			Reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+					Sync: func(ctx context.Context, resource *resources.TestResource) error {
+						return nil
+					},
+				},
+			},
+			Config: c,
+		}
+	})
+}
+
+func TestBackoffReconciler_Unstructured(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		APIVersion("testing.reconciler.runtime/v1").
+		Kind("TestResource").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	readyTrue := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready")
+	backoff := diemetav1.ConditionBlank.
+		Type(reconcilers.ConditionBackoff).
+		Status(metav1.ConditionTrue).
+		Reason(reconcilers.BackoffNotReadyReason)
+
+	rts := rtesting.SubReconcilerTests[*unstructured.Unstructured]{
+		"first attempt": {
+			Now:      now,
+			Resource: resource.DieReleaseUnstructured(),
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						backoff.
+							Message("resource is not ready after 1 attempts").
+							LastTransitionTime(metav1.NewTime(now)),
+					)
+				}).
+				DieReleaseUnstructured(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Second},
+		},
+		"ready resource resets attempts": {
+			Now: now,
+			Resource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						backoff.
+							Message("resource is not ready after 3 attempts").
+							LastTransitionTime(metav1.NewTime(now.Add(-4*time.Second))),
+						readyTrue,
+					)
+				}).
+				DieReleaseUnstructured(),
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						readyTrue,
+					)
+				}).
+				DieReleaseUnstructured(),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*unstructured.Unstructured], c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+		return &reconcilers.BackoffReconciler[*unstructured.Unstructured]{
+			Reconciler: &reconcilers.SyncReconciler[*unstructured.Unstructured]{
+				Sync: func(ctx context.Context, resource *unstructured.Unstructured) error {
+					return nil
+				},
+			},
+		}
+	})
+}
+
+func TestBackoffReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.BackoffReconciler[*resources.TestResource]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Name: "missing reconciler",
+			},
+			shouldErr: `BackoffReconciler "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "negative initial backoff",
+			reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Name:           "negative initial backoff",
+				Reconciler:     reconcilers.Sequence[*resources.TestResource]{},
+				InitialBackoff: -1 * time.Second,
+			},
+			shouldErr: `BackoffReconciler "negative initial backoff" must not define a negative InitialBackoff`,
+		},
+		{
+			name: "max backoff less than initial backoff",
+			reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Name:           "max backoff less than initial backoff",
+				Reconciler:     reconcilers.Sequence[*resources.TestResource]{},
+				InitialBackoff: 10 * time.Minute,
+			},
+			shouldErr: `BackoffReconciler "max backoff less than initial backoff" must define a MaxBackoff no less than InitialBackoff`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.BackoffReconciler[*resources.TestResource]{
+				Name:       "invalid reconciler",
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			shouldErr: `BackoffReconciler "invalid reconciler" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
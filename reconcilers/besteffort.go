@@ -0,0 +1,65 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"reconciler.io/runtime/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ SubReconciler[client.Object] = (BestEffortSequence[client.Object])(nil)
+
+// BestEffortSequence is a collection of SubReconcilers called in order. Unlike Sequence, which
+// skips further reconcilers once a reconciler errs, each reconciler is called regardless of
+// previous errors. The resulting errors are joined and the results are aggregated.
+//
+// Unlike Always, further reconcilers are skipped when a reconciler returns ErrHaltSubReconcilers.
+// The resulting joined error will only match ErrQuiet if all contributing errors match ErrQuiet.
+// Once halted, the resulting error always matches ErrHaltSubReconcilers, so a parent Sequence or
+// BestEffortSequence stops as well, while errors returned before the halt are not quieted.
+type BestEffortSequence[Type client.Object] []SubReconciler[Type]
+
+func (r BestEffortSequence[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	return Always[T](r).SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r BestEffortSequence[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	return reconcileAll(ctx, resource, r, true)
+}
+
+func (r *BestEffortSequence[T]) Validate(ctx context.Context) error {
+	errs := []error{}
+
+	// validate BestEffortSequence
+	if validation.IsRecursive(ctx) {
+		for i, reconciler := range *r {
+			if v, ok := reconciler.(validation.Validator); ok {
+				if err := v.Validate(ctx); err != nil {
+					errs = append(errs, fmt.Errorf("BestEffortSequence must have a valid BestEffortSequence[%d]: %w", i, err))
+				}
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
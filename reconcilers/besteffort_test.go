@@ -0,0 +1,304 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+)
+
+func TestBestEffortSequence(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+			)
+		})
+
+	addField := func(key string) *reconcilers.SyncReconciler[*resources.TestResource] {
+		return &reconcilers.SyncReconciler[*resources.TestResource]{
+			Sync: func(ctx context.Context, resource *resources.TestResource) error {
+				if resource.Status.Fields == nil {
+					resource.Status.Fields = map[string]string{}
+				}
+				resource.Status.Fields[key] = "true"
+				return nil
+			},
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"all succeed": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						addField("first"),
+						addField("second"),
+					}
+				},
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("first", "true")
+					d.AddField("second", "true")
+				}).
+				DieReleasePtr(),
+		},
+		"mixed success and failure, keeps processing": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						addField("first"),
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return fmt.Errorf("first error")
+							},
+						},
+						addField("second"),
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return fmt.Errorf("second error")
+							},
+						},
+					}
+				},
+			},
+			ShouldErr: true,
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("first", "true")
+					d.AddField("second", "true")
+				}).
+				DieReleasePtr(),
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if expected, actual := "first error\nsecond error", err.Error(); expected != actual {
+					t.Errorf("expected error %q, got %q", expected, actual)
+				}
+			},
+		},
+		"halt stops processing": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						addField("first"),
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return reconcilers.ErrHaltSubReconcilers
+							},
+						},
+						addField("second"),
+					}
+				},
+			},
+			ShouldErr: true,
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("first", "true")
+				}).
+				DieReleasePtr(),
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+					t.Errorf("expected returned error to be ErrHaltSubReconcilers")
+				}
+			},
+		},
+		"errors before halt are not quiet": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return fmt.Errorf("not quiet")
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return reconcilers.ErrHaltSubReconcilers
+							},
+						},
+						addField("second"),
+					}
+				},
+			},
+			ShouldErr: true,
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if errors.Is(err, reconcilers.ErrQuiet) {
+					t.Errorf("expected returned error to not be ErrQuiet")
+				}
+				if !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+					t.Errorf("expected returned error to be ErrHaltSubReconcilers")
+				}
+				if expected, actual := "not quiet", err.Error(); expected != actual {
+					t.Errorf("expected error %q, got %q", expected, actual)
+				}
+			},
+		},
+		"halt after an error stops the parent": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						reconcilers.BestEffortSequence[*resources.TestResource]{
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									return fmt.Errorf("not quiet")
+								},
+							},
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									return reconcilers.ErrHaltSubReconcilers
+								},
+							},
+						},
+						addField("second"),
+					}
+				},
+			},
+			ShouldErr: true,
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if errors.Is(err, reconcilers.ErrQuiet) {
+					t.Errorf("expected returned error to not be ErrQuiet")
+				}
+				if !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+					t.Errorf("expected returned error to be ErrHaltSubReconcilers")
+				}
+			},
+		},
+		"aggregates results": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.BestEffortSequence[*resources.TestResource]{
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+								return reconcilers.Result{RequeueAfter: 2 * time.Minute}, nil
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+								return reconcilers.Result{}, fmt.Errorf("test error")
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+								return reconcilers.Result{RequeueAfter: 1 * time.Minute}, nil
+							},
+						},
+					}
+				},
+			},
+			ShouldErr:      true,
+			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Minute},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c)
+	})
+}
+
+func TestBestEffortSequence_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.BestEffortSequence[*resources.TestResource]
+		shouldErr  string
+	}{
+		{
+			name:       "valid empty sequence",
+			reconciler: &reconcilers.BestEffortSequence[*resources.TestResource]{},
+		},
+		{
+			name: "valid sequence",
+			reconciler: &reconcilers.BestEffortSequence[*resources.TestResource]{
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					Sync: func(ctx context.Context, resource *resources.TestResource) error {
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "invalid sequence",
+			reconciler: &reconcilers.BestEffortSequence[*resources.TestResource]{
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					Sync: func(ctx context.Context, resource *resources.TestResource) error {
+						return nil
+					},
+				},
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			shouldErr: `BestEffortSequence must have a valid BestEffortSequence[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+		{
+			name: "multiple invalid reconcilers",
+			reconciler: &reconcilers.BestEffortSequence[*resources.TestResource]{
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			shouldErr: `[BestEffortSequence must have a valid BestEffortSequence[0]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult, BestEffortSequence must have a valid BestEffortSequence[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"k8s.io/apimachinery/pkg/util/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"reconciler.io/runtime/validation"
 
 	"github.com/go-logr/logr"
@@ -95,19 +96,21 @@ func (r *CastResource[T, CT]) SetupWithManager(ctx context.Context, mgr ctrl.Man
 func (r *CastResource[T, CT]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Reconciler value
 	if r.Reconciler == nil {
-		return fmt.Errorf("CastResource %q must define Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("CastResource %q must define Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("CastResource %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("CastResource %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *CastResource[T, CT]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -23,20 +23,24 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/internal"
+	"reconciler.io/runtime/stash"
 	"reconciler.io/runtime/validation"
 )
 
@@ -76,7 +80,8 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	ChildType ChildType
 	// ChildListType is the listing type for the child type. For example,
 	// PodList is the list type for Pod. Required when the generic type is not
-	// a struct, or is unstructured.
+	// a struct. For an unstructured ChildType, defaults to an unstructured list
+	// of the ChildType's kind suffixed with List.
 	//
 	// +optional
 	ChildListType ChildListType
@@ -86,8 +91,45 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	// reconciled from other resources of the same type.
 	//
 	// Any child resource created is tracked for changes.
+	//
+	// A namespaced resource cannot own a cluster-scoped child, SkipOwnerReference is required for
+	// this combination. A cluster-scoped resource may own namespaced children in any namespace.
 	SkipOwnerReference bool
 
+	// OwnerReferenceController sets the controller field of the owner reference added to created
+	// and adopted children. A resource may have many owners, but only a single controller. Use a
+	// non-controller owner reference for children that legitimately have multiple owners. Children
+	// are discovered by an owner reference to the reconciled resource, which must be a controller
+	// reference unless OwnerReferenceController is false.
+	//
+	// OwnerReferenceController may not be used when SkipOwnerReference is true.
+	//
+	// Defaults to true.
+	//
+	// +optional
+	OwnerReferenceController *bool
+
+	// BlockOwnerDeletion sets the blockOwnerDeletion field of the owner reference added to created
+	// and adopted children. When true, foreground deletion of the reconciled resource waits for the
+	// child to be deleted.
+	//
+	// BlockOwnerDeletion may not be used when SkipOwnerReference is true.
+	//
+	// Defaults to true.
+	//
+	// +optional
+	BlockOwnerDeletion *bool
+
+	// WatchPredicates filter the child resource events that trigger a reconcile of the owning
+	// resource. The predicates apply to the watches registered for owned and tracked children
+	// during setup.
+	//
+	// When SkipOwnerReference is true, the children are only watched by the ChildObjectManager.
+	// The UpdatingObjectManager applies the predicates to the watch it registers for TrackDesired.
+	//
+	// +optional
+	WatchPredicates []predicate.Predicate
+
 	// Setup performs initialization on the manager and builder this reconciler
 	// will run with. It's common to setup field indexes and watch resources.
 	//
@@ -101,6 +143,20 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	// status on the reconciled resource, return OnlyReconcileChildStatus as an error.
 	DesiredChild func(ctx context.Context, resource Type) (ChildType, error)
 
+	// DesiredChildHash returns a stable hash of the inputs to the desired child that are not
+	// otherwise reflected in the child, like the content of a referenced Secret. The hash is set
+	// on the desired child as the ChildHashAnnotation annotation, and on the annotations of the
+	// child's pod template, when the child has a `spec.template` like a Deployment. A change to
+	// the hash updates the child, rolling out the pods of a workload.
+	//
+	// The hash is set after DesiredChild returns and only differs from the actual child when the
+	// inputs change, it does not otherwise contribute to the decision to update the child. The
+	// MergeBeforeUpdate method of the ChildObjectManager must copy the annotations, or the spec
+	// for the pod template, for the hash to be updated.
+	//
+	// +optional
+	DesiredChildHash func(resource Type) string
+
 	// ReflectChildStatusOnParent updates the reconciled resource's status with values from the
 	// child. Most errors are returned directly, skipping this method. The set of handled error
 	// reasons is defined by ReflectedChildErrorReasons.
@@ -124,6 +180,9 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	ReflectedChildErrorReasons []metav1.StatusReason
 
 	// ChildObjectManager synchronizes the desired child state to the API Server.
+	//
+	// Fields of the child owned by other controllers, like the status, can be excluded from the
+	// decision to update the child with UpdatingObjectManager#IgnoreFields.
 	ChildObjectManager ObjectManager[ChildType]
 
 	// ListOptions allows custom options to be use when listing potential child resources. Each
@@ -136,6 +195,8 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	//         client.InNamespace(resource.GetNamespace()),
 	//     }
 	//
+	// For a cluster-scoped reconciled resource the default lists children across all namespaces.
+	//
 	// ListOptions is required when a Finalizer is defined or SkipOwnerReference is true. An empty
 	// list is often sufficient although it may incur a performance penalty, especially when
 	// querying the API sever instead of an informer cache.
@@ -153,6 +214,27 @@ type ChildReconciler[Type, ChildType client.Object, ChildListType client.ObjectL
 	// +optional
 	OurChild func(resource Type, child ChildType) bool
 
+	// AdoptMatching is used to take ownership of existing children that do not have a controller
+	// reference, typically children created by a previous controller. The function returns true
+	// for unowned child resources that should be adopted by the reconciled resource. Adopted
+	// children are patched to add the controller reference and then reconciled like any other
+	// child, rather than creating a duplicate. Children controlled by another resource are never
+	// adopted. If not specified, unowned children are ignored.
+	//
+	// Candidates for adoption must also match OurChild, when defined. Children are not adopted
+	// while the reconciled resource is pending deletion.
+	//
+	// AdoptMatching may not be used when SkipOwnerReference is true, children are then discovered
+	// without an owner reference.
+	//
+	// +optional
+	AdoptMatching func(resource Type, child ChildType) bool
+
+	// id of the child within a ChildSetReconciler, empty for a standalone ChildReconciler
+	id string
+	// onlyMetadata watches the children as metadata, see ChildSetReconciler.MetadataOnlyListing
+	onlyMetadata bool
+
 	lazyInit sync.Once
 }
 
@@ -164,7 +246,7 @@ func (r *ChildReconciler[T, CT, CLT]) init() {
 		}
 		if internal.IsNil(r.ChildListType) {
 			var nilCLT CLT
-			r.ChildListType = newEmpty(nilCLT).(CLT)
+			r.ChildListType = newEmptyList(nilCLT, r.ChildType).(CLT)
 		}
 		if r.Name == "" {
 			r.Name = fmt.Sprintf("%sChildReconciler", typeName(r.ChildType))
@@ -199,7 +281,15 @@ func (r *ChildReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
+	if err := validateChildSchemeRegistration(c.Scheme(), "ChildReconciler", r.Name, r.ChildType, r.ChildListType); err != nil {
+		return err
+	}
+
 	if !r.SkipOwnerReference {
+		if err := r.validateOwnerScope(ctx); err != nil {
+			return err
+		}
+
 		var ct client.Object = r.ChildType
 		if duck.IsDuck(ct, mgr.GetScheme()) {
 			gvk := ct.GetObjectKind().GroupVersionKind()
@@ -207,11 +297,21 @@ func (r *ChildReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, mgr
 			ct.GetObjectKind().SetGroupVersionKind(gvk)
 		}
 
-		bldr.Owns(ct)
-		bldr.Watches(ct, EnqueueTracked(ctx))
+		ownsOpts := []builder.OwnsOption{builder.WithPredicates(r.WatchPredicates...)}
+		watchesOpts := []builder.WatchesOption{builder.WithPredicates(r.WatchPredicates...)}
+		if !r.isOwnerReferenceController() {
+			// children with a non-controller owner reference are enqueued for each owner
+			ownsOpts = append(ownsOpts, builder.MatchEveryOwner)
+		}
+		if r.onlyMetadata {
+			ownsOpts = append(ownsOpts, builder.OnlyMetadata)
+			watchesOpts = append(watchesOpts, builder.OnlyMetadata)
+		}
+		bldr.Owns(ct, ownsOpts...)
+		bldr.Watches(ct, EnqueueTracked(ctx), watchesOpts...)
 	}
 
-	if err := r.ChildObjectManager.SetupWithManager(ctx, mgr, bldr); err != nil {
+	if err := r.ChildObjectManager.SetupWithManager(stashWatchPredicates(ctx, r.WatchPredicates), mgr, bldr); err != nil {
 		return err
 	}
 
@@ -224,41 +324,100 @@ func (r *ChildReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, mgr
 	return nil
 }
 
+const watchPredicatesStashKey stash.Key = "reconciler.io/runtime:watchPredicates"
+
+// stashWatchPredicates stores the predicates an ObjectManager applies to the watches it registers
+// during setup, see ChildReconciler.WatchPredicates.
+func stashWatchPredicates(ctx context.Context, predicates []predicate.Predicate) context.Context {
+	return context.WithValue(ctx, watchPredicatesStashKey, predicates)
+}
+
+// retrieveWatchPredicates returns the predicates stashed for the watches an ObjectManager
+// registers, or nil if not found.
+func retrieveWatchPredicates(ctx context.Context) []predicate.Predicate {
+	predicates, _ := ctx.Value(watchPredicatesStashKey).([]predicate.Predicate)
+	return predicates
+}
+
 func (r *ChildReconciler[T, CT, CLT]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// require DesiredChild
 	if r.DesiredChild == nil {
-		return fmt.Errorf("ChildReconciler %q must implement DesiredChild", r.Name)
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must implement DesiredChild", r.Name))
+	}
+
+	// require the kind of unstructured children
+	if err := validateUnstructuredKind("ChildReconciler", r.Name, "ChildType", r.ChildType); err != nil {
+		errs = append(errs, err)
 	}
 
 	// require ReflectChildStatusOnParent or ReflectChildStatusOnParentWithError
 	if r.ReflectChildStatusOnParent == nil && r.ReflectChildStatusOnParentWithError == nil {
-		return fmt.Errorf("ChildReconciler %q must implement ReflectChildStatusOnParent or ReflectChildStatusOnParentWithError", r.Name)
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must implement ReflectChildStatusOnParent or ReflectChildStatusOnParentWithError", r.Name))
 	}
 
 	if r.OurChild == nil && r.SkipOwnerReference {
 		// OurChild is required when SkipOwnerReference is true
-		return fmt.Errorf("ChildReconciler %q must implement OurChild since owner references are not used", r.Name)
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must implement OurChild since owner references are not used", r.Name))
 	}
 
 	if r.ListOptions == nil && r.SkipOwnerReference {
 		// ListOptions is required when SkipOwnerReference is true
-		return fmt.Errorf("ChildReconciler %q must implement ListOptions since owner references are not used", r.Name)
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must implement ListOptions since owner references are not used", r.Name))
+	}
+
+	if r.AdoptMatching != nil && r.SkipOwnerReference {
+		// AdoptMatching adds an owner reference to the adopted child
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must not define AdoptMatching since owner references are not used", r.Name))
+	}
+
+	if r.OwnerReferenceController != nil && r.SkipOwnerReference {
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must not define OwnerReferenceController since owner references are not used", r.Name))
+	}
+
+	if r.BlockOwnerDeletion != nil && r.SkipOwnerReference {
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must not define BlockOwnerDeletion since owner references are not used", r.Name))
 	}
 
 	// require ChildObjectManager
 	if r.ChildObjectManager == nil {
-		return fmt.Errorf("ChildReconciler %q must implement ChildObjectManager", r.Name)
+		errs = append(errs, fmt.Errorf("ChildReconciler %q must implement ChildObjectManager", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.ChildObjectManager.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("ChildReconciler %q must have a valid ChildObjectManager: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("ChildReconciler %q must have a valid ChildObjectManager: %w", r.Name, err))
 			}
 		}
 	}
 
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateOwnerScope uses the RESTMapper to reject a namespaced reconciled resource that would own
+// cluster-scoped children, which the API Server disallows. The check is skipped when the scope of
+// either type cannot be determined.
+func (r *ChildReconciler[T, CT, CLT]) validateOwnerScope(ctx context.Context) error {
+	c := RetrieveConfigOrDie(ctx)
+
+	resourceType := RetrieveResourceType(ctx)
+	if resourceType == nil {
+		return nil
+	}
+	resourceNamespaced, err := c.IsObjectNamespaced(resourceType)
+	if err != nil {
+		return nil
+	}
+	childNamespaced, err := c.IsObjectNamespaced(r.ChildType)
+	if err != nil {
+		return nil
+	}
+	if resourceNamespaced && !childNamespaced {
+		return fmt.Errorf("ChildReconciler %q must define SkipOwnerReference since a namespaced resource cannot own a cluster-scoped child", r.Name)
+	}
 	return nil
 }
 
@@ -272,7 +431,7 @@ func (r *ChildReconciler[T, CT, CLT]) Reconcile(ctx context.Context, resource T)
 		WithValues("childType", gvk(c, r.ChildType))
 	ctx = logr.NewContext(ctx, log)
 
-	child, err := r.reconcile(ctx, resource)
+	child, desired, err := r.reconcile(ctx, resource)
 	if resource.GetDeletionTimestamp() != nil {
 		return Result{}, err
 	}
@@ -284,7 +443,13 @@ func (r *ChildReconciler[T, CT, CLT]) Reconcile(ctx context.Context, resource T)
 				// on the reconciled resource as being not ready.
 				apierr := err.(apierrs.APIStatus)
 				conflicted := r.ChildType.DeepCopyObject().(CT)
-				_ = c.APIReader.Get(ctx, types.NamespacedName{Namespace: resource.GetNamespace(), Name: apierr.Status().Details.Name}, conflicted)
+				// the desired child's namespace is authoritative, a cluster-scoped resource may own
+				// children in any namespace
+				namespace := resource.GetNamespace()
+				if !internal.IsNil(desired) {
+					namespace = desired.GetNamespace()
+				}
+				_ = c.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: apierr.Status().Details.Name}, conflicted)
 				if r.ourChild(resource, conflicted) {
 					// skip updating the reconciled resource's status, fail and try again
 					return Result{}, err
@@ -323,7 +488,7 @@ func (r *ChildReconciler[T, CT, CLT]) shouldReflectError(err error) bool {
 	return false
 }
 
-func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T) (CT, error) {
+func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T) (CT, CT, error) {
 	var nilCT CT
 	log := logr.FromContextOrDiscard(ctx)
 	c := RetrieveConfigOrDie(ctx)
@@ -334,10 +499,14 @@ func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T)
 		// use existing known children when available, fall back to lookup
 		list := r.ChildListType.DeepCopyObject().(CLT)
 		if err := c.List(ctx, list, r.listOptions(ctx, resource)...); err != nil {
-			return nilCT, err
+			return nilCT, nilCT, err
 		}
 		children = extractItems[CT](list)
 	}
+	children, err := r.adoptChildren(ctx, resource, children)
+	if err != nil {
+		return nilCT, nilCT, err
+	}
 	children = r.filterChildren(resource, children)
 	if len(children) == 1 {
 		actual = children[0]
@@ -346,7 +515,7 @@ func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T)
 		for _, extra := range children {
 			log.Info("extra child detected", "child", namespaceName(extra))
 			if _, err := r.ChildObjectManager.Manage(ctx, resource, extra, nilCT); err != nil {
-				return nilCT, err
+				return nilCT, nilCT, err
 			}
 		}
 	}
@@ -354,14 +523,15 @@ func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T)
 	desired, err := r.desiredChild(ctx, resource)
 	if err != nil {
 		if errors.Is(err, OnlyReconcileChildStatus) {
-			return actual, nil
+			r.logDecision(ctx, childActionSkipped, actual)
+			return actual, nilCT, nil
 		}
-		return nilCT, err
+		return nilCT, nilCT, err
 	}
 	if !internal.IsNil(desired) {
-		if !r.SkipOwnerReference && metav1.GetControllerOfNoCopy(desired) == nil {
-			if err := r.setControllerReference(ctx, resource, desired); err != nil {
-				return nilCT, err
+		if !r.SkipOwnerReference && !r.hasOwnerReference(resource, desired) {
+			if err := r.setOwnerReference(ctx, resource, desired); err != nil {
+				return nilCT, desired, err
 			}
 		}
 		if !r.ourChild(resource, desired) {
@@ -370,7 +540,36 @@ func (r *ChildReconciler[T, CT, CLT]) reconcile(ctx context.Context, resource T)
 	}
 
 	// create/update/delete desired child
-	return r.ChildObjectManager.Manage(ctx, resource, actual, desired)
+	child, err := r.ChildObjectManager.Manage(ctx, resource, actual, desired)
+	if err != nil {
+		return child, desired, err
+	}
+	if internal.IsNil(desired) {
+		r.logDecision(ctx, childAction(actual, desired, child), actual)
+	} else {
+		r.logDecision(ctx, childAction(actual, desired, child), desired)
+	}
+	return child, desired, nil
+}
+
+// childActionSkipped is logged when the child was not managed
+const childActionSkipped ChildSetAction = "Skipped"
+
+// logDecision logs the action taken for the child at a debug level. Only successful decisions are
+// logged, errors, including quiet errors, are returned to the caller instead.
+func (r *ChildReconciler[T, CT, CLT]) logDecision(ctx context.Context, action ChildSetAction, child CT) {
+	c := RetrieveConfigOrDie(ctx)
+	if action == "" {
+		action = childActionSkipped
+	}
+	keysAndValues := []interface{}{"action", action, "gvk", gvk(c, r.ChildType)}
+	if !internal.IsNil(child) && child.GetName() != "" {
+		keysAndValues = append(keysAndValues, "name", namespaceName(child))
+	}
+	if r.id != "" {
+		keysAndValues = append(keysAndValues, "id", r.id)
+	}
+	logr.FromContextOrDiscard(ctx).V(1).Info("child reconciled", keysAndValues...)
 }
 
 func (r *ChildReconciler[T, CT, CLT]) desiredChild(ctx context.Context, resource T) (CT, error) {
@@ -381,7 +580,98 @@ func (r *ChildReconciler[T, CT, CLT]) desiredChild(ctx context.Context, resource
 		return nilCT, nil
 	}
 
-	return r.DesiredChild(ctx, resource)
+	desired, err := r.DesiredChild(ctx, resource)
+	if err != nil || internal.IsNil(desired) || r.DesiredChildHash == nil {
+		return desired, err
+	}
+	if err := setChildHash(desired, r.DesiredChildHash(resource)); err != nil {
+		return nilCT, err
+	}
+	return desired, nil
+}
+
+// ChildHashAnnotation holds the hash returned from ChildReconciler#DesiredChildHash
+const ChildHashAnnotation = "reconciler.io/child-hash"
+
+// setChildHash sets the hash as the ChildHashAnnotation on the child, and on the child's pod
+// template when the child has a `spec.template`.
+func setChildHash(child client.Object, hash string) error {
+	annotations := child.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ChildHashAnnotation] = hash
+	child.SetAnnotations(annotations)
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(child)
+	if err != nil {
+		return err
+	}
+	if _, found, _ := unstructured.NestedMap(content, "spec", "template"); !found {
+		return nil
+	}
+	if err := unstructured.SetNestedField(content, hash, "spec", "template", "metadata", "annotations", ChildHashAnnotation); err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(content, child)
+}
+
+// adoptChildren sets a controller reference on each unowned child matched by AdoptMatching. The
+// returned children include the adopted children in place of the unowned originals.
+func (r *ChildReconciler[T, CT, CLT]) adoptChildren(ctx context.Context, resource T, children []CT) ([]CT, error) {
+	if r.AdoptMatching == nil || r.SkipOwnerReference || resource.GetDeletionTimestamp() != nil {
+		return children, nil
+	}
+
+	items := make([]CT, len(children))
+	for i, child := range children {
+		items[i] = child
+		if r.isOwnedBy(child, resource) || child.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if r.isOwnerReferenceController() && metav1.GetControllerOfNoCopy(child) != nil {
+			// controlled by another resource
+			continue
+		}
+		if r.OurChild != nil && !r.OurChild(resource, child) {
+			continue
+		}
+		if !r.AdoptMatching(resource, child) {
+			continue
+		}
+		adopted, err := r.adoptChild(ctx, resource, child)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = adopted
+	}
+	return items, nil
+}
+
+func (r *ChildReconciler[T, CT, CLT]) adoptChild(ctx context.Context, resource T, child CT) (CT, error) {
+	var nilCT CT
+	log := logr.FromContextOrDiscard(ctx)
+	pc := RetrieveOriginalConfigOrDie(ctx)
+	c := RetrieveConfigOrDie(ctx)
+
+	adopted := child.DeepCopyObject().(CT)
+	if err := r.setOwnerReference(ctx, resource, adopted); err != nil {
+		return nilCT, err
+	}
+
+	log.Info("adopting child", "child", namespaceName(child))
+	if err := c.Patch(ctx, adopted, client.MergeFromWithOptions(child, client.MergeFromWithOptimisticLock{})); err != nil {
+		if !errors.Is(err, ErrQuiet) {
+			log.Error(err, "unable to adopt child", "child", namespaceName(child))
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "AdoptFailed",
+				"Failed to adopt %s %q: %v", typeName(child), child.GetName(), err)
+		}
+		return nilCT, err
+	}
+	pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Adopted",
+		"Adopted %s %q", typeName(child), child.GetName())
+
+	return adopted, nil
 }
 
 func (r *ChildReconciler[T, CT, CLT]) filterChildren(resource T, children []CT) []CT {
@@ -404,7 +694,7 @@ func (r *ChildReconciler[T, CT, CLT]) listOptions(ctx context.Context, resource
 }
 
 func (r *ChildReconciler[T, CT, CLT]) ourChild(resource T, obj CT) bool {
-	if !r.SkipOwnerReference && !metav1.IsControlledBy(obj, resource) {
+	if !r.SkipOwnerReference && !r.isOwnedBy(obj, resource) {
 		return false
 	}
 	// TODO do we need to remove resources pending deletion?
@@ -414,7 +704,45 @@ func (r *ChildReconciler[T, CT, CLT]) ourChild(resource T, obj CT) bool {
 	return r.OurChild(resource, obj)
 }
 
-// From controller-runtime, modified to support duck types
+func (r *ChildReconciler[T, CT, CLT]) isOwnerReferenceController() bool {
+	return r.OwnerReferenceController == nil || *r.OwnerReferenceController
+}
+
+// isOwnedBy returns true if the object has an owner reference to the owner, which must be a
+// controller reference unless OwnerReferenceController is false.
+func (r *ChildReconciler[T, CT, CLT]) isOwnedBy(obj, owner metav1.Object) bool {
+	if r.isOwnerReferenceController() {
+		return metav1.IsControlledBy(obj, owner)
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOwnerReference returns true if the desired child already defines the owner reference set by
+// setOwnerReference.
+func (r *ChildReconciler[T, CT, CLT]) hasOwnerReference(owner, desired metav1.Object) bool {
+	if r.isOwnerReferenceController() {
+		return metav1.GetControllerOfNoCopy(desired) != nil
+	}
+	return r.isOwnedBy(desired, owner)
+}
+
+// setOwnerReference adds an owner reference for the owner to the object, honoring
+// OwnerReferenceController and BlockOwnerDeletion.
+func (r *ChildReconciler[T, CT, CLT]) setOwnerReference(ctx context.Context, owner, object metav1.Object) error {
+	return r.setControllerReference(ctx, owner, object, func(ref *metav1.OwnerReference) {
+		ref.Controller = ptr.To(r.isOwnerReferenceController())
+		if r.BlockOwnerDeletion != nil {
+			ref.BlockOwnerDeletion = ptr.To(*r.BlockOwnerDeletion)
+		}
+	})
+}
+
+// From controller-runtime, modified to support duck types and non-controller owner references
 func (r *ChildReconciler[T, CT, CLT]) setControllerReference(ctx context.Context, owner, controlled metav1.Object, opts ...controllerutil.OwnerReferenceOption) error {
 	// Validate the owner.
 	ro, ok := owner.(runtime.Object)
@@ -443,8 +771,10 @@ func (r *ChildReconciler[T, CT, CLT]) setControllerReference(ctx context.Context
 	}
 
 	// Return early with an error if the object is already controlled.
-	if existing := metav1.GetControllerOf(controlled); existing != nil && !r.referSameObject(*existing, ref) {
-		return r.newAlreadyOwnedError(controlled, *existing)
+	if ptr.Deref(ref.Controller, false) {
+		if existing := metav1.GetControllerOf(controlled); existing != nil && !r.referSameObject(*existing, ref) {
+			return r.newAlreadyOwnedError(controlled, *existing)
+		}
 	}
 
 	// Update owner references and return.
@@ -19,17 +19,27 @@ package reconcilers_test
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 	diecorev1 "reconciler.io/dies/apis/core/v1"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
@@ -38,7 +48,15 @@ import (
 	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
 	rtesting "reconciler.io/runtime/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 func TestChildReconciler(t *testing.T) {
@@ -250,37 +268,455 @@ func TestChildReconciler(t *testing.T) {
 					}),
 			},
 		},
+		"adopt unowned child": {
+			Resource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.AdoptMatching = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return child.Name == resource.Name
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Adopted", `Adopted ConfigMap %q`, testName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "",
+					Kind:      "ConfigMap",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"ownerReferences":[{"apiVersion":"testing.reconciler.runtime/v1","blockOwnerDeletion":true,"controller":true,"kind":"TestResource","name":"test-resource","uid":""}],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"ignore unowned child not matching AdoptMatching": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("other")
+						d.OwnerReferences()
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.AdoptMatching = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return child.Name == resource.Name
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+		},
+		"does not adopt a child controlled by another resource": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("other")
+						d.ControlledBy(resource.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("other")
+							d.UID(types.UID("b1f2ac6e-2d4e-4d4f-9a4f-3c9e0c1d2e3f"))
+						}), scheme)
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.AdoptMatching = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return true
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+		},
+		"error adopting child": {
+			Resource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "ConfigMap"),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.AdoptMatching = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return true
+					}
+					return r
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "AdoptFailed", `Failed to adopt ConfigMap %q: inducing failure for patch ConfigMap`, testName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "",
+					Kind:      "ConfigMap",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"ownerReferences":[{"apiVersion":"testing.reconciler.runtime/v1","blockOwnerDeletion":true,"controller":true,"kind":"TestResource","name":"test-resource","uid":""}],"resourceVersion":"999"}}`),
+				},
+			},
+			ShouldErr: true,
+		},
+		"create child with non-controller owner reference": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.OwnerReferenceController = ptr.To(false)
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences(
+							metav1.OwnerReference{
+								APIVersion:         resources.GroupVersion.String(),
+								Kind:               "TestResource",
+								Name:               resource.GetName(),
+								UID:                resource.GetUID(),
+								Controller:         ptr.To(false),
+								BlockOwnerDeletion: ptr.To(true),
+							},
+						)
+					}),
+			},
+		},
+		"create child with owner reference not blocking owner deletion": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.BlockOwnerDeletion = ptr.To(false)
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences(
+							metav1.OwnerReference{
+								APIVersion:         resources.GroupVersion.String(),
+								Kind:               "TestResource",
+								Name:               resource.GetName(),
+								UID:                resource.GetUID(),
+								Controller:         ptr.To(true),
+								BlockOwnerDeletion: ptr.To(false),
+							},
+						)
+					}),
+			},
+		},
+		"child with non-controller owner reference is in sync, controlled by another resource": {
+			Resource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences(
+							metav1.OwnerReference{
+								APIVersion:         resources.GroupVersion.String(),
+								Kind:               "TestResource",
+								Name:               "other",
+								UID:                types.UID("b1f2ac6e-2d4e-4d4f-9a4f-3c9e0c1d2e3f"),
+								Controller:         ptr.To(true),
+								BlockOwnerDeletion: ptr.To(true),
+							},
+							metav1.OwnerReference{
+								APIVersion:         resources.GroupVersion.String(),
+								Kind:               "TestResource",
+								Name:               resource.GetName(),
+								UID:                resource.GetUID(),
+								Controller:         ptr.To(false),
+								BlockOwnerDeletion: ptr.To(true),
+							},
+						)
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.OwnerReferenceController = ptr.To(false)
+					return r
+				},
+			},
+		},
+		"adopt child controlled by another resource with non-controller owner reference": {
+			Resource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences(
+							metav1.OwnerReference{
+								APIVersion:         resources.GroupVersion.String(),
+								Kind:               "TestResource",
+								Name:               "other",
+								UID:                types.UID("b1f2ac6e-2d4e-4d4f-9a4f-3c9e0c1d2e3f"),
+								Controller:         ptr.To(true),
+								BlockOwnerDeletion: ptr.To(true),
+							},
+						)
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.OwnerReferenceController = ptr.To(false)
+					r.AdoptMatching = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return child.Name == resource.Name
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Adopted", `Adopted ConfigMap %q`, testName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "",
+					Kind:      "ConfigMap",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"ownerReferences":[{"apiVersion":"testing.reconciler.runtime/v1","blockOwnerDeletion":true,"controller":true,"kind":"TestResource","name":"other","uid":"b1f2ac6e-2d4e-4d4f-9a4f-3c9e0c1d2e3f"},{"apiVersion":"testing.reconciler.runtime/v1","blockOwnerDeletion":true,"controller":false,"kind":"TestResource","name":"test-resource","uid":""}],"resourceVersion":"999"}}`),
+				},
+			},
+		},
 		"update child": {
 			Resource: resourceReady.
 				SpecDie(func(d *dies.TestResourceSpecDie) {
 					d.AddField("foo", "bar")
-					d.AddField("new", "field")
+					d.AddField("new", "field")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return defaultChildReconciler(c)
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+					d.AddField("new", "field")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+					d.AddField("new", "field")
+				}).
+				DieReleasePtr(),
+			ExpectUpdates: []client.Object{
+				configMapGiven.
+					AddData("new", "field"),
+			},
+		},
+		"create child with hash": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("test-input", "abc")
+				}).
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.DesiredChildHash = func(resource *resources.TestResource) string {
+						return resource.Annotations["test-input"]
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("test-input", "abc")
+				}).
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.ChildHashAnnotation, "abc")
+					}),
+			},
+		},
+		"child with hash is in sync": {
+			Resource: resourceReady.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("test-input", "abc")
+				}).
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
 				}).
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("foo", "bar")
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
-				configMapGiven,
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.ChildHashAnnotation, "abc")
+					}),
 			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-					return defaultChildReconciler(c)
+					r := defaultChildReconciler(c)
+					r.DesiredChildHash = func(resource *resources.TestResource) string {
+						return resource.Annotations["test-input"]
+					}
+					return r
 				},
 			},
-			ExpectResource: resourceReady.
+		},
+		"update child when hash changes": {
+			Resource: resourceReady.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("test-input", "def")
+				}).
 				SpecDie(func(d *dies.TestResourceSpecDie) {
 					d.AddField("foo", "bar")
-					d.AddField("new", "field")
 				}).
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("foo", "bar")
-					d.AddField("new", "field")
 				}).
 				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.ChildHashAnnotation, "abc")
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.DesiredChildHash = func(resource *resources.TestResource) string {
+						return resource.Annotations["test-input"]
+					}
+					return r
+				},
+			},
 			ExpectUpdates: []client.Object{
 				configMapGiven.
-					AddData("new", "field"),
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.ChildHashAnnotation, "def")
+					}),
 			},
 		},
 		"delete child": {
@@ -622,37 +1058,472 @@ func TestChildReconciler(t *testing.T) {
 						d.Name("extra-child-2")
 					}),
 			},
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("delete", "ConfigMap"),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("delete", "ConfigMap"),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return defaultChildReconciler(c)
+				},
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				{Group: "", Kind: "ConfigMap", Namespace: testNamespace, Name: "extra-child-1"},
+			},
+			ShouldErr: true,
+		},
+		"error creating desired child": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildReconciler(c)
+					r.DesiredChild = func(ctx context.Context, parent *resources.TestResource) (*corev1.ConfigMap, error) {
+						return nil, fmt.Errorf("test error")
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+		},
+		"log child decision": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return defaultChildReconciler(c)
+				},
+			},
+			Prepare: func(t *testing.T, ctx context.Context, tc *rtesting.SubReconcilerTestCase[*resources.TestResource]) (context.Context, error) {
+				lines := []string{}
+				tc.Metadata["lines"] = &lines
+				log := funcr.New(func(prefix, args string) {
+					if strings.Contains(args, `"msg"="child reconciled"`) {
+						lines = append(lines, args)
+					}
+				}, funcr.Options{Verbosity: 1})
+				return logr.NewContext(ctx, log), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, tc *rtesting.SubReconcilerTestCase[*resources.TestResource]) error {
+				expected := []string{
+					`"level"=1 "msg"="child reconciled" "childType"="/v1, Kind=ConfigMap" "action"="Created" "gvk"="/v1, Kind=ConfigMap" "name"={"name"="test-resource" "namespace"="test-namespace"}`,
+				}
+				if diff := cmp.Diff(expected, *tc.Metadata["lines"].(*[]string)); diff != "" {
+					t.Errorf("unexpected log lines (-expected, +actual): %s", diff)
+				}
+				return nil
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c)
+	})
+}
+
+func TestChildReconciler_DesiredChildHash(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.AddAnnotation("test-input", "abc")
+		})
+
+	deploymentCreate := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testName,
+			Annotations: map[string]string{
+				reconcilers.ChildHashAnnotation: "abc",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         resources.GroupVersion.String(),
+					Kind:               "TestResource",
+					Name:               testName,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(true),
+				},
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": testName,
+					},
+					Annotations: map[string]string{
+						reconcilers.ChildHashAnnotation: "abc",
+					},
+				},
+			},
+		},
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"sets the hash on the pod template": {
+			Resource: resource.DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				deploymentCreate,
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.ChildReconciler[*resources.TestResource, *appsv1.Deployment, *appsv1.DeploymentList]{
+			DesiredChild: func(ctx context.Context, parent *resources.TestResource) (*appsv1.Deployment, error) {
+				return &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: parent.Namespace,
+						Name:      parent.Name,
+					},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{
+									"app": parent.Name,
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			DesiredChildHash: func(resource *resources.TestResource) string {
+				return resource.Annotations["test-input"]
+			},
+			ChildObjectManager:         &rtesting.StubObjectManager[*appsv1.Deployment]{},
+			ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *appsv1.Deployment, err error) {},
+		}
+	})
+}
+
+func TestChildReconciler_ClusterScoped(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	apiResources := []*metav1.APIResourceList{
+		{
+			GroupVersion: resources.GroupVersion.String(),
+			APIResources: []metav1.APIResource{
+				{
+					Name:         "testresources",
+					SingularName: "testresource",
+					Namespaced:   false,
+					Group:        resources.GroupVersion.Group,
+					Version:      resources.GroupVersion.Version,
+					Kind:         "TestResource",
+				},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{
+					Name:         "configmaps",
+					SingularName: "configmap",
+					Namespaced:   true,
+					Version:      "v1",
+					Kind:         "ConfigMap",
+				},
+			},
+		},
+	}
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName)
+		}).
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("foo", "bar")
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+			)
+		})
+	resourceReady := resource.
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+			)
+		})
+
+	configMapCreate := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.ControlledBy(resource, scheme)
+		}).
+		AddData("foo", "bar")
+	configMapGiven := configMapCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+		})
+
+	defaultChildReconciler := func(_ reconcilers.Config) *reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList] {
+		return &reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+			DesiredChild: func(ctx context.Context, parent *resources.TestResource) (*corev1.ConfigMap, error) {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						// a cluster-scoped parent must choose the namespace for its children
+						Namespace: testNamespace,
+						Name:      parent.Name,
+					},
+					Data: reconcilers.MergeMaps(parent.Spec.Fields),
+				}, nil
+			},
+			ChildObjectManager: &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+			ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {
+				if err != nil {
+					if apierrs.IsAlreadyExists(err) {
+						name := err.(apierrs.APIStatus).Status().Details.Name
+						parent.Status.MarkNotReady(ctx, "NameConflict", "%q already exists", name)
+					}
+					return
+				}
+				parent.Status.Fields = reconcilers.MergeMaps(child.Data)
+				parent.Status.MarkReady(ctx)
+			},
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"create namespaced child": {
+			GivenAPIResources: apiResources,
+			Resource:          resource.DieReleasePtr(),
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+		},
+		"child is in sync, listed across namespaces": {
+			GivenAPIResources: apiResources,
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven,
+			},
+		},
+		"child name collision in the child's namespace": {
+			GivenAPIResources: apiResources,
+			Resource:          resourceReady.DieReleasePtr(),
+			APIGivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("create", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: apierrs.NewAlreadyExists(schema.GroupResource{}, testName),
+				}),
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionFalse).
+							Reason("NameConflict").Message(`"test-resource" already exists`),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(configMapGiven, resource, scheme),
 			},
-			Metadata: map[string]interface{}{
-				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-					return defaultChildReconciler(c)
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return defaultChildReconciler(c)
+	})
+}
+
+func TestChildReconciler_SetupWithManager_OwnerScope(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+		GivenAPIResources: []*metav1.APIResourceList{
+			{
+				GroupVersion: resources.GroupVersion.String(),
+				APIResources: []metav1.APIResource{
+					{
+						Name:         "testresources",
+						SingularName: "testresource",
+						Namespaced:   true,
+						Group:        resources.GroupVersion.Group,
+						Version:      resources.GroupVersion.Version,
+						Kind:         "TestResource",
+					},
 				},
 			},
-			ExpectDeletes: []rtesting.DeleteRef{
-				{Group: "", Kind: "ConfigMap", Namespace: testNamespace, Name: "extra-child-1"},
-			},
-			ShouldErr: true,
-		},
-		"error creating desired child": {
-			Resource: resource.DieReleasePtr(),
-			Metadata: map[string]interface{}{
-				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-					r := defaultChildReconciler(c)
-					r.DesiredChild = func(ctx context.Context, parent *resources.TestResource) (*corev1.ConfigMap, error) {
-						return nil, fmt.Errorf("test error")
-					}
-					return r
+			{
+				GroupVersion: rbacv1.SchemeGroupVersion.String(),
+				APIResources: []metav1.APIResource{
+					{
+						Name:         "clusterroles",
+						SingularName: "clusterrole",
+						Namespaced:   false,
+						Group:        rbacv1.SchemeGroupVersion.Group,
+						Version:      rbacv1.SchemeGroupVersion.Version,
+						Kind:         "ClusterRole",
+					},
 				},
 			},
-			ShouldErr: true,
 		},
 	}
 
-	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c)
-	})
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	r := &reconcilers.ChildReconciler[*resources.TestResource, *rbacv1.ClusterRole, *rbacv1.ClusterRoleList]{
+		DesiredChild: func(ctx context.Context, resource *resources.TestResource) (*rbacv1.ClusterRole, error) {
+			return nil, nil
+		},
+		ChildObjectManager:         &rtesting.StubObjectManager[*rbacv1.ClusterRole]{},
+		ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *rbacv1.ClusterRole, err error) {},
+	}
+
+	err := r.SetupWithManager(ctx, nil, nil)
+	if expected := `ChildReconciler "ClusterRoleChildReconciler" must define SkipOwnerReference since a namespaced resource cannot own a cluster-scoped child`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestChildReconciler_SetupWithManager_UnregisteredChild(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+	}
+
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	r := &reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+		DesiredChild: func(ctx context.Context, resource *resources.TestResource) (*corev1.ConfigMap, error) {
+			return nil, nil
+		},
+		ChildObjectManager:         &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+		ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {},
+	}
+
+	err := r.SetupWithManager(ctx, nil, nil)
+	if expected := `ChildReconciler "ConfigMapChildReconciler" ChildType ConfigMap must be registered in the scheme`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestChildReconciler_SetupWithManager_WatchPredicates(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+	}
+
+	child := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-child")
+		}).
+		DieReleasePtr()
+
+	tests := map[string]struct {
+		skipOwnerReference bool
+		// the number of watches started, including the watch for the reconciled resource
+		watches int
+		// the number of watches filtering an event for the child
+		filtered int
+	}{
+		"owner references": {
+			watches:  3,
+			filtered: 2,
+		},
+		"skip owner references": {
+			skipOwnerReference: true,
+			watches:            2,
+			filtered:           1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+			ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+			filtered := []string{}
+			r := &reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				DesiredChild: func(ctx context.Context, resource *resources.TestResource) (*corev1.ConfigMap, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{
+					MergeBeforeUpdate: func(current, desired *corev1.ConfigMap) {},
+					TrackDesired:      tc.skipOwnerReference,
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {},
+				WatchPredicates: []predicate.Predicate{
+					predicate.NewPredicateFuncs(func(obj client.Object) bool {
+						filtered = append(filtered, obj.GetName())
+						return false
+					}),
+				},
+			}
+			if tc.skipOwnerReference {
+				r.SkipOwnerReference = true
+				r.OurChild = func(resource *resources.TestResource, child *corev1.ConfigMap) bool { return true }
+				r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+					return []client.ListOption{}
+				}
+			}
+
+			recorder := startWatches(t, ctx, scheme, tc.watches, r.SetupWithManager)
+			if err := recorder.Add(ctx, child.DeepCopy()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if expected, actual := tc.filtered, len(filtered); expected != actual {
+				t.Errorf("expected the event to be filtered by %d watches, got %d", expected, actual)
+			}
+		})
+	}
 }
 
 func TestChildReconciler_Unstructured(t *testing.T) {
@@ -850,6 +1721,34 @@ func TestChildReconciler_Unstructured(t *testing.T) {
 				configMapCreate.DieReleaseUnstructured(),
 			},
 		},
+		"create child, derived ChildListType": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleaseUnstructured(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.CalledAtMostTimes("list", "ConfigMapList", 1),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					r := defaultChildReconciler(c)
+					r.ChildListType = nil
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleaseUnstructured(),
+			ExpectCreates: []client.Object{
+				configMapCreate.DieReleaseUnstructured(),
+			},
+		},
 		"update child": {
 			Resource: resourceReady.
 				SpecDie(func(d *dies.TestResourceSpecDie) {
@@ -1330,7 +2229,7 @@ func TestChildReconciler_Validate(t *testing.T) {
 			name:       "empty",
 			parent:     &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{},
-			shouldErr:  `ChildReconciler "PodChildReconciler" must implement DesiredChild`,
+			shouldErr:  `[ChildReconciler "PodChildReconciler" must implement DesiredChild, ChildReconciler "PodChildReconciler" must implement ReflectChildStatusOnParent or ReflectChildStatusOnParentWithError, ChildReconciler "PodChildReconciler" must implement ChildObjectManager]`,
 		},
 		{
 			name:   "valid",
@@ -1462,7 +2361,93 @@ func TestChildReconciler_Validate(t *testing.T) {
 				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
 				SkipOwnerReference:         true,
 			},
-			shouldErr: `ChildReconciler "SkipOwnerReference without OurChild" must implement OurChild since owner references are not used`,
+			shouldErr: `[ChildReconciler "SkipOwnerReference without OurChild" must implement OurChild since owner references are not used, ChildReconciler "SkipOwnerReference without OurChild" must implement ListOptions since owner references are not used]`,
+		},
+		{
+			name:   "AdoptMatching",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChild:  func(ctx context.Context, parent *corev1.ConfigMap) (*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
+				AdoptMatching:              func(resource *corev1.ConfigMap, child *corev1.Pod) bool { return true },
+			},
+		},
+		{
+			name:   "AdoptMatching with SkipOwnerReference",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:          "AdoptMatching with SkipOwnerReference",
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChild:  func(ctx context.Context, parent *corev1.ConfigMap) (*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
+				SkipOwnerReference:         true,
+				ListOptions:                func(ctx context.Context, parent *corev1.ConfigMap) []client.ListOption { return []client.ListOption{} },
+				OurChild:                   func(resource *corev1.ConfigMap, child *corev1.Pod) bool { return true },
+				AdoptMatching:              func(resource *corev1.ConfigMap, child *corev1.Pod) bool { return true },
+			},
+			shouldErr: `ChildReconciler "AdoptMatching with SkipOwnerReference" must not define AdoptMatching since owner references are not used`,
+		},
+		{
+			name:   "OwnerReferenceController and BlockOwnerDeletion",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChild:  func(ctx context.Context, parent *corev1.ConfigMap) (*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
+				OwnerReferenceController:   ptr.To(false),
+				BlockOwnerDeletion:         ptr.To(false),
+			},
+		},
+		{
+			name:   "OwnerReferenceController with SkipOwnerReference",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:          "OwnerReferenceController with SkipOwnerReference",
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChild:  func(ctx context.Context, parent *corev1.ConfigMap) (*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
+				SkipOwnerReference:         true,
+				ListOptions:                func(ctx context.Context, parent *corev1.ConfigMap) []client.ListOption { return []client.ListOption{} },
+				OurChild:                   func(resource *corev1.ConfigMap, child *corev1.Pod) bool { return true },
+				OwnerReferenceController:   ptr.To(false),
+			},
+			shouldErr: `ChildReconciler "OwnerReferenceController with SkipOwnerReference" must not define OwnerReferenceController since owner references are not used`,
+		},
+		{
+			name:   "BlockOwnerDeletion with SkipOwnerReference",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:          "BlockOwnerDeletion with SkipOwnerReference",
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChild:  func(ctx context.Context, parent *corev1.ConfigMap) (*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, child *corev1.Pod, err error) {},
+				SkipOwnerReference:         true,
+				ListOptions:                func(ctx context.Context, parent *corev1.ConfigMap) []client.ListOption { return []client.ListOption{} },
+				OurChild:                   func(resource *corev1.ConfigMap, child *corev1.Pod) bool { return true },
+				BlockOwnerDeletion:         ptr.To(true),
+			},
+			shouldErr: `ChildReconciler "BlockOwnerDeletion with SkipOwnerReference" must not define BlockOwnerDeletion since owner references are not used`,
 		},
 		{
 			name:   "OurChild",
@@ -1509,3 +2494,182 @@ func TestChildReconciler_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestChildReconciler_Validate_Unstructured(t *testing.T) {
+	configMapType := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.ChildReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.ChildReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				ChildType: configMapType(),
+				DesiredChild: func(ctx context.Context, parent *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, child *unstructured.Unstructured, err error) {
+				},
+			},
+		},
+		{
+			name: "ChildType missing",
+			reconciler: &reconcilers.ChildReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				Name: "ChildType missing",
+				// ChildType:          configMapType(),
+				DesiredChild: func(ctx context.Context, parent *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, child *unstructured.Unstructured, err error) {
+				},
+			},
+			shouldErr: `ChildReconciler "ChildType missing" must define ChildType with an apiVersion and kind for unstructured types`,
+		},
+		{
+			name: "ChildType missing kind",
+			reconciler: &reconcilers.ChildReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				Name: "ChildType missing kind",
+				ChildType: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "v1",
+					},
+				},
+				DesiredChild: func(ctx context.Context, parent *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, child *unstructured.Unstructured, err error) {
+				},
+			},
+			shouldErr: `ChildReconciler "ChildType missing kind" must define ChildType with an apiVersion and kind for unstructured types`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := reconcilers.StashResourceType(context.TODO(), configMapType())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				var errString string
+				if err != nil {
+					errString = err.Error()
+				}
+				t.Errorf("validate() error = %q, shouldErr %q", errString, c.shouldErr)
+			}
+		})
+	}
+}
+
+// watchRecorder is a cache of fake informers that records the object of each informer requested
+// by a watch.
+type watchRecorder struct {
+	*informertest.FakeInformers
+
+	m       sync.Mutex
+	watched []client.Object
+}
+
+func (c *watchRecorder) GetInformer(ctx context.Context, obj client.Object, opts ...cache.InformerGetOption) (cache.Informer, error) {
+	// the fake informers are not safe for concurrent use
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.watched = append(c.watched, obj)
+	informer, err := c.FakeInformers.GetInformer(ctx, obj, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &lockedInformer{Informer: informer, m: &c.m}, nil
+}
+
+// lockedInformer serializes adding event handlers, which the fake informer is not safe to do
+// concurrently.
+type lockedInformer struct {
+	cache.Informer
+	m *sync.Mutex
+}
+
+func (i *lockedInformer) AddEventHandlerWithOptions(handler toolscache.ResourceEventHandler, opts toolscache.HandlerOptions) (toolscache.ResourceEventHandlerRegistration, error) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return i.Informer.AddEventHandlerWithOptions(handler, opts)
+}
+
+// Add sends an add event for the object to the handlers of its informer
+func (c *watchRecorder) Add(ctx context.Context, obj client.Object) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	informer, err := c.FakeInformers.FakeInformerFor(ctx, obj)
+	if err != nil {
+		return err
+	}
+	informer.Add(obj)
+	return nil
+}
+
+// Watched returns the objects watched so far
+func (c *watchRecorder) Watched() []client.Object {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return append([]client.Object{}, c.watched...)
+}
+
+// startWatches builds a controller for TestResource with the watches registered by setup, and
+// starts the controller's watches against fake informers. The recorder is returned once the
+// number of watches expected are started.
+func startWatches(t *testing.T, ctx context.Context, scheme *runtime.Scheme, expected int, setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error) *watchRecorder {
+	t.Helper()
+
+	recorder := &watchRecorder{
+		FakeInformers: &informertest.FakeInformers{Scheme: scheme},
+	}
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://localhost"}, ctrl.Options{
+		Scheme: scheme,
+		NewCache: func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+			return recorder, nil
+		},
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		Controller:             ctrlconfig.Controller{SkipNameValidation: ptr.To(true)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected manager error: %v", err)
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).For(&resources.TestResource{})
+	if err := setup(ctx, mgr, bldr); err != nil {
+		t.Fatalf("unexpected setup error: %v", err)
+	}
+	controller, err := bldr.Build(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected build error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	go func() {
+		_ = controller.Start(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(recorder.Watched()) < expected {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d watches to start, got %d", expected, len(recorder.Watched()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return recorder
+}
@@ -18,16 +18,28 @@ package reconcilers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"reconciler.io/runtime/internal"
 	"reconciler.io/runtime/stash"
+	rtime "reconciler.io/runtime/time"
 	"reconciler.io/runtime/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -38,6 +50,10 @@ var (
 	_ SubReconciler[client.Object] = (*ChildSetReconciler[client.Object, client.Object, client.ObjectList])(nil)
 )
 
+// continueNotSupported is the continue token set by the informer cache on a list that was
+// truncated to the requested limit. The cache is not able to resume the list from the token.
+const continueNotSupported = "continue-not-supported"
+
 // ChildSetReconciler is a sub reconciler that manages a set of child resources for a reconciled
 // resource. A correlation ID is used to track the desired state of each child resource across
 // reconcile requests. A ChildReconciler is created dynamically and reconciled for each desired
@@ -59,7 +75,8 @@ type ChildSetReconciler[Type, ChildType client.Object, ChildListType client.Obje
 	ChildType ChildType
 	// ChildListType is the listing type for the child type. For example,
 	// PodList is the list type for Pod. Required when the generic type is not
-	// a struct, or is unstructured.
+	// a struct. For an unstructured ChildType, defaults to an unstructured list
+	// of the ChildType's kind suffixed with List.
 	//
 	// +optional
 	ChildListType ChildListType
@@ -85,6 +102,22 @@ type ChildSetReconciler[Type, ChildType client.Object, ChildListType client.Obje
 	// Any child resource created is tracked for changes.
 	SkipOwnerReference bool
 
+	// OwnerReferenceController sets the controller field of the owner reference added to
+	// children. See ChildReconciler#OwnerReferenceController.
+	//
+	// Defaults to true.
+	//
+	// +optional
+	OwnerReferenceController *bool
+
+	// BlockOwnerDeletion sets the blockOwnerDeletion field of the owner reference added to
+	// children. See ChildReconciler#BlockOwnerDeletion.
+	//
+	// Defaults to true.
+	//
+	// +optional
+	BlockOwnerDeletion *bool
+
 	// Setup performs initialization on the manager and builder this reconciler
 	// will run with. It's common to setup field indexes and watch resources.
 	//
@@ -105,6 +138,29 @@ type ChildSetReconciler[Type, ChildType client.Object, ChildListType client.Obje
 	// status on the reconciled resource, return OnlyReconcileChildStatus as an error.
 	DesiredChildren func(ctx context.Context, resource Type) ([]ChildType, error)
 
+	// DesiredChildrenSources composes the set of desired children from multiple sources as an
+	// alternative to DesiredChildren. Each source is called in order and the returned children are
+	// concatenated before being correlated with IdentifyChild. Identifiers must be unique across
+	// all sources.
+	//
+	// When a source returns OnlyReconcileChildStatus, the remaining sources are still called and
+	// reconciliation is skipped for all child resources.
+	//
+	// +optional
+	DesiredChildrenSources []func(ctx context.Context, resource Type) ([]ChildType, error)
+
+	// DesiredChildrenFunc produces the desired children as an iterator as an alternative to
+	// DesiredChildren. Each child is correlated with IdentifyChild as it is produced. Yielding a
+	// non-nil error stops the iteration with the error, except for OnlyReconcileChildStatus which
+	// skips reconciliation of the child resources while the remaining children are still produced.
+	//
+	// Unlike DesiredChildren, production of children stops as soon as a child is rejected, for
+	// example when its id is a duplicate, so expensive work to build the remaining children, like
+	// paging through an external source, is not performed for a reconcile that will fail.
+	//
+	// +optional
+	DesiredChildrenFunc func(ctx context.Context, resource Type) iter.Seq2[ChildType, error]
+
 	// ChildObjectManager synchronizes the desired child state to the API Server.
 	ChildObjectManager ObjectManager[ChildType]
 
@@ -168,8 +224,78 @@ type ChildSetReconciler[Type, ChildType client.Object, ChildListType client.Obje
 	// Non-deterministic IDs will result in the rapid deletion and creation of child resources.
 	IdentifyChild func(child ChildType) string
 
+	// VerifyIdentifyChildStability when true checks, for each desired child, that IdentifyChild
+	// returns the same value for the child after it is round-tripped through the API Server. The
+	// round-trip is simulated by encoding the child as JSON and populating the metadata fields set
+	// by the API Server on create, like the name generated from generateName and the uid. An
+	// unstable identifier is returned as an error, rather than causing the child to be deleted and
+	// created for each reconcile request.
+	//
+	// The check adds overhead for each desired child and is intended for development and tests.
+	//
+	// +optional
+	VerifyIdentifyChildStability bool
+
+	// MetadataOnlyListing when true lists potential child resources as metav1.PartialObjectMetadata
+	// rather than as the full ChildType. Only the metadata of each listed child is populated when
+	// passed to OurChild and IdentifyChild. The full child resource is fetched only for children
+	// that are desired and may need to be updated, children that are no longer desired are deleted
+	// using their metadata.
+	//
+	// OurChild and IdentifyChild must not depend on fields outside of the metadata (like the spec)
+	// in this mode. Known children returned by RetrieveKnownChildren within DesiredChildren also
+	// contain only metadata.
+	//
+	// Children are watched as metadata, when the client is backed by an informer cache a metadata
+	// informer is used for the child type rather than an informer of full objects. The full child
+	// resources are read with the APIReader, an uncached request to the API Server. The children
+	// last observed for each reconciled resource are kept in memory, a desired child is only read
+	// when its resourceVersion differs from the observed child.
+	//
+	// +optional
+	MetadataOnlyListing bool
+
+	// ListPageSize is the maximum number of potential children returned by each List request.
+	// When set, children are listed page by page with the APIReader, following the continue token,
+	// with only the children matching OurChild retained between pages. Bounding the page size
+	// limits memory consumption when many resources of the child type exist that are not our
+	// children, as is common when SkipOwnerReference is true.
+	//
+	// An informer cache does not support paging, so each page is an uncached request to the API
+	// Server. If the APIReader is backed by a cache anyway, listing stops after the first page.
+	//
+	// Defaults to 0, all potential children are listed with a single request to the client.
+	//
+	// +optional
+	ListPageSize int64
+
+	// BatchDeleteOrphans when true deletes children that are no longer desired with a single
+	// DeleteAllOf request rather than a Delete request for each child. The collection is selected
+	// with the namespace, label and field selectors from ListOptions, the ChildObjectManager is not
+	// consulted for children deleted in batch.
+	//
+	// A batch delete is only issued when it is safe to do so, otherwise children are deleted
+	// individually. It is safe when:
+	//   - a Finalizer is not defined
+	//   - ListOptions select with an exact label selector, where each requirement matches a label
+	//     with a single value, like client.MatchingLabels
+	//   - ListOptions do not select with a field selector
+	//   - ListOptions select within a namespace, unless the child type is cluster scoped
+	//   - every resource listed is our child, and none of them are desired
+	//   - listing was not cut short by a cache backed APIReader, see ListPageSize
+	//   - no child has finalizers or is already terminating
+	//   - reconciliation is not limited by OnlyReconcileChildStatus
+	//
+	// +optional
+	BatchDeleteOrphans bool
+
 	lazyInit       sync.Once
 	voidReconciler *ChildReconciler[Type, ChildType, ChildListType]
+
+	// observed holds the full desired children last observed for each resource by uid, see
+	// MetadataOnlyListing
+	observed     map[types.UID]map[types.UID]ChildType
+	observedLock sync.Mutex
 }
 
 func (r *ChildSetReconciler[T, CT, CLT]) init() {
@@ -180,12 +306,12 @@ func (r *ChildSetReconciler[T, CT, CLT]) init() {
 		}
 		if internal.IsNil(r.ChildListType) {
 			var nilCLT CLT
-			r.ChildListType = newEmpty(nilCLT).(CLT)
+			r.ChildListType = newEmptyList(nilCLT, r.ChildType).(CLT)
 		}
 		if r.Name == "" {
 			r.Name = fmt.Sprintf("%sChildSetReconciler", typeName(r.ChildType))
 		}
-		r.voidReconciler = r.childReconcilerFor(nilCT, nil, "", true)
+		r.voidReconciler = r.childReconcilerFor(nilCT, nil, "", nil, true)
 		if r.ReflectChildrenStatusOnParentWithError == nil && r.ReflectChildrenStatusOnParent != nil {
 			r.ReflectChildrenStatusOnParentWithError = func(ctx context.Context, parent T, result ChildSetResult[CT]) error {
 				r.ReflectChildrenStatusOnParent(ctx, parent, result)
@@ -209,6 +335,10 @@ func (r *ChildSetReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, m
 		return err
 	}
 
+	if err := validateChildSchemeRegistration(c.Scheme(), "ChildSetReconciler", r.Name, r.ChildType, r.ChildListType); err != nil {
+		return err
+	}
+
 	if err := r.ChildObjectManager.SetupWithManager(ctx, mgr, bldr); err != nil {
 		return err
 	}
@@ -226,22 +356,30 @@ func (r *ChildSetReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, m
 	return nil
 }
 
-func (r *ChildSetReconciler[T, CT, CLT]) childReconcilerFor(desired CT, desiredErr error, id string, void bool) *ChildReconciler[T, CT, CLT] {
+func (r *ChildSetReconciler[T, CT, CLT]) childReconcilerFor(desired CT, desiredErr error, id string, duplicates []CT, void bool) *ChildReconciler[T, CT, CLT] {
 	return &ChildReconciler[T, CT, CLT]{
-		Name:               id,
-		ChildType:          r.ChildType,
-		ChildListType:      r.ChildListType,
-		SkipOwnerReference: r.SkipOwnerReference,
+		Name:                     id,
+		id:                       id,
+		ChildType:                r.ChildType,
+		ChildListType:            r.ChildListType,
+		SkipOwnerReference:       r.SkipOwnerReference,
+		OwnerReferenceController: r.OwnerReferenceController,
+		BlockOwnerDeletion:       r.BlockOwnerDeletion,
+		onlyMetadata:             r.MetadataOnlyListing,
 		DesiredChild: func(ctx context.Context, resource T) (CT, error) {
 			return desired, desiredErr
 		},
-		ChildObjectManager: r.ChildObjectManager,
+		ChildObjectManager: &childSetActionObjectManager[CT]{
+			ObjectManager: r.ChildObjectManager,
+		},
 		ReflectChildStatusOnParent: func(ctx context.Context, parent T, child CT, err error) {
 			result := childSetResultStasher[CT]().RetrieveOrEmpty(ctx)
 			result.Children = append(result.Children, ChildSetPartialResult[CT]{
-				Id:    id,
-				Child: child,
-				Err:   err,
+				Id:         id,
+				Child:      child,
+				Err:        err,
+				Action:     childSetActionStasher.Clear(ctx),
+				Duplicates: duplicates,
 			})
 			childSetResultStasher[CT]().Store(ctx, result)
 		},
@@ -259,49 +397,71 @@ func (r *ChildSetReconciler[T, CT, CLT]) childReconcilerFor(desired CT, desiredE
 func (r *ChildSetReconciler[T, CT, CLT]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// default implicit values
 	if r.Finalizer != "" {
 		r.SkipOwnerReference = true
 	}
 
-	// require DesiredChildren
-	if r.DesiredChildren == nil {
-		return fmt.Errorf("ChildSetReconciler %q must implement DesiredChildren", r.Name)
+	// require DesiredChildren, DesiredChildrenSources or DesiredChildrenFunc
+	if r.DesiredChildren == nil && len(r.DesiredChildrenSources) == 0 && r.DesiredChildrenFunc == nil {
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement DesiredChildren, DesiredChildrenSources or DesiredChildrenFunc", r.Name))
+	}
+	if r.DesiredChildren != nil && len(r.DesiredChildrenSources) != 0 {
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must not implement both DesiredChildren and DesiredChildrenSources", r.Name))
+	}
+	if r.DesiredChildrenFunc != nil && (r.DesiredChildren != nil || len(r.DesiredChildrenSources) != 0) {
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must not implement DesiredChildrenFunc with DesiredChildren or DesiredChildrenSources", r.Name))
+	}
+	for i, source := range r.DesiredChildrenSources {
+		if source == nil {
+			errs = append(errs, fmt.Errorf("ChildSetReconciler %q must not define a nil DesiredChildrenSources[%d]", r.Name, i))
+		}
+	}
+
+	// require the kind of unstructured children
+	if err := validateUnstructuredKind("ChildSetReconciler", r.Name, "ChildType", r.ChildType); err != nil {
+		errs = append(errs, err)
 	}
 
 	// require ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError
 	if r.ReflectChildrenStatusOnParent == nil && r.ReflectChildrenStatusOnParentWithError == nil {
-		return fmt.Errorf("ChildSetReconciler %q must implement ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError", r.Name)
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError", r.Name))
 	}
 
 	if r.OurChild == nil && r.SkipOwnerReference {
 		// OurChild is required when SkipOwnerReference is true
-		return fmt.Errorf("ChildSetReconciler %q must implement OurChild since owner references are not used", r.Name)
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement OurChild since owner references are not used", r.Name))
 	}
 
 	if r.ListOptions == nil && r.SkipOwnerReference {
 		// ListOptions is required when SkipOwnerReference is true
-		return fmt.Errorf("ChildSetReconciler %q must implement ListOptions since owner references are not used", r.Name)
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement ListOptions since owner references are not used", r.Name))
 	}
 
 	// require IdentifyChild
 	if r.IdentifyChild == nil {
-		return fmt.Errorf("ChildSetReconciler %q must implement IdentifyChild", r.Name)
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement IdentifyChild", r.Name))
+	}
+
+	if r.ListPageSize < 0 {
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must not define a negative ListPageSize", r.Name))
 	}
 
 	// require ChildObjectManager
 	if r.ChildObjectManager == nil {
-		return fmt.Errorf("ChildSetReconciler %q must implement ChildObjectManager", r.Name)
+		errs = append(errs, fmt.Errorf("ChildSetReconciler %q must implement ChildObjectManager", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.ChildObjectManager.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("ChildSetReconciler %q must have a valid ChildObjectManager: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("ChildSetReconciler %q must have a valid ChildObjectManager: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *ChildSetReconciler[T, CT, CLT]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -311,68 +471,397 @@ func (r *ChildSetReconciler[T, CT, CLT]) Reconcile(ctx context.Context, resource
 		WithName(r.Name)
 	ctx = logr.NewContext(ctx, log)
 
-	knownChildren, err := r.knownChildren(ctx, resource)
+	knownChildren, exclusive, err := r.knownChildren(ctx, resource)
 	if err != nil {
 		return Result{}, err
 	}
 	ctx = stashKnownChildren(ctx, knownChildren)
 
-	cr, err := r.composeChildReconcilers(ctx, resource, knownChildren)
+	cr, desiredIDs, orphans, err := r.composeChildReconcilers(ctx, resource, knownChildren, exclusive)
 	if err != nil {
 		return Result{}, err
 	}
+	if len(orphans) != 0 {
+		if err := r.deleteOrphans(ctx, resource, orphans); err != nil {
+			return Result{}, err
+		}
+	}
+	if r.MetadataOnlyListing {
+		knownChildren, err = r.hydrateChildren(ctx, resource, knownChildren, desiredIDs)
+		if err != nil {
+			return Result{}, err
+		}
+		ctx = stashKnownChildren(ctx, knownChildren)
+	}
 	result, reconcileErr := cr.Reconcile(ctx, resource)
-	reflectStatusErr := r.reflectStatus(ctx, resource)
+	childSetResult := childSetResultStasher[CT]().Clear(ctx)
+	if r.MetadataOnlyListing {
+		r.observeChildren(resource, childSetResult, desiredIDs)
+	}
+	reflectStatusErr := r.reflectStatus(ctx, resource, childSetResult)
 	return result, errors.Join(reconcileErr, reflectStatusErr)
 }
 
-func (r *ChildSetReconciler[T, CT, CLT]) knownChildren(ctx context.Context, resource T) ([]CT, error) {
+// knownChildren lists our children. The returned bool is true when every resource listed is our
+// child.
+func (r *ChildSetReconciler[T, CT, CLT]) knownChildren(ctx context.Context, resource T) ([]CT, bool, error) {
+	if r.MetadataOnlyListing {
+		return r.knownChildrenMetadata(ctx, resource)
+	}
+
+	ourChildren := []CT{}
+	exclusive := true
+	complete, err := r.listPages(ctx, resource, func() client.ObjectList {
+		return r.ChildListType.DeepCopyObject().(CLT)
+	}, func(children client.ObjectList) error {
+		for _, child := range extractItems[CT](children.(CLT)) {
+			if !r.voidReconciler.ourChild(resource, child) {
+				exclusive = false
+				continue
+			}
+			ourChildren = append(ourChildren, child.DeepCopyObject().(CT))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return ourChildren, exclusive && complete, nil
+}
+
+func (r *ChildSetReconciler[T, CT, CLT]) knownChildrenMetadata(ctx context.Context, resource T) ([]CT, bool, error) {
 	c := RetrieveConfigOrDie(ctx)
 
-	children := r.ChildListType.DeepCopyObject().(CLT)
+	gvk, err := c.GroupVersionKindFor(r.ChildListType)
+	if err != nil {
+		return nil, false, err
+	}
 	ourChildren := []CT{}
-	if err := c.List(ctx, children, r.voidReconciler.listOptions(ctx, resource)...); err != nil {
-		return nil, err
+	exclusive := true
+	complete, err := r.listPages(ctx, resource, func() client.ObjectList {
+		children := &metav1.PartialObjectMetadataList{}
+		children.SetGroupVersionKind(gvk)
+		return children
+	}, func(list client.ObjectList) error {
+		children := list.(*metav1.PartialObjectMetadataList)
+		for i := range children.Items {
+			child, err := r.metadataOnlyChild(&children.Items[i])
+			if err != nil {
+				return err
+			}
+			if !r.voidReconciler.ourChild(resource, child) {
+				exclusive = false
+				continue
+			}
+			ourChildren = append(ourChildren, child)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return ourChildren, exclusive && complete, nil
+}
+
+// listPages lists the potential children, calling page with each list returned. When ListPageSize
+// is set, the children are listed in pages with the APIReader, otherwise with a single request to
+// the client. The returned bool is false when listing stopped before the last page because the
+// reader does not support continuing a list.
+func (r *ChildSetReconciler[T, CT, CLT]) listPages(ctx context.Context, resource T, newList func() client.ObjectList, page func(list client.ObjectList) error) (bool, error) {
+	c := RetrieveConfigOrDie(ctx)
+
+	opts := r.voidReconciler.listOptions(ctx, resource)
+	if r.ListPageSize == 0 {
+		list := newList()
+		if err := c.List(ctx, list, opts...); err != nil {
+			return false, err
+		}
+		return true, page(list)
+	}
+
+	continueToken := ""
+	for {
+		list := newList()
+		pageOpts := append(slices.Clone(opts), client.Limit(r.ListPageSize))
+		if continueToken != "" {
+			pageOpts = append(pageOpts, client.Continue(continueToken))
+		}
+		if err := c.APIReader.List(ctx, list, pageOpts...); err != nil {
+			return false, err
+		}
+		if err := page(list); err != nil {
+			return false, err
+		}
+		switch continueToken = list.GetContinue(); continueToken {
+		case "":
+			return true, nil
+		case continueNotSupported:
+			// the list was truncated by a cache, requesting the next page would repeat this one
+			return false, nil
+		}
 	}
-	for _, child := range extractItems[CT](children) {
-		if !r.voidReconciler.ourChild(resource, child) {
+}
+
+// metadataOnlyChild converts the partial object into the child type with only the metadata
+// populated.
+func (r *ChildSetReconciler[T, CT, CLT]) metadataOnlyChild(item *metav1.PartialObjectMetadata) (CT, error) {
+	var nilCT CT
+
+	metadata, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&item.ObjectMeta)
+	if err != nil {
+		return nilCT, err
+	}
+	child := r.ChildType.DeepCopyObject().(CT)
+	if u, ok := client.Object(child).(*unstructured.Unstructured); ok {
+		if u.Object == nil {
+			u.Object = map[string]interface{}{}
+		}
+		u.Object["metadata"] = metadata
+		return child, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(child)
+	if err != nil {
+		return nilCT, err
+	}
+	content["metadata"] = metadata
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, child); err != nil {
+		return nilCT, err
+	}
+	return child, nil
+}
+
+// hydrateChildren returns the full object for known children that are desired. A child with the
+// same resourceVersion as the child last observed for the resource is not fetched again. Children
+// that no longer exist are dropped.
+func (r *ChildSetReconciler[T, CT, CLT]) hydrateChildren(ctx context.Context, resource T, knownChildren []CT, desiredIDs sets.Set[string]) ([]CT, error) {
+	c := RetrieveConfigOrDie(ctx)
+
+	r.observedLock.Lock()
+	observed := r.observed[resource.GetUID()]
+	r.observedLock.Unlock()
+
+	children := []CT{}
+	for _, child := range knownChildren {
+		if !desiredIDs.Has(r.IdentifyChild(child)) {
+			children = append(children, child)
+			continue
+		}
+		if prior, ok := observed[child.GetUID()]; ok && child.GetResourceVersion() != "" && prior.GetResourceVersion() == child.GetResourceVersion() {
+			// unchanged since last observed, an update is only required when the desired child changed
+			children = append(children, prior.DeepCopyObject().(CT))
 			continue
 		}
-		ourChildren = append(ourChildren, child.DeepCopyObject().(CT))
+		full := r.ChildType.DeepCopyObject().(CT)
+		// read with the APIReader, the cache is only populated with the metadata of children
+		if err := c.APIReader.Get(ctx, namespaceName(child), full); err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		children = append(children, full)
 	}
 
-	return ourChildren, nil
+	return children, nil
 }
 
-func (r *ChildSetReconciler[T, CT, CLT]) composeChildReconcilers(ctx context.Context, resource T, knownChildren []CT) (SubReconciler[T], error) {
-	desiredChildren, desiredChildrenErr := r.DesiredChildren(ctx, resource)
-	if desiredChildrenErr != nil && !errors.Is(desiredChildrenErr, OnlyReconcileChildStatus) {
-		return nil, desiredChildrenErr
+// observeChildren records the desired children that were reconciled without error for the
+// resource, replacing the children previously observed. Nothing is retained for a resource that
+// is being deleted.
+func (r *ChildSetReconciler[T, CT, CLT]) observeChildren(resource T, result ChildSetResult[CT], desiredIDs sets.Set[string]) {
+	observed := map[types.UID]CT{}
+	if resource.GetDeletionTimestamp() == nil {
+		for _, childResult := range result.Children {
+			if childResult.Err != nil || internal.IsNil(childResult.Child) || !desiredIDs.Has(childResult.Id) {
+				continue
+			}
+			if uid := childResult.Child.GetUID(); uid != "" {
+				observed[uid] = childResult.Child.DeepCopyObject().(CT)
+			}
+		}
 	}
 
+	r.observedLock.Lock()
+	defer r.observedLock.Unlock()
+	if len(observed) == 0 {
+		delete(r.observed, resource.GetUID())
+		return
+	}
+	if r.observed == nil {
+		r.observed = map[types.UID]map[types.UID]CT{}
+	}
+	r.observed[resource.GetUID()] = observed
+}
+
+// desiredChild is a desired child along with the index of the DesiredChildrenSources it was
+// returned from.
+type desiredChild[CT client.Object] struct {
+	child  CT
+	source int
+}
+
+// desiredChildren calls fn with each desired child in order, stopping at the first error returned
+// by fn. OnlyReconcileChildStatus is returned once every desired child is produced when it is
+// returned by any source.
+func (r *ChildSetReconciler[T, CT, CLT]) desiredChildren(ctx context.Context, resource T, fn func(desired desiredChild[CT]) error) error {
+	var onlyReconcileChildStatusErr error
+
+	if r.DesiredChildrenFunc != nil {
+		for child, err := range r.DesiredChildrenFunc(ctx, resource) {
+			if err != nil {
+				if !errors.Is(err, OnlyReconcileChildStatus) {
+					return err
+				}
+				if onlyReconcileChildStatusErr == nil {
+					onlyReconcileChildStatusErr = err
+				}
+				continue
+			}
+			if err := fn(desiredChild[CT]{child: child}); err != nil {
+				return err
+			}
+		}
+		return onlyReconcileChildStatusErr
+	}
+
+	sources := r.DesiredChildrenSources
+	if r.DesiredChildren != nil {
+		sources = []func(ctx context.Context, resource T) ([]CT, error){r.DesiredChildren}
+	}
+
+	for i, source := range sources {
+		children, err := source(ctx, resource)
+		if err != nil {
+			if !errors.Is(err, OnlyReconcileChildStatus) {
+				return err
+			}
+			if onlyReconcileChildStatusErr == nil {
+				onlyReconcileChildStatusErr = err
+			}
+		}
+		for _, child := range children {
+			if err := fn(desiredChild[CT]{child: child, source: i}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return onlyReconcileChildStatusErr
+}
+
+// verifyIdentifyChildStability returns an error when IdentifyChild returns a different id for the
+// desired child once it is created on the API Server.
+func (r *ChildSetReconciler[T, CT, CLT]) verifyIdentifyChildStability(ctx context.Context, desired CT, id string) error {
+	created, err := simulateCreate(ctx, desired)
+	if err != nil {
+		return err
+	}
+	if createdID := r.IdentifyChild(created); createdID != id {
+		return fmt.Errorf("unstable child id: %q for the desired child, %q once created", id, createdID)
+	}
+	return nil
+}
+
+// simulatedGenerateNameSuffix is appended to the GenerateName of a desired child that does not
+// define a Name to simulate the random suffix the API Server generates when the child is created.
+// The suffix is fixed so the error reported for an unstable child id is deterministic.
+const simulatedGenerateNameSuffix = "x7k2q"
+
+// simulateCreate returns a copy of the object after a JSON round-trip with the metadata fields
+// populated by the API Server when the object is created.
+func simulateCreate[T client.Object](ctx context.Context, obj T) (T, error) {
+	created := obj.DeepCopyObject().(T)
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return created, err
+	}
+	replaceWithEmpty(created)
+	if err := json.Unmarshal(data, created); err != nil {
+		return created, err
+	}
+	if created.GetName() == "" && created.GetGenerateName() != "" {
+		created.SetName(created.GetGenerateName() + simulatedGenerateNameSuffix)
+	}
+	if created.GetUID() == "" {
+		created.SetUID(types.UID("00000000-0000-0000-0000-000000000000"))
+	}
+	if created.GetResourceVersion() == "" {
+		created.SetResourceVersion("1")
+	}
+	if created.GetGeneration() == 0 {
+		created.SetGeneration(1)
+	}
+	if creationTimestamp := created.GetCreationTimestamp(); creationTimestamp.IsZero() {
+		created.SetCreationTimestamp(metav1.NewTime(rtime.RetrieveNow(ctx)))
+	}
+	return created, nil
+}
+
+// composeChildReconcilers returns a reconciler for each child, the ids of desired children and
+// the orphaned children to delete in batch. Orphans deleted in batch do not have a reconciler.
+func (r *ChildSetReconciler[T, CT, CLT]) composeChildReconcilers(ctx context.Context, resource T, knownChildren []CT, exclusive bool) (SubReconciler[T], sets.Set[string], []CT, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
 	childIDs := sets.NewString()
 	desiredChildByID := map[string]CT{}
-	for _, child := range desiredChildren {
-		id := r.IdentifyChild(child)
+	desiredSourceByID := map[string]int{}
+	desiredChildrenErr := r.desiredChildren(ctx, resource, func(desired desiredChild[CT]) error {
+		id := r.IdentifyChild(desired.child)
 		if id == "" {
-			return nil, fmt.Errorf("desired child id may not be empty")
+			return fmt.Errorf("desired child id may not be empty")
+		}
+		if r.VerifyIdentifyChildStability {
+			if err := r.verifyIdentifyChildStability(ctx, desired.child, id); err != nil {
+				return err
+			}
 		}
 		if childIDs.Has(id) {
-			return nil, fmt.Errorf("duplicate child id found: %s", id)
+			if source := desiredSourceByID[id]; source != desired.source {
+				return fmt.Errorf("duplicate child id found: %s, in DesiredChildrenSources[%d] and DesiredChildrenSources[%d]", id, source, desired.source)
+			}
+			return fmt.Errorf("duplicate child id found: %s", id)
 		}
 		childIDs.Insert(id)
-		desiredChildByID[id] = child
+		desiredChildByID[id] = desired.child
+		desiredSourceByID[id] = desired.source
+		return nil
+	})
+	if desiredChildrenErr != nil && !errors.Is(desiredChildrenErr, OnlyReconcileChildStatus) {
+		return nil, nil, nil, desiredChildrenErr
 	}
 
+	knownChildrenByID := map[string][]CT{}
 	for _, child := range knownChildren {
 		id := r.IdentifyChild(child)
 		childIDs.Insert(id)
+		knownChildrenByID[id] = append(knownChildrenByID[id], child)
+	}
+
+	desiredIDs := sets.KeySet(desiredChildByID)
+	var orphans []CT
+	if desiredChildrenErr == nil && r.canBatchDelete(ctx, resource, knownChildren, desiredIDs, exclusive) {
+		orphans = knownChildren
 	}
 
 	sequence := Sequence[T]{}
 	for _, id := range childIDs.List() {
+		if _, known := knownChildrenByID[id]; known && len(orphans) != 0 {
+			continue
+		}
 		child := desiredChildByID[id]
-		cr := r.childReconcilerFor(child, desiredChildrenErr, id, false)
+		var duplicates []CT
+		if known := knownChildrenByID[id]; len(known) > 1 {
+			// IdentifyChild is likely unstable, the child reconciler will delete each duplicate
+			names := make([]string, len(known))
+			for i := range known {
+				names[i] = namespaceName(known[i]).String()
+			}
+			log.Info("duplicate actual child id found", "id", id, "children", names)
+			duplicates = known
+		}
+		cr := r.childReconcilerFor(child, desiredChildrenErr, id, duplicates, false)
 		sequence = append(sequence, cr)
 	}
 
@@ -380,13 +869,129 @@ func (r *ChildSetReconciler[T, CT, CLT]) composeChildReconcilers(ctx context.Con
 		return &WithFinalizer[T]{
 			Finalizer:  r.Finalizer,
 			Reconciler: sequence,
-		}, nil
+		}, desiredIDs, nil, nil
 	}
-	return sequence, nil
+	return sequence, desiredIDs, orphans, nil
 }
 
-func (r *ChildSetReconciler[T, CT, CLT]) reflectStatus(ctx context.Context, parent T) error {
-	result := childSetResultStasher[CT]().Clear(ctx)
+// canBatchDelete returns true when every known child is an orphan that is safe to delete with a
+// single DeleteAllOf request, see BatchDeleteOrphans.
+func (r *ChildSetReconciler[T, CT, CLT]) canBatchDelete(ctx context.Context, resource T, knownChildren []CT, desiredIDs sets.Set[string], exclusive bool) bool {
+	if !r.BatchDeleteOrphans || r.Finalizer != "" || !exclusive || len(knownChildren) == 0 {
+		return false
+	}
+	opts := (&client.ListOptions{}).ApplyOptions(r.voidReconciler.listOptions(ctx, resource))
+	if !isExactLabelSelector(opts.LabelSelector) {
+		return false
+	}
+	// the collection is only selected by labels and namespace
+	if opts.FieldSelector != nil && !opts.FieldSelector.Empty() {
+		return false
+	}
+	if opts.Namespace == "" {
+		// never delete the children of every namespace
+		c := RetrieveConfigOrDie(ctx)
+		if namespaced, err := c.IsObjectNamespaced(r.ChildType); err != nil || namespaced {
+			return false
+		}
+	}
+	for _, child := range knownChildren {
+		if desiredIDs.Has(r.IdentifyChild(child)) {
+			return false
+		}
+		// finalizers are managed individually
+		if len(child.GetFinalizers()) != 0 || child.GetDeletionTimestamp() != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// isExactLabelSelector returns true when each requirement of the selector matches a label with a
+// single value. An empty selector is not exact.
+func isExactLabelSelector(selector labels.Selector) bool {
+	if selector == nil {
+		return false
+	}
+	requirements, selectable := selector.Requirements()
+	if !selectable || len(requirements) == 0 {
+		return false
+	}
+	for _, requirement := range requirements {
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+		case selection.In:
+			if requirement.Values().Len() != 1 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// deleteOrphans deletes the orphaned children with a single DeleteAllOf request, recording the
+// result for each child. Errors not handled by ReflectedChildErrorReasons are returned.
+func (r *ChildSetReconciler[T, CT, CLT]) deleteOrphans(ctx context.Context, resource T, orphans []CT) error {
+	log := logr.FromContextOrDiscard(ctx)
+	pc := RetrieveOriginalConfigOrDie(ctx)
+	c := RetrieveConfigOrDie(ctx)
+
+	opts := (&client.ListOptions{}).ApplyOptions(r.voidReconciler.listOptions(ctx, resource))
+	deleteOpts := &client.DeleteAllOfOptions{
+		ListOptions: client.ListOptions{
+			Namespace:     opts.Namespace,
+			LabelSelector: opts.LabelSelector,
+		},
+	}
+
+	log.Info("deleting orphaned children", "count", len(orphans), "selector", opts.LabelSelector.String())
+	err := c.DeleteAllOf(ctx, r.ChildType.DeepCopyObject().(CT), deleteOpts)
+	if err != nil {
+		if !errors.Is(err, ErrQuiet) {
+			log.Error(err, "unable to delete orphaned children", "selector", opts.LabelSelector.String())
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "DeleteFailed",
+				"Failed to delete %d %s: %v", len(orphans), typeName(r.ChildType), err)
+		}
+		r.voidReconciler.init()
+		if !r.voidReconciler.shouldReflectError(err) {
+			return err
+		}
+	} else {
+		pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Deleted",
+			"Deleted %d %s", len(orphans), typeName(r.ChildType))
+	}
+
+	orphansByID := map[string][]CT{}
+	for _, orphan := range orphans {
+		id := r.IdentifyChild(orphan)
+		orphansByID[id] = append(orphansByID[id], orphan)
+	}
+	result := childSetResultStasher[CT]().RetrieveOrEmpty(ctx)
+	for _, id := range sets.List(sets.KeySet(orphansByID)) {
+		partial := ChildSetPartialResult[CT]{
+			Id:  id,
+			Err: err,
+		}
+		if err == nil {
+			partial.Action = ChildSetActionDeleted
+		}
+		if duplicates := orphansByID[id]; len(duplicates) > 1 {
+			partial.Duplicates = duplicates
+		}
+		result.Children = append(result.Children, partial)
+	}
+	childSetResultStasher[CT]().Store(ctx, result)
+
+	return nil
+}
+
+func (r *ChildSetReconciler[T, CT, CLT]) reflectStatus(ctx context.Context, parent T, result ChildSetResult[CT]) error {
+	// orphans deleted in batch are recorded before the remaining children are reconciled
+	slices.SortStableFunc(result.Children, func(a, b ChildSetPartialResult[CT]) int {
+		return strings.Compare(a.Id, b.Id)
+	})
 	return r.ReflectChildrenStatusOnParentWithError(ctx, parent, result)
 }
 
@@ -398,6 +1003,73 @@ type ChildSetPartialResult[T client.Object] struct {
 	Id    string
 	Child T
 	Err   error
+	// Action made to the child by the ChildObjectManager during this reconcile. Empty when the
+	// child was not managed, or managing the child failed.
+	Action ChildSetAction
+	// Duplicates are the actual children, in the order listed, that IdentifyChild mapped to this
+	// Id. A single actual child may exist for each Id, duplicates are deleted before the desired
+	// child is created. Duplicates often indicate that IdentifyChild is not stable. Empty when
+	// at most one actual child was found.
+	Duplicates []T
+}
+
+// ChildSetAction describes the change made to a child during a reconcile
+type ChildSetAction string
+
+const (
+	ChildSetActionCreated   ChildSetAction = "Created"
+	ChildSetActionUpdated   ChildSetAction = "Updated"
+	ChildSetActionUnchanged ChildSetAction = "Unchanged"
+	ChildSetActionDeleted   ChildSetAction = "Deleted"
+)
+
+var childSetActionStasher = stash.New[ChildSetAction]("reconciler.io/runtime:childSetAction")
+
+var _ validation.Validator = (*childSetActionObjectManager[client.Object])(nil)
+
+// childSetActionObjectManager observes the actual, desired and resulting child of each successful
+// call to Manage to stash the action taken for the child
+type childSetActionObjectManager[T client.Object] struct {
+	ObjectManager[T]
+}
+
+func (m *childSetActionObjectManager[T]) Validate(ctx context.Context) error {
+	if v, ok := m.ObjectManager.(validation.Validator); ok {
+		return v.Validate(ctx)
+	}
+	return nil
+}
+
+func (m *childSetActionObjectManager[T]) Manage(ctx context.Context, resource client.Object, actual, desired T) (T, error) {
+	result, err := m.ObjectManager.Manage(ctx, resource, actual, desired)
+	if err != nil {
+		return result, err
+	}
+
+	if action := childAction(actual, desired, result); action != "" {
+		childSetActionStasher.Store(ctx, action)
+	}
+
+	return result, nil
+}
+
+// childAction describes the change made to the actual child by a successful Manage call. An
+// empty action is returned when there was nothing to manage.
+func childAction[T client.Object](actual, desired, result T) ChildSetAction {
+	exists := !internal.IsNil(actual) && !actual.GetCreationTimestamp().Time.IsZero()
+	switch {
+	case internal.IsNil(desired) && !exists:
+		// nothing to manage
+		return ""
+	case internal.IsNil(desired) || internal.IsNil(result):
+		return ChildSetActionDeleted
+	case !exists:
+		return ChildSetActionCreated
+	case result.GetResourceVersion() != actual.GetResourceVersion():
+		return ChildSetActionUpdated
+	default:
+		return ChildSetActionUnchanged
+	}
 }
 
 func (r *ChildSetResult[T]) AggregateError() error {
@@ -433,6 +1105,20 @@ func RetrieveKnownChildren[T client.Object](ctx context.Context) []T {
 	return nil
 }
 
+// ListOurChildren lists the children of the resource the ChildSetReconciler manages, outside of
+// the reconciler's DesiredChildren method. The children are listed with the ListOptions, paged with
+// the APIReader when ListPageSize is set, and filtered by ownership and OurChild, exactly as the
+// children that are known to the reconciler. When MetadataOnlyListing is true, only the metadata of each child is populated.
+//
+// Each child returned is a copy and may be mutated, which makes the helper suitable for custom
+// garbage collection of children.
+func ListOurChildren[T, CT client.Object, CLT client.ObjectList](ctx context.Context, resource T, r *ChildSetReconciler[T, CT, CLT]) ([]CT, error) {
+	r.init()
+
+	children, _, err := r.knownChildren(ctx, resource)
+	return children, err
+}
+
 func stashKnownChildren[T client.Object](ctx context.Context, children []T) context.Context {
 	return context.WithValue(ctx, knownChildrenStashKey, children)
 }
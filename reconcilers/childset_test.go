@@ -19,22 +19,29 @@ package reconcilers_test
 import (
 	"context"
 	"fmt"
+	"iter"
 	"sort"
+	"strconv"
 	"testing"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	diecorev1 "reconciler.io/dies/apis/core/v1"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/internal/resources"
 	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
+	"reconciler.io/runtime/stash"
 	rtesting "reconciler.io/runtime/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -192,6 +199,71 @@ func TestChildSetReconciler(t *testing.T) {
 				},
 			},
 		},
+		"lists children in the resource's namespace": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectLists: []rtesting.ListRef{
+				rtesting.NewListRefFromList(&corev1.ConfigMapList{}, scheme, client.InNamespace(testNamespace)),
+			},
+		},
+		"lists children with ListOptions": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.InNamespace(resource.Namespace),
+							client.MatchingLabels{"app": resource.Name},
+						}
+					}
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									d.AddLabel("app", testName)
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectLists: []rtesting.ListRef{
+				rtesting.NewListRefFromList(&corev1.ConfigMapList{}, scheme,
+					client.InNamespace(testNamespace),
+					client.MatchingLabels{"app": testName},
+				),
+			},
+		},
 		"preserve existing children": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
@@ -280,18 +352,26 @@ func TestChildSetReconciler(t *testing.T) {
 				},
 			},
 		},
-		"create green child, preserving blue child": {
+		"paginate children listing": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
 				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			APIGivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
 			},
+			ExpectAPIReaderReads: ptr.To(1),
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
+					r.ListPageSize = 1
 					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
 						return []*corev1.ConfigMap{
 							configMapBlueDesired.DieReleasePtr(),
@@ -301,17 +381,8 @@ func TestChildSetReconciler(t *testing.T) {
 					return r
 				},
 			},
-			ExpectResource: resourceReady.
-				StatusDie(func(d *dies.TestResourceStatusDie) {
-					d.AddField("blue.foo", "bar")
-					d.AddField("green.foo", "bar")
-				}).
-				DieReleasePtr(),
-			ExpectCreates: []client.Object{
-				configMapGreenCreate.DieReleasePtr(),
-			},
 		},
-		"delete green child, preserving blue child": {
+		"metadata only listing in sync with children": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
@@ -322,27 +393,31 @@ func TestChildSetReconciler(t *testing.T) {
 				configMapBlueGiven.DieReleasePtr(),
 				configMapGreenGiven.DieReleasePtr(),
 			},
+			APIGivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			ExpectAPIReaderReads: ptr.To(2),
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
+					r.MetadataOnlyListing = true
 					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						for _, child := range reconcilers.RetrieveKnownChildren[*corev1.ConfigMap](ctx) {
+							if child.Data != nil {
+								return nil, fmt.Errorf("expected metadata only child %q", child.Name)
+							}
+						}
 						return []*corev1.ConfigMap{
 							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
 						}, nil
 					}
 					return r
 				},
 			},
-			ExpectResource: resourceReady.
-				StatusDie(func(d *dies.TestResourceStatusDie) {
-					d.AddField("blue.foo", "bar")
-				}).
-				DieReleasePtr(),
-			ExpectDeletes: []rtesting.DeleteRef{
-				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
-			},
 		},
-		"update children": {
+		"metadata only listing delete green child, preserving blue child": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
@@ -353,17 +428,17 @@ func TestChildSetReconciler(t *testing.T) {
 				configMapBlueGiven.DieReleasePtr(),
 				configMapGreenGiven.DieReleasePtr(),
 			},
+			APIGivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+			},
+			ExpectAPIReaderReads: ptr.To(1),
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
+					r.MetadataOnlyListing = true
 					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
 						return []*corev1.ConfigMap{
-							configMapBlueDesired.
-								AddData("foo", "updated-blue").
-								DieReleasePtr(),
-							configMapGreenDesired.
-								AddData("foo", "updated-green").
-								DieReleasePtr(),
+							configMapBlueDesired.DieReleasePtr(),
 						}, nil
 					}
 					return r
@@ -371,62 +446,14 @@ func TestChildSetReconciler(t *testing.T) {
 			},
 			ExpectResource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
-					d.AddField("blue.foo", "updated-blue")
-					d.AddField("green.foo", "updated-green")
+					d.AddField("blue.foo", "bar")
 				}).
 				DieReleasePtr(),
-			ExpectUpdates: []client.Object{
-				configMapBlueGiven.
-					AddData("foo", "updated-blue").
-					DieReleasePtr(),
-				configMapGreenGiven.
-					AddData("foo", "updated-green").
-					DieReleasePtr(),
-			},
-		},
-		"errors for desired children with empty id": {
-			Resource: resourceReady.DieReleasePtr(),
-			Metadata: map[string]interface{}{
-				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return []*corev1.ConfigMap{
-							configMapBlueDesired.
-								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-									// clear existing id annotation
-									d.DieStamp(func(r *metav1.ObjectMeta) {
-										delete(r.Annotations, idKey)
-									})
-								}).
-								DieReleasePtr(),
-						}, nil
-					}
-					return r
-				},
-			},
-			ShouldErr: true,
-		},
-		"errors for desired children with duplicate ids": {
-			Resource: resourceReady.DieReleasePtr(),
-			Metadata: map[string]interface{}{
-				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
-					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return []*corev1.ConfigMap{
-							configMapBlueDesired.DieReleasePtr(),
-							configMapGreenDesired.
-								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-									d.AddAnnotation(idKey, "blue")
-								}).
-								DieReleasePtr(),
-						}, nil
-					}
-					return r
-				},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven, scheme),
 			},
-			ShouldErr: true,
 		},
-		"deletes actual children with duplicate ids": {
+		"create green child, preserving blue child": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
@@ -434,11 +461,6 @@ func TestChildSetReconciler(t *testing.T) {
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
 				configMapBlueGiven.DieReleasePtr(),
-				configMapGreenGiven.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						d.AddAnnotation(idKey, "blue")
-					}).
-					DieReleasePtr(),
 			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
@@ -446,20 +468,23 @@ func TestChildSetReconciler(t *testing.T) {
 					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
 						return []*corev1.ConfigMap{
 							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
 						}, nil
 					}
 					return r
 				},
 			},
-			ExpectDeletes: []rtesting.DeleteRef{
-				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
-				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
-			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
 			ExpectCreates: []client.Object{
-				configMapBlueCreate.DieReleasePtr(),
+				configMapGreenCreate.DieReleasePtr(),
 			},
 		},
-		"deletes actual child resource missing id": {
+		"delete green child, preserving blue child": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
@@ -469,7 +494,6 @@ func TestChildSetReconciler(t *testing.T) {
 			GivenObjects: []client.Object{
 				configMapBlueGiven.DieReleasePtr(),
 				configMapGreenGiven.DieReleasePtr(),
-				configMapGiven,
 			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
@@ -477,17 +501,21 @@ func TestChildSetReconciler(t *testing.T) {
 					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
 						return []*corev1.ConfigMap{
 							configMapBlueDesired.DieReleasePtr(),
-							configMapGreenDesired.DieReleasePtr(),
 						}, nil
 					}
 					return r
 				},
 			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleasePtr(),
 			ExpectDeletes: []rtesting.DeleteRef{
-				rtesting.NewDeleteRefFromObject(configMapGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
 			},
 		},
-		"defines a finalizer when requested": {
+		"batch deletes orphaned children": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
@@ -495,95 +523,757 @@ func TestChildSetReconciler(t *testing.T) {
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
-				configMapBlueGiven.DieReleasePtr(),
-				configMapGreenGiven.DieReleasePtr(),
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
 			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.Finalizer = testFinalizer
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return []*corev1.ConfigMap{
-							configMapBlueDesired.DieReleasePtr(),
-							configMapGreenDesired.DieReleasePtr(),
-						}, nil
-					}
-					r.OurChild = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
-						return true
-					}
+					r.BatchDeleteOrphans = true
 					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
 						return []client.ListOption{
-							client.InNamespace(testNamespace),
+							client.InNamespace(resource.GetNamespace()),
+							client.MatchingLabels{"app": resource.GetName()},
+						}
+					}
+					r.ReflectChildrenStatusOnParent = func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+						parent.Status.Fields = map[string]string{}
+						for _, childResult := range result.Children {
+							parent.Status.Fields[childResult.Id] = string(childResult.Action)
 						}
 					}
 					return r
 				},
 			},
 			ExpectResource: resourceReady.
-				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-					d.ResourceVersion("1000")
-					d.Finalizers(testFinalizer)
-				}).
 				StatusDie(func(d *dies.TestResourceStatusDie) {
-					d.AddField("blue.foo", "bar")
-					d.AddField("green.foo", "bar")
+					d.AddField("blue", "Deleted")
+					d.AddField("green", "Deleted")
 				}).
 				DieReleasePtr(),
 			ExpectEvents: []rtesting.Event{
-				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
-					`Patched finalizer %q`, testFinalizer),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", "Deleted 2 ConfigMap"),
 			},
-			ExpectPatches: []rtesting.PatchRef{
-				{
-					Group:     "testing.reconciler.runtime",
-					Kind:      "TestResource",
-					Namespace: testNamespace,
-					Name:      testName,
-					PatchType: types.MergePatchType,
-					Patch:     []byte(`{"metadata":{"finalizers":["test.finalizer"],"resourceVersion":"999"}}`),
-				},
+			ExpectDeleteCollections: []rtesting.DeleteCollectionRef{
+				{Kind: "ConfigMap", Namespace: testNamespace, Labels: labels.SelectorFromSet(labels.Set{"app": testName})},
 			},
 		},
-		"forwards error listing children": {
+		"batch deletes orphaned children, without a label selector": {
 			Resource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
-					d.AddField("blue.foo", "bar")
 					d.AddField("green.foo", "bar")
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
-				configMapBlueGiven.DieReleasePtr(),
 				configMapGreenGiven.DieReleasePtr(),
 			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return []*corev1.ConfigMap{
-							configMapBlueDesired.DieReleasePtr(),
-							configMapGreenDesired.DieReleasePtr(),
-						}, nil
-					}
+					r.BatchDeleteOrphans = true
 					return r
 				},
 			},
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("list", "ConfigMapList"),
+			ExpectResource: resourceReady.DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
 			},
-			ShouldErr: true,
 		},
-		"forwards error from child reconciler": {
-			Resource: resourceReady.DieReleasePtr(),
+		"batch deletes orphaned children, with an inexact label selector": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+			},
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return []*corev1.ConfigMap{
-							configMapBlueDesired.DieReleasePtr(),
-							configMapGreenDesired.DieReleasePtr(),
-						}, nil
-					}
-					return r
+					r.BatchDeleteOrphans = true
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.InNamespace(resource.GetNamespace()),
+							client.HasLabels{"app"},
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"batch deletes orphaned children, without a namespace": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.BatchDeleteOrphans = true
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.MatchingLabels{"app": resource.GetName()},
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"batch deletes orphaned children, with a desired child": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.BatchDeleteOrphans = true
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.InNamespace(resource.GetNamespace()),
+							client.MatchingLabels{"app": resource.GetName()},
+						}
+					}
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									d.AddLabel("app", testName)
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"batch deletes orphaned children, excluding children with finalizers": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+					}).
+					DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app", testName)
+						d.Finalizers(testFinalizer)
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.BatchDeleteOrphans = true
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.InNamespace(resource.GetNamespace()),
+							client.MatchingLabels{"app": resource.GetName()},
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"create children from multiple sources": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = nil
+					r.DesiredChildrenSources = []func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error){
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapBlueDesired.DieReleasePtr(),
+							}, nil
+						},
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return nil, nil
+						},
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapGreenDesired.DieReleasePtr(),
+							}, nil
+						},
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleasePtr(),
+				configMapGreenCreate.DieReleasePtr(),
+			},
+		},
+		"update children": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.
+								AddData("foo", "updated-blue").
+								DieReleasePtr(),
+							configMapGreenDesired.
+								AddData("foo", "updated-green").
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "updated-blue")
+					d.AddField("green.foo", "updated-green")
+				}).
+				DieReleasePtr(),
+			ExpectUpdates: []client.Object{
+				configMapBlueGiven.
+					AddData("foo", "updated-blue").
+					DieReleasePtr(),
+				configMapGreenGiven.
+					AddData("foo", "updated-green").
+					DieReleasePtr(),
+			},
+		},
+		"reflects the action taken for each child": {
+			Resource: resourceReady.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.
+								AddData("foo", "updated-green").
+								DieReleasePtr(),
+							configMapCreate.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									d.Name(testName + "-red")
+									d.AddAnnotation(idKey, "red")
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					r.ReflectChildrenStatusOnParent = func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+						parent.Status.Fields = map[string]string{}
+						for _, childResult := range result.Children {
+							parent.Status.Fields[childResult.Id] = string(childResult.Action)
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue", string(reconcilers.ChildSetActionUnchanged))
+					d.AddField("green", string(reconcilers.ChildSetActionUpdated))
+					d.AddField("red", string(reconcilers.ChildSetActionCreated))
+				}).
+				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-red")
+						d.AddAnnotation(idKey, "red")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectUpdates: []client.Object{
+				configMapGreenGiven.
+					AddData("foo", "updated-green").
+					DieReleasePtr(),
+			},
+		},
+		"reflects deleted children": {
+			Resource: resourceReady.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+						}, nil
+					}
+					r.ReflectChildrenStatusOnParent = func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+						parent.Status.Fields = map[string]string{}
+						for _, childResult := range result.Children {
+							parent.Status.Fields[childResult.Id] = string(childResult.Action)
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue", string(reconcilers.ChildSetActionUnchanged))
+					d.AddField("green", string(reconcilers.ChildSetActionDeleted))
+				}).
+				DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"reflects duplicate actual children": {
+			Resource: resourceReady.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-blue-duplicate")
+						d.UID(types.UID("c4f2a6c1-4b0e-4c36-9a3e-0a4f0e6d2b17"))
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+						}, nil
+					}
+					r.ReflectChildrenStatusOnParent = func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+						parent.Status.Fields = map[string]string{}
+						for _, childResult := range result.Children {
+							parent.Status.Fields[childResult.Id] = string(childResult.Action)
+							for i, duplicate := range childResult.Duplicates {
+								parent.Status.Fields[fmt.Sprintf("%s.duplicates.%d", childResult.Id, i)] = duplicate.Name
+							}
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue", string(reconcilers.ChildSetActionCreated))
+					d.AddField("blue.duplicates.0", testName+"-blue")
+					d.AddField("blue.duplicates.1", testName+"-blue-duplicate")
+				}).
+				DieReleasePtr(),
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-blue-duplicate")
+					}).
+					DieReleasePtr(), scheme),
+			},
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleasePtr(),
+			},
+		},
+		"errors for desired children with empty id": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									// clear existing id annotation
+									d.DieStamp(func(r *metav1.ObjectMeta) {
+										delete(r.Annotations, idKey)
+									})
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+		},
+		"errors for desired children with duplicate ids": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									d.AddAnnotation(idKey, "blue")
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+		},
+		"errors for desired children with duplicate ids across sources": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = nil
+					r.DesiredChildrenSources = []func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error){
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapBlueDesired.DieReleasePtr(),
+							}, nil
+						},
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapGreenDesired.
+									MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+										d.AddAnnotation(idKey, "blue")
+									}).
+									DieReleasePtr(),
+							}, nil
+						},
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if expected, actual := "duplicate child id found: blue, in DesiredChildrenSources[0] and DesiredChildrenSources[1]", err.Error(); expected != actual {
+					t.Errorf("expected error %q, got %q", expected, actual)
+				}
+			},
+		},
+		"deletes actual children with duplicate ids": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(idKey, "blue")
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven.DieReleasePtr(), scheme),
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven.DieReleasePtr(), scheme),
+			},
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleasePtr(),
+			},
+		},
+		"verifies stable child ids": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.VerifyIdentifyChildStability = true
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+		},
+		"unstable child id from a generated name": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.VerifyIdentifyChildStability = true
+					r.IdentifyChild = func(child *corev1.ConfigMap) string {
+						return fmt.Sprintf("%s/%s", child.GetAnnotations()[idKey], child.GetName())
+					}
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.
+								MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+									d.Name("")
+									d.GenerateName(testName + "-")
+								}).
+								DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if expected, actual := `unstable child id: "blue/" for the desired child, "blue/test-resource-x7k2q" once created`, err.Error(); expected != actual {
+					t.Errorf("expected error %q, got %q", expected, actual)
+				}
+			},
+		},
+		"unstable child id from the uid": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.VerifyIdentifyChildStability = true
+					r.IdentifyChild = func(child *corev1.ConfigMap) string {
+						return fmt.Sprintf("%s/%s", child.GetAnnotations()[idKey], child.GetUID())
+					}
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if expected, actual := `unstable child id: "blue/" for the desired child, "blue/00000000-0000-0000-0000-000000000000" once created`, err.Error(); expected != actual {
+					t.Errorf("expected error %q, got %q", expected, actual)
+				}
+			},
+		},
+		"deletes actual child resource missing id": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+				configMapGiven,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGiven.DieReleasePtr(), scheme),
+			},
+		},
+		"defines a finalizer when requested": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.Finalizer = testFinalizer
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
+						}, nil
+					}
+					r.OurChild = func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+						return true
+					}
+					r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+						return []client.ListOption{
+							client.InNamespace(testNamespace),
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+					d.Finalizers(testFinalizer)
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test.finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"forwards error listing children": {
+			Resource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ConfigMapList"),
+			},
+			ShouldErr: true,
+		},
+		"forwards error from child reconciler": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return []*corev1.ConfigMap{
+							configMapBlueDesired.DieReleasePtr(),
+							configMapGreenDesired.DieReleasePtr(),
+						}, nil
+					}
+					return r
 				},
 			},
 			WithReactors: []rtesting.ReactionFunc{
@@ -594,7 +1284,47 @@ func TestChildSetReconciler(t *testing.T) {
 				configMapBlueCreate.DieReleasePtr(),
 			},
 		},
-		"skip resource manager operations when OnlyReconcileChildStatus is returned": {
+		"skip resource manager operations when OnlyReconcileChildStatus is returned": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return nil, reconcilers.OnlyReconcileChildStatus
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+		},
+		"skip resource manager operations when OnlyReconcileChildStatus is returned, even when there are no actual or desired children": {
+			Resource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return nil, reconcilers.OnlyReconcileChildStatus
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.DieReleasePtr(),
+		},
+		"skip resource manager operations when OnlyReconcileChildStatus is returned from a source": {
 			Resource: resource.DieReleasePtr(),
 			GivenObjects: []client.Object{
 				configMapBlueGiven.DieReleasePtr(),
@@ -603,8 +1333,18 @@ func TestChildSetReconciler(t *testing.T) {
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return nil, reconcilers.OnlyReconcileChildStatus
+					r.DesiredChildren = nil
+					r.DesiredChildrenSources = []func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error){
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return nil, reconcilers.OnlyReconcileChildStatus
+						},
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapBlueDesired.
+									AddData("foo", "baz").
+									DieReleasePtr(),
+							}, nil
+						},
 					}
 					return r
 				},
@@ -616,31 +1356,138 @@ func TestChildSetReconciler(t *testing.T) {
 				}).
 				DieReleasePtr(),
 		},
-		"skip resource manager operations when OnlyReconcileChildStatus is returned, even when there are no actual or desired children": {
-			Resource: resource.
+		"errors when a desired children source returns an error": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = nil
+					r.DesiredChildrenSources = []func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error){
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return []*corev1.ConfigMap{
+								configMapBlueDesired.DieReleasePtr(),
+							}, nil
+						},
+						func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+							return nil, fmt.Errorf("test")
+						},
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+		},
+		"errors when desired children returns an error": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+						return nil, fmt.Errorf("test")
+					}
+					return r
+				},
+			},
+			ShouldErr: true,
+		},
+		"create children from an iterator": {
+			Resource: resourceReady.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = nil
+					r.DesiredChildrenFunc = func(ctx context.Context, resource *resources.TestResource) iter.Seq2[*corev1.ConfigMap, error] {
+						return func(yield func(*corev1.ConfigMap, error) bool) {
+							for _, child := range []*corev1.ConfigMap{
+								configMapBlueDesired.DieReleasePtr(),
+								configMapGreenDesired.DieReleasePtr(),
+							} {
+								if !yield(child, nil) {
+									return
+								}
+							}
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
 				StatusDie(func(d *dies.TestResourceStatusDie) {
 					d.AddField("blue.foo", "bar")
 					d.AddField("green.foo", "bar")
 				}).
 				DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleasePtr(),
+				configMapGreenCreate.DieReleasePtr(),
+			},
+		},
+		"stops iterating desired children at the first duplicate id": {
+			Resource: resourceReady.DieReleasePtr(),
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return nil, reconcilers.OnlyReconcileChildStatus
+					r.DesiredChildren = nil
+					r.DesiredChildrenFunc = func(ctx context.Context, resource *resources.TestResource) iter.Seq2[*corev1.ConfigMap, error] {
+						return func(yield func(*corev1.ConfigMap, error) bool) {
+							if !yield(configMapBlueDesired.DieReleasePtr(), nil) {
+								return
+							}
+							if !yield(configMapBlueDesired.DieReleasePtr(), nil) {
+								return
+							}
+							t.Errorf("expected iteration to stop at the duplicate child")
+						}
 					}
 					return r
 				},
 			},
-			ExpectResource: resourceReady.DieReleasePtr(),
+			ShouldErr: true,
 		},
-		"errors when desired children returns an error": {
+		"skip resource manager operations when OnlyReconcileChildStatus is yielded from an iterator": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					r := defaultChildSetReconciler(c)
+					r.DesiredChildren = nil
+					r.DesiredChildrenFunc = func(ctx context.Context, resource *resources.TestResource) iter.Seq2[*corev1.ConfigMap, error] {
+						return func(yield func(*corev1.ConfigMap, error) bool) {
+							if !yield(nil, reconcilers.OnlyReconcileChildStatus) {
+								return
+							}
+							yield(configMapBlueDesired.AddData("foo", "baz").DieReleasePtr(), nil)
+						}
+					}
+					return r
+				},
+			},
+			ExpectResource: resourceReady.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+					d.AddField("green.foo", "bar")
+				}).
+				DieReleasePtr(),
+		},
+		"errors when the desired children iterator yields an error": {
 			Resource: resourceReady.DieReleasePtr(),
 			Metadata: map[string]interface{}{
 				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
 					r := defaultChildSetReconciler(c)
-					r.DesiredChildren = func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
-						return nil, fmt.Errorf("test")
+					r.DesiredChildren = nil
+					r.DesiredChildrenFunc = func(ctx context.Context, resource *resources.TestResource) iter.Seq2[*corev1.ConfigMap, error] {
+						return func(yield func(*corev1.ConfigMap, error) bool) {
+							if !yield(configMapBlueDesired.DieReleasePtr(), nil) {
+								return
+							}
+							if !yield(nil, fmt.Errorf("test")) {
+								return
+							}
+							t.Errorf("expected iteration to stop at the error")
+						}
 					}
 					return r
 				},
@@ -654,6 +1501,189 @@ func TestChildSetReconciler(t *testing.T) {
 	})
 }
 
+func TestChildSetReconciler_Unstructured(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	idKey := fmt.Sprintf("%s/child-id", resources.GroupVersion.Group)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		APIVersion("testing.reconciler.runtime/v1").
+		Kind("TestResource").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+			)
+		})
+	resourceReady := resource.
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+			)
+		})
+
+	configMapBlueCreate := diecorev1.ConfigMapBlank.
+		APIVersion("v1").
+		Kind("ConfigMap").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName + "-blue")
+			d.AddAnnotation(idKey, "blue")
+			d.ControlledBy(resource, scheme)
+		}).
+		AddData("foo", "bar")
+	configMapBlueGiven := configMapBlueCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.UID(types.UID("a0e91ff9-bf42-4bc7-9253-2a6581b07e4d"))
+		})
+
+	defaultChildSetReconciler := func(_ reconcilers.Config) *reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList] {
+		return &reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+			// ChildListType is derived from the ChildType's kind
+			ChildType: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+				},
+			},
+			DesiredChildren: func(ctx context.Context, parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+				fields, ok, _ := unstructured.NestedStringMap(parent.Object, "spec", "fields")
+				if !ok || len(fields) == 0 {
+					return nil, nil
+				}
+
+				children := []*unstructured.Unstructured{}
+				for id, v := range fields {
+					child := &unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": "v1",
+							"kind":       "ConfigMap",
+							"metadata": map[string]interface{}{
+								"namespace": parent.GetNamespace(),
+								"name":      fmt.Sprintf("%s-%s", parent.GetName(), id),
+								"annotations": map[string]interface{}{
+									idKey: id,
+								},
+							},
+							"data": map[string]interface{}{
+								"foo": v,
+							},
+						},
+					}
+					children = append(children, child)
+				}
+				return children, nil
+			},
+			IdentifyChild: func(child *unstructured.Unstructured) string {
+				return child.GetAnnotations()[idKey]
+			},
+			ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+			ReflectChildrenStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, result reconcilers.ChildSetResult[*unstructured.Unstructured]) {
+				if err := result.AggregateError(); err != nil {
+					return
+				}
+				unstructured.RemoveNestedField(parent.Object, "status", "fields")
+				for _, childResult := range result.Children {
+					if childResult.Child == nil {
+						continue
+					}
+					for k, v := range childResult.Child.Object["data"].(map[string]interface{}) {
+						unstructured.SetNestedField(parent.Object, v, "status", "fields", fmt.Sprintf("%s.%s", childResult.Id, k))
+					}
+				}
+				readyCond := map[string]interface{}{
+					"type":    "Ready",
+					"status":  "True",
+					"reason":  "Ready",
+					"message": "",
+				}
+				unstructured.SetNestedSlice(parent.Object, []interface{}{readyCond}, "status", "conditions")
+			},
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*unstructured.Unstructured]{
+		"in sync no children": {
+			Resource: resourceReady.DieReleaseUnstructured(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return defaultChildSetReconciler(c)
+				},
+			},
+		},
+		"in sync with children": {
+			Resource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("blue", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleaseUnstructured(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.CalledAtMostTimes("list", "ConfigMapList", 1),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return defaultChildSetReconciler(c)
+				},
+			},
+		},
+		"create child": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("blue", "bar")
+				}).
+				DieReleaseUnstructured(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return defaultChildSetReconciler(c)
+				},
+			},
+			ExpectResource: resourceReady.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("blue", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("blue.foo", "bar")
+				}).
+				DieReleaseUnstructured(),
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleaseUnstructured(),
+			},
+		},
+		"delete child": {
+			Resource: resourceReady.DieReleaseUnstructured(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return defaultChildSetReconciler(c)
+				},
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapBlueGiven, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*unstructured.Unstructured], c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured])(t, c)
+	})
+}
+
 func TestChildSetReconciler_Validate(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -665,7 +1695,7 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 			name:       "empty",
 			parent:     &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{},
-			shouldErr:  `ChildSetReconciler "PodChildSetReconciler" must implement DesiredChildren`,
+			shouldErr:  `[ChildSetReconciler "PodChildSetReconciler" must implement DesiredChildren, DesiredChildrenSources or DesiredChildrenFunc, ChildSetReconciler "PodChildSetReconciler" must implement ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError, ChildSetReconciler "PodChildSetReconciler" must implement IdentifyChild, ChildSetReconciler "PodChildSetReconciler" must implement ChildObjectManager]`,
 		},
 		{
 			name:   "valid",
@@ -682,29 +1712,112 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 			},
 		},
 		{
-			name:   "valid, ReflectChildrenStatusOnParentWithError",
+			name:   "valid, ReflectChildrenStatusOnParentWithError",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				ChildType:       &corev1.Pod{},
+				ChildListType:   &corev1.PodList{},
+				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParentWithError: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) error {
+					return nil
+				},
+				IdentifyChild: func(child *corev1.Pod) string { return "" },
+			},
+		},
+		{
+			name:   "valid, DesiredChildrenFunc",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChildrenFunc: func(ctx context.Context, parent *corev1.ConfigMap) iter.Seq2[*corev1.Pod, error] {
+					return func(yield func(*corev1.Pod, error) bool) {}
+				},
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
+			},
+		},
+		{
+			name:   "DesiredChildrenFunc with DesiredChildren",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:            "DesiredChildrenFunc with DesiredChildren",
+				ChildType:       &corev1.Pod{},
+				ChildListType:   &corev1.PodList{},
+				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				DesiredChildrenFunc: func(ctx context.Context, parent *corev1.ConfigMap) iter.Seq2[*corev1.Pod, error] {
+					return func(yield func(*corev1.Pod, error) bool) {}
+				},
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
+			},
+			shouldErr: `ChildSetReconciler "DesiredChildrenFunc with DesiredChildren" must not implement DesiredChildrenFunc with DesiredChildren or DesiredChildrenSources`,
+		},
+		{
+			name:   "ChildType missing",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name: "ChildType missing",
+				// ChildType:                  &corev1.Pod{},
+				ChildListType:   &corev1.PodList{},
+				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
+			},
+		},
+		{
+			name:   "ChildListType missing",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:      "ChildListType missing",
+				ChildType: &corev1.Pod{},
+				// ChildListType:              &corev1.PodList{},
+				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
+			},
+		},
+		{
+			name:   "DesiredChildren missing",
 			parent: &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
-				ChildType:       &corev1.Pod{},
-				ChildListType:   &corev1.PodList{},
-				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				Name:          "DesiredChildren missing",
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				// DesiredChildren:            func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
 				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
 					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
 				},
-				ReflectChildrenStatusOnParentWithError: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) error {
-					return nil
-				},
-				IdentifyChild: func(child *corev1.Pod) string { return "" },
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
+			shouldErr: `ChildSetReconciler "DesiredChildren missing" must implement DesiredChildren, DesiredChildrenSources or DesiredChildrenFunc`,
 		},
 		{
-			name:   "ChildType missing",
+			name:   "valid, DesiredChildrenSources",
 			parent: &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
-				Name: "ChildType missing",
-				// ChildType:                  &corev1.Pod{},
-				ChildListType:   &corev1.PodList{},
-				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				ChildType:     &corev1.Pod{},
+				ChildListType: &corev1.PodList{},
+				DesiredChildrenSources: []func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error){
+					func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+					func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				},
 				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
 					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
 				},
@@ -713,35 +1826,42 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 			},
 		},
 		{
-			name:   "ChildListType missing",
+			name:   "DesiredChildren and DesiredChildrenSources",
 			parent: &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
-				Name:      "ChildListType missing",
-				ChildType: &corev1.Pod{},
-				// ChildListType:              &corev1.PodList{},
+				Name:            "DesiredChildren and DesiredChildrenSources",
+				ChildType:       &corev1.Pod{},
+				ChildListType:   &corev1.PodList{},
 				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				DesiredChildrenSources: []func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error){
+					func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				},
 				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
 					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
 				},
 				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
 				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
+			shouldErr: `ChildSetReconciler "DesiredChildren and DesiredChildrenSources" must not implement both DesiredChildren and DesiredChildrenSources`,
 		},
 		{
-			name:   "DesiredChildren missing",
+			name:   "DesiredChildrenSources nil source",
 			parent: &corev1.ConfigMap{},
 			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
-				Name:          "DesiredChildren missing",
+				Name:          "DesiredChildrenSources nil source",
 				ChildType:     &corev1.Pod{},
 				ChildListType: &corev1.PodList{},
-				// DesiredChildren:            func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				DesiredChildrenSources: []func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error){
+					func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+					nil,
+				},
 				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
 					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
 				},
 				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
 				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
-			shouldErr: `ChildSetReconciler "DesiredChildren missing" must implement DesiredChildren`,
+			shouldErr: `ChildSetReconciler "DesiredChildrenSources nil source" must not define a nil DesiredChildrenSources[1]`,
 		},
 		{
 			name:   "ReflectChildrenStatusOnParent missing",
@@ -824,7 +1944,7 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 				Finalizer:                     "my-finalizer",
 				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
-			shouldErr: `ChildSetReconciler "Finalizer without OurChild" must implement OurChild since owner references are not used`,
+			shouldErr: `[ChildSetReconciler "Finalizer without OurChild" must implement OurChild since owner references are not used, ChildSetReconciler "Finalizer without OurChild" must implement ListOptions since owner references are not used]`,
 		},
 		{
 			name:   "SkipOwnerReference without OurChild",
@@ -841,7 +1961,7 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 				SkipOwnerReference:            true,
 				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
-			shouldErr: `ChildSetReconciler "SkipOwnerReference without OurChild" must implement OurChild since owner references are not used`,
+			shouldErr: `[ChildSetReconciler "SkipOwnerReference without OurChild" must implement OurChild since owner references are not used, ChildSetReconciler "SkipOwnerReference without OurChild" must implement ListOptions since owner references are not used]`,
 		},
 		{
 			name:   "OurChild",
@@ -858,6 +1978,23 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
 			},
 		},
+		{
+			name:   "negative ListPageSize",
+			parent: &corev1.ConfigMap{},
+			reconciler: &reconcilers.ChildSetReconciler[*corev1.ConfigMap, *corev1.Pod, *corev1.PodList]{
+				Name:            "negative ListPageSize",
+				ChildType:       &corev1.Pod{},
+				ChildListType:   &corev1.PodList{},
+				DesiredChildren: func(ctx context.Context, parent *corev1.ConfigMap) ([]*corev1.Pod, error) { return nil, nil },
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.Pod]{
+					MergeBeforeUpdate: func(current, desired *corev1.Pod) {},
+				},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *corev1.ConfigMap, result reconcilers.ChildSetResult[*corev1.Pod]) {},
+				IdentifyChild:                 func(child *corev1.Pod) string { return "" },
+				ListPageSize:                  -1,
+			},
+			shouldErr: `ChildSetReconciler "negative ListPageSize" must not define a negative ListPageSize`,
+		},
 		{
 			name:   "ChildObjectManager missing",
 			parent: &corev1.ConfigMap{},
@@ -886,3 +2023,495 @@ func TestChildSetReconciler_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestChildSetReconciler_Validate_Unstructured(t *testing.T) {
+	configMapType := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				ChildType: configMapType(),
+				DesiredChildren: func(ctx context.Context, parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, result reconcilers.ChildSetResult[*unstructured.Unstructured]) {
+				},
+				IdentifyChild: func(child *unstructured.Unstructured) string { return "" },
+			},
+		},
+		{
+			name: "ChildType missing",
+			reconciler: &reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				Name: "ChildType missing",
+				// ChildType:          configMapType(),
+				DesiredChildren: func(ctx context.Context, parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, result reconcilers.ChildSetResult[*unstructured.Unstructured]) {
+				},
+				IdentifyChild: func(child *unstructured.Unstructured) string { return "" },
+			},
+			shouldErr: `ChildSetReconciler "ChildType missing" must define ChildType with an apiVersion and kind for unstructured types`,
+		},
+		{
+			name: "ChildType missing kind",
+			reconciler: &reconcilers.ChildSetReconciler[*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+				Name: "ChildType missing kind",
+				ChildType: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "v1",
+					},
+				},
+				DesiredChildren: func(ctx context.Context, parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+				ChildObjectManager: &rtesting.StubObjectManager[*unstructured.Unstructured]{},
+				ReflectChildrenStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, result reconcilers.ChildSetResult[*unstructured.Unstructured]) {
+				},
+				IdentifyChild: func(child *unstructured.Unstructured) string { return "" },
+			},
+			shouldErr: `ChildSetReconciler "ChildType missing kind" must define ChildType with an apiVersion and kind for unstructured types`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := reconcilers.StashResourceType(context.TODO(), configMapType())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				var errString string
+				if err != nil {
+					errString = err.Error()
+				}
+				t.Errorf("validate() error = %q, shouldErr %q", errString, c.shouldErr)
+			}
+		})
+	}
+}
+
+func TestChildSetReconciler_SetupWithManager_UnregisteredChild(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+	}
+
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	r := &reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+		DesiredChildren: func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+			return nil, nil
+		},
+		ChildObjectManager: &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+		ReflectChildrenStatusOnParent: func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+		},
+		IdentifyChild: func(child *corev1.ConfigMap) string {
+			return child.Name
+		},
+	}
+
+	err := r.SetupWithManager(ctx, nil, nil)
+	if expected := `ChildSetReconciler "ConfigMapChildSetReconciler" ChildType ConfigMap must be registered in the scheme`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestChildSetReconciler_SetupWithManager_MetadataOnlyListing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+	}
+
+	newReconciler := func(metadataOnlyListing bool) *reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList] {
+		return &reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+			DesiredChildren: func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+				return nil, nil
+			},
+			ChildObjectManager: &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+			ReflectChildrenStatusOnParent: func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+			},
+			IdentifyChild: func(child *corev1.ConfigMap) string {
+				return child.Name
+			},
+			MetadataOnlyListing: metadataOnlyListing,
+		}
+	}
+
+	// the watched object for each child watch, the owner watch and the tracked watch
+	childWatches := func(watched []client.Object) []client.Object {
+		children := []client.Object{}
+		for _, obj := range watched {
+			if _, ok := obj.(*resources.TestResource); !ok {
+				children = append(children, obj)
+			}
+		}
+		return children
+	}
+
+	t.Run("full objects", func(t *testing.T) {
+		ctx := context.Background()
+		ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+		ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+		recorder := startWatches(t, ctx, scheme, 3, newReconciler(false).SetupWithManager)
+		for _, obj := range childWatches(recorder.Watched()) {
+			if _, ok := obj.(*corev1.ConfigMap); !ok {
+				t.Errorf("expected child to be watched as a ConfigMap, got %T", obj)
+			}
+		}
+	})
+
+	t.Run("metadata only", func(t *testing.T) {
+		ctx := context.Background()
+		ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+		ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+		recorder := startWatches(t, ctx, scheme, 3, newReconciler(true).SetupWithManager)
+		for _, obj := range childWatches(recorder.Watched()) {
+			partial, ok := obj.(*metav1.PartialObjectMetadata)
+			if !ok {
+				t.Errorf("expected child to be watched as PartialObjectMetadata, got %T", obj)
+				continue
+			}
+			if expected, actual := "ConfigMap", partial.GetObjectKind().GroupVersionKind().Kind; expected != actual {
+				t.Errorf("expected metadata watch for kind %q, got %q", expected, actual)
+			}
+		}
+	})
+}
+
+func TestChildSetReconciler_MetadataOnlyListing_Hydration(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.UID(types.UID("c2fb4d5e-1f16-4b0e-9b58-3d1a8cbe5c7a"))
+		})
+
+	configMapDesired := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.ControlledBy(resource, scheme)
+		}).
+		AddData("foo", "bar")
+	configMapBlueDesired := configMapDesired.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-blue")
+		})
+	configMapGreenDesired := configMapDesired.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-green")
+		})
+	givenObjects := []client.Object{
+		configMapBlueDesired.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.UID(types.UID("a0e91ff9-bf42-4bc7-9253-2a6581b07e4d"))
+			}).
+			DieReleasePtr(),
+		configMapGreenDesired.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.UID(types.UID("62af4b9a-767a-4f32-b62c-e4bccbfa8ef0"))
+			}).
+			DieReleasePtr(),
+	}
+
+	// only the first reconcile reads the children, later reconciles observe the same resourceVersion
+	expectConfig := rtesting.ExpectConfig{
+		Scheme:               scheme,
+		GivenObjects:         givenObjects,
+		APIGivenObjects:      givenObjects,
+		ExpectAPIReaderReads: ptr.To(2),
+	}
+	config := expectConfig.Config()
+
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, config)
+	ctx = reconcilers.StashOriginalConfig(ctx, config)
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	blueValue := "bar"
+	r := &reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+		MetadataOnlyListing: true,
+		DesiredChildren: func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+			return []*corev1.ConfigMap{
+				configMapBlueDesired.AddData("foo", blueValue).DieReleasePtr(),
+				configMapGreenDesired.DieReleasePtr(),
+			}, nil
+		},
+		IdentifyChild: func(child *corev1.ConfigMap) string {
+			return child.Name
+		},
+		ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{
+			MergeBeforeUpdate: func(current, desired *corev1.ConfigMap) {
+				current.Data = desired.Data
+			},
+		},
+		ReflectChildrenStatusOnParent: func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+			if err := result.AggregateError(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		},
+	}
+
+	reconcile := func() {
+		t.Helper()
+		if _, err := r.Reconcile(stash.WithContext(ctx), resource.DieReleasePtr()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// in sync
+	reconcile()
+	reconcile()
+	expectConfig.AssertAPIReaderExpectations(t)
+
+	// the child updated by the reconciler is observed from the update
+	blueValue = "baz"
+	reconcile()
+	reconcile()
+
+	blue := &corev1.ConfigMap{}
+	if err := config.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testName + "-blue"}, blue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected, actual := "baz", blue.Data["foo"]; expected != actual {
+		t.Errorf("expected blue child to be updated to %q, got %q", expected, actual)
+	}
+	expectConfig.AssertAPIReaderExpectations(t)
+}
+
+func TestListOurChildren(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.UID(types.UID("c2fb4d5e-1f16-4b0e-9b58-3d1a8cbe5c7a"))
+		})
+
+	configMapGiven := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.ControlledBy(resource, scheme)
+		})
+
+	givenObjects := []client.Object{
+		configMapGiven.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(testName + "-blue")
+			}).
+			AddData("foo", "bar"),
+		configMapGiven.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(testName + "-green")
+			}),
+		configMapGiven.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(testName + "-red")
+			}),
+		configMapGiven.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(testName + "-orphan")
+				d.OwnerReferences()
+			}),
+		configMapGiven.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Namespace("other-namespace")
+				d.Name(testName + "-elsewhere")
+			}),
+	}
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme:          scheme,
+		GivenObjects:    givenObjects,
+		APIGivenObjects: givenObjects,
+	}
+
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+
+	newReconciler := func() *reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList] {
+		return &reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+			OurChild: func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+				return child.Name != testName+"-red"
+			},
+		}
+	}
+
+	names := func(children []*corev1.ConfigMap) []string {
+		n := []string{}
+		for _, child := range children {
+			n = append(n, child.Name)
+		}
+		sort.Strings(n)
+		return n
+	}
+
+	t.Run("full objects", func(t *testing.T) {
+		children, err := reconcilers.ListOurChildren(ctx, resource.DieReleasePtr(), newReconciler())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected, actual := []string{testName + "-blue", testName + "-green"}, names(children); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("expected children %v, got %v", expected, actual)
+		}
+		for _, child := range children {
+			if child.Name == testName+"-blue" && child.Data["foo"] != "bar" {
+				t.Errorf("expected data to be populated, got %v", child.Data)
+			}
+		}
+	})
+
+	t.Run("metadata only", func(t *testing.T) {
+		r := newReconciler()
+		r.MetadataOnlyListing = true
+		children, err := reconcilers.ListOurChildren(ctx, resource.DieReleasePtr(), r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected, actual := []string{testName + "-blue", testName + "-green"}, names(children); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("expected children %v, got %v", expected, actual)
+		}
+		for _, child := range children {
+			if len(child.Data) != 0 {
+				t.Errorf("expected only metadata to be populated, got data %v", child.Data)
+			}
+		}
+	})
+
+	t.Run("list options", func(t *testing.T) {
+		r := newReconciler()
+		r.ListOptions = func(ctx context.Context, resource *resources.TestResource) []client.ListOption {
+			return []client.ListOption{
+				client.InNamespace("other-namespace"),
+			}
+		}
+		children, err := reconcilers.ListOurChildren(ctx, resource.DieReleasePtr(), r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected, actual := []string{testName + "-elsewhere"}, names(children); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("expected children %v, got %v", expected, actual)
+		}
+	})
+
+	t.Run("paged with the APIReader", func(t *testing.T) {
+		config := expectConfig.Config()
+		reader := &pagingReader{Reader: config.APIReader}
+		config.APIReader = reader
+		ctx := reconcilers.StashConfig(context.Background(), config)
+
+		r := newReconciler()
+		r.ListPageSize = 1
+		children, err := reconcilers.ListOurChildren(ctx, resource.DieReleasePtr(), r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected, actual := []string{testName + "-blue", testName + "-green"}, names(children); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("expected children %v, got %v", expected, actual)
+		}
+		if expected, actual := 4, reader.lists; expected != actual {
+			t.Errorf("expected %d list requests, got %d", expected, actual)
+		}
+	})
+
+	t.Run("paged with a cache backed APIReader", func(t *testing.T) {
+		config := expectConfig.Config()
+		reader := &pagingReader{Reader: config.APIReader, cacheLike: true}
+		config.APIReader = reader
+		ctx := reconcilers.StashConfig(context.Background(), config)
+
+		r := newReconciler()
+		r.ListPageSize = 1
+		children, err := reconcilers.ListOurChildren(ctx, resource.DieReleasePtr(), r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(children) > 1 {
+			t.Errorf("expected at most one child from the first page, got %v", names(children))
+		}
+		if expected, actual := 1, reader.lists; expected != actual {
+			t.Errorf("expected %d list requests, got %d", expected, actual)
+		}
+	})
+}
+
+// pagingReader honors the limit and continue list options, which the fake client ignores. When
+// cacheLike is true, lists are truncated to the limit and cannot be continued, like an informer
+// cache.
+type pagingReader struct {
+	client.Reader
+	cacheLike bool
+	lists     int
+}
+
+func (r *pagingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	r.lists++
+
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	limit, continueToken := listOpts.Limit, listOpts.Continue
+	listOpts.Limit, listOpts.Continue = 0, ""
+	if err := r.Reader.List(ctx, list, listOpts); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].(client.Object).GetName() < items[j].(client.Object).GetName()
+	})
+
+	start, end, next := 0, len(items), ""
+	if continueToken != "" && !r.cacheLike {
+		if start, err = strconv.Atoi(continueToken); err != nil {
+			return err
+		}
+	}
+	if limit > 0 && int64(end-start) > limit {
+		end = start + int(limit)
+		next = strconv.Itoa(end)
+	}
+	if r.cacheLike {
+		next = "continue-not-supported"
+	}
+	if err := meta.SetList(list, items[start:end]); err != nil {
+		return err
+	}
+	list.SetContinue(next)
+	return nil
+}
@@ -17,19 +17,29 @@ limitations under the License.
 package reconcilers
 
 import (
-	"unicode"
-	"unicode/utf8"
-
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	rcmpopts "reconciler.io/runtime/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // IgnoreAllUnexported is a cmp.Option that ignores unexported fields in all structs
-var IgnoreAllUnexported = cmp.FilterPath(func(p cmp.Path) bool {
-	// from cmp.IgnoreUnexported with type info removed
-	sf, ok := p.Index(-1).(cmp.StructField)
-	if !ok {
-		return false
-	}
-	r, _ := utf8.DecodeRuneInString(sf.Name())
-	return !unicode.IsUpper(r)
-}, cmp.Ignore())
+var IgnoreAllUnexported = rcmpopts.IgnoreAllUnexported
+
+// ResourceChanged reports whether the metadata, spec or status of the current resource differ
+// from the original resource. Fields managed by the API server that do not reflect a meaningful
+// change, like the resourceVersion, creationTimestamp and the lastTransitionTime of conditions,
+// are ignored. Unexported fields are also ignored.
+//
+// The original resource as loaded at the start of the reconcile request is available via
+// RetrieveOriginalResource.
+func ResourceChanged(original, current client.Object) bool {
+	return !cmp.Equal(original, current,
+		rcmpopts.IgnoreAllUnexported,
+		rcmpopts.IgnoreLastTransitionTime,
+		rcmpopts.IgnoreTypeMeta,
+		rcmpopts.IgnoreCreationTimestamp,
+		rcmpopts.IgnoreResourceVersion,
+		cmpopts.EquateEmpty(),
+	)
+}
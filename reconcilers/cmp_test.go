@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/internal/resources"
 	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
@@ -92,3 +95,85 @@ func TestIgnoreAllUnexported(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceChanged(t *testing.T) {
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-resource")
+			d.ResourceVersion("1")
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+			)
+		})
+
+	tests := map[string]struct {
+		original     *resources.TestResource
+		current      *resources.TestResource
+		shouldChange bool
+	}{
+		"unchanged": {
+			original:     resource.DieReleasePtr(),
+			current:      resource.DieReleasePtr(),
+			shouldChange: false,
+		},
+		"ignores resourceVersion": {
+			original: resource.DieReleasePtr(),
+			current: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("2")
+				}).
+				DieReleasePtr(),
+			shouldChange: false,
+		},
+		"ignores condition lastTransitionTime": {
+			original: resource.DieReleasePtr(),
+			current: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready").
+							LastTransitionTime(metav1.Now()),
+					)
+				}).
+				DieReleasePtr(),
+			shouldChange: false,
+		},
+		"metadata changed": {
+			original: resource.DieReleasePtr(),
+			current: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("foo", "bar")
+				}).
+				DieReleasePtr(),
+			shouldChange: true,
+		},
+		"spec changed": {
+			original: resource.DieReleasePtr(),
+			current: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			shouldChange: true,
+		},
+		"status changed": {
+			original: resource.DieReleasePtr(),
+			current: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			shouldChange: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if changed := reconcilers.ResourceChanged(tc.original, tc.current); changed != tc.shouldChange {
+				t.Errorf("ResourceChanged() = %v, expected %v", changed, tc.shouldChange)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtime "reconciler.io/runtime/time"
+)
+
+// RecordConditionTransition records an event on the reconciled resource when the status of a
+// condition changes between the old and updated condition. Transitions to False are recorded as
+// Warning events, all other transitions are recorded as Normal events. A nil old condition is
+// treated as unset. No event is recorded when the updated condition is nil or the status is unchanged.
+//
+// The event reason is the condition type concatenated with the updated status, for example
+// `ReadyFalse`. The event is recorded with the config that loaded the reconciled resource.
+func RecordConditionTransition(ctx context.Context, resource client.Object, condType string, old, updated *metav1.Condition) {
+	if updated == nil {
+		return
+	}
+	oldStatus := "Unset"
+	if old != nil {
+		if old.Status == updated.Status {
+			return
+		}
+		oldStatus = string(old.Status)
+	}
+
+	eventType := corev1.EventTypeNormal
+	if updated.Status == metav1.ConditionFalse {
+		eventType = corev1.EventTypeWarning
+	}
+	message := fmt.Sprintf("Condition %s transitioned from %s to %s with reason %q", condType, oldStatus, updated.Status, updated.Reason)
+	if updated.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, updated.Message)
+	}
+
+	c := RetrieveOriginalConfigOrDie(ctx)
+	c.RecorderFor(ctx).Event(resource, eventType, fmt.Sprintf("%s%s", condType, updated.Status), message)
+}
+
+// ConditionTransitionThrottle records condition transition events like
+// RecordConditionTransition, while suppressing repeated identical transitions for a resource.
+// A flapping condition records each distinct transition once per window, rather than an event
+// for every flap.
+//
+// Transitions are tracked per resource by its kind and UID, a recreated resource is throttled
+// independently of the resource it replaced. Resources without a UID are tracked by namespace and
+// name. Recorded transitions expire lazily once the window elapses.
+//
+// The throttle is safe for concurrent use and should be shared across reconcile requests, for
+// example as a field of the reconciler. The zero value is ready to use.
+type ConditionTransitionThrottle struct {
+	// Window is the duration an identical transition is suppressed for after it is recorded.
+	//
+	// Defaults to 5 minutes.
+	//
+	// +optional
+	Window time.Duration
+
+	m         sync.Mutex
+	recorded  map[conditionTransition]time.Time
+	lastSweep time.Time
+}
+
+// conditionTransition identifies a transition of a condition on a resource
+type conditionTransition struct {
+	gvk       schema.GroupVersionKind
+	uid       types.UID
+	name      types.NamespacedName
+	condType  string
+	oldStatus metav1.ConditionStatus
+	newStatus metav1.ConditionStatus
+	reason    string
+}
+
+const defaultConditionTransitionWindow = 5 * time.Minute
+
+// RecordConditionTransition records an event for the transition between the old and updated
+// condition, see RecordConditionTransition. The event is skipped when the same transition, with
+// the same reason, was recorded for the resource within the window. The current time is
+// retrieved with RetrieveNow.
+func (t *ConditionTransitionThrottle) RecordConditionTransition(ctx context.Context, resource client.Object, condType string, old, updated *metav1.Condition) {
+	if updated == nil || (old != nil && old.Status == updated.Status) {
+		return
+	}
+	transition := conditionTransition{
+		gvk:       gvk(RetrieveOriginalConfigOrDie(ctx), resource),
+		uid:       resource.GetUID(),
+		condType:  condType,
+		newStatus: updated.Status,
+		reason:    updated.Reason,
+	}
+	if old != nil {
+		transition.oldStatus = old.Status
+	}
+	if transition.uid == "" {
+		transition.name = namespaceName(resource)
+	}
+
+	window := t.Window
+	if window <= 0 {
+		window = defaultConditionTransitionWindow
+	}
+	now := rtime.RetrieveNow(ctx)
+
+	t.m.Lock()
+	if t.recorded == nil {
+		t.recorded = map[conditionTransition]time.Time{}
+	}
+	at, ok := t.recorded[transition]
+	suppressed := ok && now.Before(at.Add(window))
+	if !suppressed {
+		t.recorded[transition] = now
+	}
+	if !now.Before(t.lastSweep.Add(window)) {
+		// forget expired transitions for resources that stopped transitioning, at most once per
+		// window
+		for k, at := range t.recorded {
+			if !now.Before(at.Add(window)) {
+				delete(t.recorded, k)
+			}
+		}
+		t.lastSweep = now
+	}
+	t.m.Unlock()
+
+	if suppressed {
+		return
+	}
+	RecordConditionTransition(ctx, resource, condType, old, updated)
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRecordConditionTransition(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	unknown := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ready := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready")
+	notReady := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionFalse).Reason("Failed").Message("something went wrong")
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"initialized": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"New": unknown.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "ReadyUnknown",
+					`Condition Ready transitioned from Unset to Unknown with reason "Initializing"`),
+			},
+		},
+		"became ready": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Old": unknown.DieReleasePtr(),
+				"New": ready.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "ReadyTrue",
+					`Condition Ready transitioned from Unknown to True with reason "Ready"`),
+			},
+		},
+		"became not ready": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Old": ready.DieReleasePtr(),
+				"New": notReady.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed": something went wrong`),
+			},
+		},
+		"unchanged status": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Old": notReady.DieReleasePtr(),
+				"New": notReady.Reason("OtherFailure").DieReleasePtr(),
+			},
+		},
+		"removed condition": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Old": ready.DieReleasePtr(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		var old, updated *metav1.Condition
+		if v, ok := rtc.Metadata["Old"]; ok {
+			old = v.(*metav1.Condition)
+		}
+		if v, ok := rtc.Metadata["New"]; ok {
+			updated = v.(*metav1.Condition)
+		}
+		return &reconcilers.SyncReconciler[*resources.TestResource]{
+			Sync: func(ctx context.Context, resource *resources.TestResource) error {
+				reconcilers.RecordConditionTransition(ctx, resource, apis.ConditionReady, old, updated)
+				return nil
+			},
+		}
+	})
+}
+
+func TestConditionTransitionThrottle(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := time.Now().Truncate(time.Second)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	otherResource := resource.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other-resource")
+		})
+	resourceWithUID := resource.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.UID("11111111-1111-1111-1111-111111111111")
+		})
+	recreatedResource := resource.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.UID("22222222-2222-2222-2222-222222222222")
+		})
+	otherKindResource := dies.TestResourceNoStatusBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.UID("11111111-1111-1111-1111-111111111111")
+		})
+
+	ready := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready")
+	notReady := diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionFalse).Reason("Failed")
+
+	type transition struct {
+		resource     client.Object
+		old, updated *metav1.Condition
+		// elapsed is the time since the test started the transition occurs at
+		elapsed time.Duration
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"repeated identical transitions": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: time.Minute},
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 2 * time.Minute},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+		"flapping condition": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{old: notReady.DieReleasePtr(), updated: ready.DieReleasePtr(), elapsed: time.Second},
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 2 * time.Second},
+					{old: notReady.DieReleasePtr(), updated: ready.DieReleasePtr(), elapsed: 3 * time.Second},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "ReadyTrue",
+					`Condition Ready transitioned from False to True with reason "Ready"`),
+			},
+		},
+		"identical transition after the window": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 5 * time.Minute},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+		"identical transition with a different reason": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{old: ready.DieReleasePtr(), updated: notReady.Reason("OtherFailure").DieReleasePtr(), elapsed: time.Second},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "OtherFailure"`),
+			},
+		},
+		"identical transition for a different resource": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{resource: otherResource.DieReleasePtr(), old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(otherResource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+		"identical transition for a recreated resource": {
+			Resource: resourceWithUID.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{resource: recreatedResource.DieReleasePtr(), old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: time.Second},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceWithUID, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(recreatedResource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+		"identical transition for a different kind": {
+			Resource: resourceWithUID.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{resource: otherKindResource.DieReleasePtr(), old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: time.Second},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceWithUID, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(otherKindResource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+		"expired transition after other transitions": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Transitions": []transition{
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr()},
+					{resource: otherResource.DieReleasePtr(), old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 4 * time.Minute},
+					{old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 6 * time.Minute},
+					{resource: otherResource.DieReleasePtr(), old: ready.DieReleasePtr(), updated: notReady.DieReleasePtr(), elapsed: 7 * time.Minute},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(otherResource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReadyFalse",
+					`Condition Ready transitioned from True to False with reason "Failed"`),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		throttle := &reconcilers.ConditionTransitionThrottle{}
+		return &reconcilers.SyncReconciler[*resources.TestResource]{
+			Sync: func(ctx context.Context, resource *resources.TestResource) error {
+				for _, tr := range rtc.Metadata["Transitions"].([]transition) {
+					// the stashed time is not overwritten, derive a new context at the elapsed time
+					trCtx := reconcilers.StashOriginalConfig(context.Background(), reconcilers.RetrieveOriginalConfigOrDie(ctx))
+					trCtx = rtime.StashNow(trCtx, now.Add(tr.elapsed))
+					var target client.Object = resource
+					if tr.resource != nil {
+						target = tr.resource
+					}
+					throttle.RecordConditionTransition(trCtx, target, apis.ConditionReady, tr.old, tr.updated)
+				}
+				return nil
+			},
+		}
+	})
+}
@@ -26,13 +26,17 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/tools/reference"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 
 	"github.com/go-logr/logr"
@@ -51,26 +55,79 @@ type Config struct {
 	Recorder record.EventRecorder
 	events.EventRecorder
 	Tracker tracker.Tracker
+	// Clock measures the passage of time for reconcilers, like the timeout of a
+	// TimeoutReconciler. Defaults to the system clock.
+	//
+	// +optional
+	Clock clock.WithTicker
 
 	syncPeriod time.Duration
+	dryRun     bool
 }
 
 func (c Config) IsEmpty() bool {
 	return c == Config{}
 }
 
+// GetClock returns the Clock of the config, or the system clock when not defined.
+func (c Config) GetClock() clock.WithTicker {
+	if c.Clock == nil {
+		return clock.RealClock{}
+	}
+	return c.Clock
+}
+
+// ContextualRecorder is implemented by event recorders that attribute the events they record to
+// the context they are recorded within, for example to the reconciler named by the context's
+// logger, see Config.RecorderFor.
+type ContextualRecorder interface {
+	// ForContext returns a recorder that attributes recorded events to the context
+	ForContext(ctx context.Context) record.EventRecorder
+}
+
+// ContextualEventRecorder is the ContextualRecorder counterpart for the EventRecorder of a Config,
+// see Config.EventRecorderFor.
+type ContextualEventRecorder interface {
+	// ForContext returns a recorder that attributes recorded events to the context
+	ForContext(ctx context.Context) events.EventRecorder
+}
+
+// RecorderFor returns the Recorder of the config bound to the context when the recorder is a
+// ContextualRecorder, otherwise the Recorder is returned as is.
+func (c Config) RecorderFor(ctx context.Context) record.EventRecorder {
+	if r, ok := c.Recorder.(ContextualRecorder); ok {
+		return r.ForContext(ctx)
+	}
+	return c.Recorder
+}
+
+// EventRecorderFor returns the EventRecorder of the config bound to the context when the
+// recorder is a ContextualEventRecorder, otherwise the EventRecorder is returned as is.
+func (c Config) EventRecorderFor(ctx context.Context) events.EventRecorder {
+	if r, ok := c.EventRecorder.(ContextualEventRecorder); ok {
+		return r.ForContext(ctx)
+	}
+	return c.EventRecorder
+}
+
 // WithCluster extends the config to access a new cluster.
 func (c Config) WithCluster(cluster cluster.Cluster) Config {
-	return Config{
+	config := Config{
 		Client:        duck.NewDuckAwareClientWrapper(cluster.GetClient()),
 		APIReader:     duck.NewDuckAwareAPIReaderWrapper(cluster.GetAPIReader(), cluster.GetClient()),
 		Discovery:     discovery.NewDiscoveryClientForConfigOrDie(cluster.GetConfig()),
 		Recorder:      cluster.GetEventRecorderFor("controller"),
 		EventRecorder: cluster.GetEventRecorder("controller"),
 		Tracker:       c.Tracker,
+		Clock:         c.Clock,
 
 		syncPeriod: c.syncPeriod,
 	}
+	if c.dryRun {
+		// preserve dry run mode for the new cluster
+		config = config.WithDryRun()
+	}
+	return config
 }
 
 // WithTracker extends the config with a new tracker.
@@ -82,8 +139,10 @@ func (c Config) WithTracker() Config {
 		Recorder:      c.Recorder,
 		EventRecorder: c.EventRecorder,
 		Tracker:       tracker.New(c.Scheme(), 2*c.syncPeriod),
+		Clock:         c.Clock,
 
 		syncPeriod: c.syncPeriod,
+		dryRun:     c.dryRun,
 	}
 }
 
@@ -100,11 +159,112 @@ func (c Config) WithDangerousDuckClientOperations() Config {
 		Discovery: c.Discovery,
 		Recorder:  c.Recorder,
 		Tracker:   c.Tracker,
+		Clock:     c.Clock,
 
 		syncPeriod: c.syncPeriod,
+		dryRun:     c.dryRun,
 	}
 }
 
+// WithDryRun returns a new Config whose client submits all mutating requests (Create, Update,
+// Patch, Delete and DeleteAllOf) with client.DryRunAll. The requests are fully processed by the
+// API Server, including admission, but are never persisted. Reads are unaffected.
+//
+// Every reconciler and ObjectManager that retrieves this config from the context will operate in
+// dry run mode. Finalizers and the reconciled resource's status are written with the original
+// config, to preview a reconciler end-to-end the root reconciler's Config should be in dry run
+// mode.
+func (c Config) WithDryRun() Config {
+	if c.dryRun {
+		return c
+	}
+	return Config{
+		Client:        client.NewDryRunClient(c.Client),
+		APIReader:     c.APIReader,
+		Discovery:     c.Discovery,
+		Recorder:      c.Recorder,
+		EventRecorder: c.EventRecorder,
+		Tracker:       c.Tracker,
+		Clock:         c.Clock,
+
+		syncPeriod: c.syncPeriod,
+		dryRun:     true,
+	}
+}
+
+// WithReadOnly returns a new Config whose client fails all mutating requests (Create, Update,
+// Patch, Apply, Delete and DeleteAllOf, including for subresources) with ErrReadOnly. Reads are
+// unaffected. See ReadOnly to guarantee a portion of the reconciler hierarchy performs no writes.
+func (c Config) WithReadOnly() Config {
+	if _, ok := c.Client.(*readOnlyClient); ok {
+		return c
+	}
+	return Config{
+		// wrap in a pointer so the config remains comparable
+		Client:        &readOnlyClient{Client: c.Client},
+		APIReader:     c.APIReader,
+		Discovery:     c.Discovery,
+		Recorder:      c.Recorder,
+		EventRecorder: c.EventRecorder,
+		Tracker:       c.Tracker,
+		Clock:         c.Clock,
+
+		syncPeriod: c.syncPeriod,
+		dryRun:     c.dryRun,
+	}
+}
+
+// WithClientInterceptors returns a new Config whose client calls the interceptors for each
+// request, see controller-runtime's interceptor.Funcs. Interceptors are called in the order
+// provided, the first interceptor is the outermost and receives the client wrapped by the
+// following interceptors. A nil func within an interceptor delegates to the next client.
+//
+// Interceptors are a uniform extension point for cross cutting concerns like metrics, tracing or
+// injecting a field manager. Only the Client is intercepted, the APIReader is unmodified.
+func (c Config) WithClientInterceptors(interceptors ...interceptor.Funcs) Config {
+	if len(interceptors) == 0 {
+		return c
+	}
+	cl, ok := c.Client.(client.WithWatch)
+	if !ok {
+		cl = &unwatchableClient{Client: c.Client}
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		cl = interceptor.NewClient(cl, interceptors[i])
+	}
+	return Config{
+		// wrap in a pointer so the config remains comparable
+		Client:        &interceptedClient{WithWatch: cl},
+		APIReader:     c.APIReader,
+		Discovery:     c.Discovery,
+		Recorder:      c.Recorder,
+		EventRecorder: c.EventRecorder,
+		Tracker:       c.Tracker,
+		Clock:         c.Clock,
+
+		syncPeriod: c.syncPeriod,
+		dryRun:     c.dryRun,
+	}
+}
+
+type interceptedClient struct {
+	client.WithWatch
+}
+
+// unwatchableClient adapts a client that does not support watches for use with interceptors
+type unwatchableClient struct {
+	client.Client
+}
+
+func (c *unwatchableClient) Watch(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return nil, fmt.Errorf("client %T does not support watch", c.Client)
+}
+
+// IsDryRun returns true if mutating requests made with this config's client are not persisted.
+func (c Config) IsDryRun() bool {
+	return c.dryRun
+}
+
 // TrackAndGet tracks the resources for changes and returns the current value. The track is
 // registered even when the resource does not exists so that its creation can be tracked.
 //
@@ -153,6 +313,41 @@ func (c Config) TrackAndList(ctx context.Context, list client.ObjectList, opts .
 	return c.List(ctx, list, opts...)
 }
 
+// NewObjectFor returns a new empty object for the GroupVersionKind as registered with the client's
+// scheme. An error is returned when the kind is not registered with the scheme, which can be
+// checked with runtime.IsNotRegisteredError, or when the registered type is not a client.Object.
+func (c Config) NewObjectFor(gvk schema.GroupVersionKind) (client.Object, error) {
+	obj, err := c.Scheme().New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T registered for %s is not a client.Object", obj, gvk)
+	}
+	return o, nil
+}
+
+// NewListFor returns a new empty list for the GroupVersionKind as registered with the client's
+// scheme. The kind may reference either the list kind or the kind of the list's items, for
+// example ConfigMapList or ConfigMap. An error is returned when the list kind is not registered
+// with the scheme, which can be checked with runtime.IsNotRegisteredError, or when the registered
+// type is not a client.ObjectList.
+func (c Config) NewListFor(gvk schema.GroupVersionKind) (client.ObjectList, error) {
+	if !strings.HasSuffix(gvk.Kind, "List") {
+		gvk.Kind = gvk.Kind + "List"
+	}
+	obj, err := c.Scheme().New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%T registered for %s is not a client.ObjectList", obj, gvk)
+	}
+	return list, nil
+}
+
 // NewConfig creates a Config for a specific API type. Typically passed into a
 // reconciler.
 func NewConfig(mgr ctrl.Manager, apiType client.Object, syncPeriod time.Duration) Config {
@@ -216,24 +411,26 @@ func (r *WithConfig[T]) init() {
 func (r *WithConfig[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Config value
 	if r.Config == nil {
-		return fmt.Errorf("WithConfig %q must define Config", r.Name)
+		errs = append(errs, fmt.Errorf("WithConfig %q must define Config", r.Name))
 	}
 
 	// validate Reconciler value
 	if r.Reconciler == nil {
-		return fmt.Errorf("WithConfig %q must define Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("WithConfig %q must define Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("WithConfig %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("WithConfig %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *WithConfig[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -248,3 +445,238 @@ func (r *WithConfig[T]) Reconcile(ctx context.Context, resource T) (Result, erro
 	ctx = StashConfig(ctx, c)
 	return r.Reconciler.Reconcile(ctx, resource)
 }
+
+var _ SubReconciler[client.Object] = (*WithClusterConfig[client.Object])(nil)
+
+// Experimental: WithClusterConfig injects the config for a named remote cluster into the
+// reconcilers nested under it. Nested reconcilers, like a ChildReconciler, create and observe
+// resources in the remote cluster, while the reconciled resource, including its status, continues
+// to be read and written with the original config.
+//
+// The name of the targeted cluster can be accessed with `RetrieveClusterName(ctx)`, the config for
+// the remote cluster with `RetrieveConfig(ctx)` and the original config used to load the reconciled
+// resource with `RetrieveOriginalConfig(ctx)`.
+type WithClusterConfig[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `WithClusterConfig`.  Ideally unique,
+	// but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Cluster is the name of the remote cluster nested reconcilers target. The name is passed to
+	// ClusterConfig to resolve the Config for the cluster.
+	Cluster string
+
+	// ClusterConfig resolves the Config for the named cluster. The current config is provided to
+	// be derived from, for example with Config.WithCluster. This method is called during setup and
+	// during reconciliation, if context is needed, it should be available during both phases.
+	ClusterConfig func(ctx context.Context, cluster string, c Config) (Config, error)
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	lazyInit sync.Once
+}
+
+func (r *WithClusterConfig[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("cluster", r.Cluster)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	ctx, err := r.withClusterConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return r.Reconciler.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *WithClusterConfig[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "WithClusterConfig"
+		}
+	})
+}
+
+func (r *WithClusterConfig[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Cluster value
+	if r.Cluster == "" {
+		errs = append(errs, fmt.Errorf("WithClusterConfig %q must define Cluster", r.Name))
+	}
+
+	// validate ClusterConfig value
+	if r.ClusterConfig == nil {
+		errs = append(errs, fmt.Errorf("WithClusterConfig %q must define ClusterConfig", r.Name))
+	}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("WithClusterConfig %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("WithClusterConfig %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *WithClusterConfig[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("cluster", r.Cluster)
+	ctx = logr.NewContext(ctx, log)
+
+	ctx, err := r.withClusterConfig(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	return r.Reconciler.Reconcile(ctx, resource)
+}
+
+func (r *WithClusterConfig[T]) withClusterConfig(ctx context.Context) (context.Context, error) {
+	c, err := r.ClusterConfig(ctx, r.Cluster, RetrieveConfigOrDie(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve config for cluster %q: %w", r.Cluster, err)
+	}
+	ctx = StashConfig(ctx, c)
+	ctx = StashClusterName(ctx, r.Cluster)
+	return ctx, nil
+}
+
+var _ SubReconciler[client.Object] = (*WithRemoteFinalizer[client.Object])(nil)
+
+// Experimental: WithRemoteFinalizer manages state in a remote cluster, like children created by a
+// nested ChildReconciler, guarded by a finalizer on the reconciled resource. It composes
+// WithClusterConfig and WithFinalizer: nested reconcilers target the remote cluster, while the
+// finalizer is patched onto the reconciled resource with the original config.
+//
+// Owner references and the garbage collector do not work across clusters, nested child
+// reconcilers must set SkipOwnerReference and define OurChild and ListOptions to find their
+// children. When the reconciled resource is terminating, nested child reconcilers delete their
+// children in the remote cluster before the finalizer is cleared.
+//
+// The finalizer is only cleared after the nested reconciler succeeds. While the remote cluster is
+// unreachable, either because ClusterConfig or a request to the remote cluster fails, the error
+// is returned and the request is requeued with the finalizer retained.
+type WithRemoteFinalizer[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `WithRemoteFinalizer`.  Ideally unique,
+	// but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Cluster is the name of the remote cluster nested reconcilers target, see
+	// WithClusterConfig.Cluster.
+	Cluster string
+
+	// ClusterConfig resolves the Config for the named cluster, see
+	// WithClusterConfig.ClusterConfig.
+	ClusterConfig func(ctx context.Context, cluster string, c Config) (Config, error)
+
+	// Finalizer to set on the reconciled resource, see WithFinalizer.Finalizer.
+	Finalizer string
+
+	// ReadyToClearFinalizer must return true before the finalizer is cleared from the resource.
+	// Only called when the resource is terminating and the nested reconciler succeeded. The config
+	// for the remote cluster is available with `RetrieveConfig(ctx)`, for example to confirm a
+	// deleted child with finalizers of its own is fully removed.
+	//
+	// Defaults to always return true.
+	//
+	// +optional
+	ReadyToClearFinalizer func(ctx context.Context, resource Type) bool
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	lazyInit   sync.Once
+	reconciler *WithClusterConfig[Type]
+}
+
+func (r *WithRemoteFinalizer[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	return r.reconciler.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *WithRemoteFinalizer[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "WithRemoteFinalizer"
+		}
+		r.reconciler = &WithClusterConfig[T]{
+			Name:          r.Name,
+			Cluster:       r.Cluster,
+			ClusterConfig: r.ClusterConfig,
+			Reconciler: &WithFinalizer[T]{
+				Finalizer:             r.Finalizer,
+				ReadyToClearFinalizer: r.ReadyToClearFinalizer,
+				Reconciler:            r.Reconciler,
+			},
+		}
+	})
+}
+
+func (r *WithRemoteFinalizer[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Cluster value
+	if r.Cluster == "" {
+		errs = append(errs, fmt.Errorf("WithRemoteFinalizer %q must define Cluster", r.Name))
+	}
+
+	// validate ClusterConfig value
+	if r.ClusterConfig == nil {
+		errs = append(errs, fmt.Errorf("WithRemoteFinalizer %q must define ClusterConfig", r.Name))
+	}
+
+	// validate Finalizer value
+	if r.Finalizer == "" {
+		errs = append(errs, fmt.Errorf("WithRemoteFinalizer %q must define Finalizer", r.Name))
+	}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("WithRemoteFinalizer %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("WithRemoteFinalizer %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *WithRemoteFinalizer[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	return r.reconciler.Reconcile(ctx, resource)
+}
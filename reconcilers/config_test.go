@@ -20,13 +20,20 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 	diecorev1 "reconciler.io/dies/apis/core/v1"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
 	"reconciler.io/runtime/internal/resources"
@@ -36,6 +43,7 @@ import (
 	"reconciler.io/runtime/tracker"
 	"reconciler.io/runtime/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestConfig_TrackAndGet(t *testing.T) {
@@ -197,6 +205,20 @@ func TestConfig_TrackAndList(t *testing.T) {
 				},
 			},
 		},
+		"track and list by selector": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap,
+			},
+			Metadata: map[string]interface{}{
+				"listOpts": []client.ListOption{
+					client.MatchingLabels(map[string]string{"app": "test-app"}),
+				},
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewSelectorTrackRequest(&corev1.ConfigMap{}, testSelector, resource, scheme),
+			},
+		},
 		"track with errored list": {
 			Resource:  resource.DieReleasePtr(),
 			ShouldErr: true,
@@ -272,6 +294,293 @@ func TestConfig_TrackAndList(t *testing.T) {
 	})
 }
 
+func TestConfig_WithDryRun(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	configMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		AddData("greeting", "hello")
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"create is not persisted": {
+			Resource: resource.DieReleasePtr(),
+			ExpectCreates: []client.Object{
+				configMap,
+			},
+		},
+		"create error": {
+			Resource: resource.DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("create", "ConfigMap"),
+			},
+			ShouldErr: true,
+			ExpectCreates: []client.Object{
+				configMap,
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.WithConfig[*resources.TestResource]{
+			Config: func(ctx context.Context, c reconcilers.Config) (reconcilers.Config, error) {
+				if c.IsDryRun() {
+					t.Errorf("expected config to not be in dry run mode")
+				}
+				return c.WithDryRun(), nil
+			},
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					if !c.IsDryRun() {
+						t.Errorf("expected config to be in dry run mode")
+					}
+					if !c.WithTracker().IsDryRun() {
+						t.Errorf("expected derived config to be in dry run mode")
+					}
+
+					if err := c.Create(ctx, configMap.DieReleasePtr()); err != nil {
+						return err
+					}
+					if err := c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testName}, &corev1.ConfigMap{}); !apierrs.IsNotFound(err) {
+						t.Errorf("expected dry run create to not be persisted, got %v", err)
+					}
+					return nil
+				},
+			},
+		}
+	})
+}
+
+func TestConfig_GetClock(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	config := (&rtesting.ExpectConfig{Scheme: scheme}).Config()
+	if _, ok := config.GetClock().(clock.RealClock); !ok {
+		t.Errorf("expected the system clock by default, got %T", config.GetClock())
+	}
+
+	clk := clocktesting.NewFakeClock(time.Now())
+	config.Clock = clk
+	for name, derived := range map[string]reconcilers.Config{
+		"WithTracker":                       config.WithTracker(),
+		"WithDangerousDuckClientOperations": config.WithDangerousDuckClientOperations(),
+		"WithDryRun":                        config.WithDryRun(),
+		"WithReadOnly":                      config.WithReadOnly(),
+		"WithClientInterceptors":            config.WithClientInterceptors(interceptor.Funcs{}),
+	} {
+		if derived.GetClock() != clk {
+			t.Errorf("expected %s to preserve the clock, got %T", name, derived.GetClock())
+		}
+	}
+}
+
+func TestConfig_NewObjectFor(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+	// register a type that is not a client.Object
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "testing.reconciler.runtime", Version: "v1", Kind: "Options"}, &metav1.ListOptions{})
+
+	c := (&rtesting.ExpectConfig{Scheme: scheme}).Config()
+
+	tests := map[string]struct {
+		gvk              schema.GroupVersionKind
+		expected         client.Object
+		shouldErr        bool
+		notRegisteredErr bool
+	}{
+		"built-in type": {
+			gvk:      corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+			expected: &corev1.ConfigMap{},
+		},
+		"custom type": {
+			gvk:      resources.GroupVersion.WithKind("TestResource"),
+			expected: &resources.TestResource{},
+		},
+		"unregistered type": {
+			gvk:              schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Unknown"},
+			shouldErr:        true,
+			notRegisteredErr: true,
+		},
+		"not an object": {
+			gvk:       schema.GroupVersionKind{Group: "testing.reconciler.runtime", Version: "v1", Kind: "Options"},
+			shouldErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := c.NewObjectFor(tc.gvk)
+			if (err != nil) != tc.shouldErr {
+				t.Fatalf("NewObjectFor() error = %v, shouldErr %v", err, tc.shouldErr)
+			}
+			if tc.notRegisteredErr != runtime.IsNotRegisteredError(err) {
+				t.Errorf("NewObjectFor() error = %v, expected not registered error %v", err, tc.notRegisteredErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("NewObjectFor() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_NewListFor(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+	// register a type that is not a client.ObjectList
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "testing.reconciler.runtime", Version: "v1", Kind: "OptionsList"}, &metav1.ListOptions{})
+
+	c := (&rtesting.ExpectConfig{Scheme: scheme}).Config()
+
+	tests := map[string]struct {
+		gvk              schema.GroupVersionKind
+		expected         client.ObjectList
+		shouldErr        bool
+		notRegisteredErr bool
+	}{
+		"item kind": {
+			gvk:      corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+			expected: &corev1.ConfigMapList{},
+		},
+		"list kind": {
+			gvk:      corev1.SchemeGroupVersion.WithKind("ConfigMapList"),
+			expected: &corev1.ConfigMapList{},
+		},
+		"custom type": {
+			gvk:      resources.GroupVersion.WithKind("TestResource"),
+			expected: &resources.TestResourceList{},
+		},
+		"unregistered type": {
+			gvk:              schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Unknown"},
+			shouldErr:        true,
+			notRegisteredErr: true,
+		},
+		"not a list": {
+			gvk:       schema.GroupVersionKind{Group: "testing.reconciler.runtime", Version: "v1", Kind: "Options"},
+			shouldErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := c.NewListFor(tc.gvk)
+			if (err != nil) != tc.shouldErr {
+				t.Fatalf("NewListFor() error = %v, shouldErr %v", err, tc.shouldErr)
+			}
+			if tc.notRegisteredErr != runtime.IsNotRegisteredError(err) {
+				t.Errorf("NewListFor() error = %v, expected not registered error %v", err, tc.notRegisteredErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("NewListFor() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_WithClientInterceptors(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	configMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		AddData("greeting", "hello")
+
+	// appendOrder records the order interceptors are called in the created object's data
+	appendOrder := func(name string) interceptor.Funcs {
+		return interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				cm := obj.(*corev1.ConfigMap)
+				cm.Data["order"] = cm.Data["order"] + name
+				return c.Create(ctx, obj, opts...)
+			},
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"interceptors called in order": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Interceptors": []interceptor.Funcs{
+					appendOrder("a"),
+					appendOrder("b"),
+				},
+			},
+			ExpectCreates: []client.Object{
+				configMap.AddData("order", "ab"),
+			},
+		},
+		"interceptor short circuits request": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Interceptors": []interceptor.Funcs{
+					{
+						Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+							return fmt.Errorf("intercepted")
+						},
+					},
+				},
+			},
+			ShouldErr: true,
+		},
+		"no interceptors": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Interceptors": []interceptor.Funcs{},
+			},
+			ExpectCreates: []client.Object{
+				configMap,
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.WithConfig[*resources.TestResource]{
+			Config: func(ctx context.Context, c reconcilers.Config) (reconcilers.Config, error) {
+				return c.WithClientInterceptors(rtc.Metadata["Interceptors"].([]interceptor.Funcs)...), nil
+			},
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					return c.Create(ctx, configMap.DieReleasePtr())
+				},
+			},
+		}
+	})
+}
+
 func TestWithConfig(t *testing.T) {
 	testNamespace := "test-namespace"
 	testName := "test-resource"
@@ -349,7 +658,7 @@ func TestWithConfig_Validate(t *testing.T) {
 			name:       "empty",
 			resource:   &corev1.ConfigMap{},
 			reconciler: &reconcilers.WithConfig[*corev1.ConfigMap]{},
-			shouldErr:  `WithConfig "WithConfig" must define Config`,
+			shouldErr:  `[WithConfig "WithConfig" must define Config, WithConfig "WithConfig" must define Reconciler]`,
 		},
 		{
 			name:     "valid",
@@ -427,3 +736,498 @@ func TestWithConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestWithClusterConfig(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testCluster := "remote"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	remoteConfigMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	clusterConfig := func(ctx context.Context, cluster string, _ reconcilers.Config) (reconcilers.Config, error) {
+		c, ok := reconcilers.RetrieveAdditionalConfigs(ctx)[cluster]
+		if !ok {
+			return reconcilers.Config{}, fmt.Errorf("unknown cluster %q", cluster)
+		}
+		return c, nil
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"nested reconcilers target the remote cluster": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, oc reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithClusterConfig[*resources.TestResource]{
+						Cluster:       testCluster,
+						ClusterConfig: clusterConfig,
+						Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, parent *resources.TestResource) error {
+								if cluster := reconcilers.RetrieveClusterName(ctx); cluster != testCluster {
+									t.Errorf("unexpected cluster name %q", cluster)
+								}
+								if roc := reconcilers.RetrieveOriginalConfigOrDie(ctx); roc != oc {
+									t.Errorf("unexpected original config")
+								}
+
+								c := reconcilers.RetrieveConfigOrDie(ctx)
+								if err := c.Create(ctx, remoteConfigMap.DieReleasePtr()); err != nil {
+									return err
+								}
+								if parent.Status.Fields == nil {
+									parent.Status.Fields = map[string]string{}
+								}
+								parent.Status.Fields["cluster"] = reconcilers.RetrieveClusterName(ctx)
+
+								return nil
+							},
+						},
+					}
+				},
+			},
+			AdditionalConfigs: map[string]rtesting.ExpectConfig{
+				testCluster: {
+					Scheme: scheme,
+					ExpectCreates: []client.Object{
+						remoteConfigMap,
+					},
+				},
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("cluster", testCluster)
+				}).
+				DieReleasePtr(),
+		},
+		"unknown cluster": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, oc reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithClusterConfig[*resources.TestResource]{
+						Cluster:       "unknown",
+						ClusterConfig: clusterConfig,
+						Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, parent *resources.TestResource) error {
+								t.Errorf("reconciler should not be called")
+								return nil
+							},
+						},
+					}
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c)
+	})
+}
+
+func TestWithClusterConfig_Validate(t *testing.T) {
+	config := reconcilers.Config{}
+	clusterConfig := func(ctx context.Context, cluster string, c reconcilers.Config) (reconcilers.Config, error) {
+		return config, nil
+	}
+
+	tests := []struct {
+		name           string
+		reconciler     *reconcilers.WithClusterConfig[*corev1.ConfigMap]
+		validateNested bool
+		shouldErr      string
+	}{
+		{
+			name:       "empty",
+			reconciler: &reconcilers.WithClusterConfig[*corev1.ConfigMap]{},
+			shouldErr:  `[WithClusterConfig "WithClusterConfig" must define Cluster, WithClusterConfig "WithClusterConfig" must define ClusterConfig, WithClusterConfig "WithClusterConfig" must define Reconciler]`,
+		},
+		{
+			name: "valid",
+			reconciler: &reconcilers.WithClusterConfig[*corev1.ConfigMap]{
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Reconciler:    &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+		},
+		{
+			name: "missing cluster config",
+			reconciler: &reconcilers.WithClusterConfig[*corev1.ConfigMap]{
+				Name:       "missing cluster config",
+				Cluster:    "remote",
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+			shouldErr: `WithClusterConfig "missing cluster config" must define ClusterConfig`,
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.WithClusterConfig[*corev1.ConfigMap]{
+				Name:          "missing reconciler",
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+			},
+			shouldErr: `WithClusterConfig "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.WithClusterConfig[*corev1.ConfigMap]{
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Reconciler:    &reconcilers.SyncReconciler[*corev1.ConfigMap]{},
+			},
+			validateNested: true,
+			shouldErr:      `WithClusterConfig "WithClusterConfig" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if c.validateNested {
+				ctx = validation.WithRecursive(ctx)
+			}
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
+
+func TestWithRemoteFinalizer(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testCluster := "remote"
+	testFinalizer := "test-finalizer"
+
+	now := &metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		}).
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("foo", "bar")
+		})
+	resourceTerminating := resource.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.DeletionTimestamp(now)
+			d.Finalizers(testFinalizer)
+		})
+	remoteConfigMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.AddLabel("owner", testName)
+		}).
+		AddData("foo", "bar")
+
+	clusterConfig := func(ctx context.Context, cluster string, _ reconcilers.Config) (reconcilers.Config, error) {
+		c, ok := reconcilers.RetrieveAdditionalConfigs(ctx)[cluster]
+		if !ok {
+			return reconcilers.Config{}, fmt.Errorf("unknown cluster %q", cluster)
+		}
+		return c, nil
+	}
+	remoteChildReconciler := func() reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+			DesiredChild: func(ctx context.Context, parent *resources.TestResource) (*corev1.ConfigMap, error) {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: parent.Namespace,
+						Name:      parent.Name,
+						Labels:    map[string]string{"owner": parent.Name},
+					},
+					Data: reconcilers.MergeMaps(parent.Spec.Fields),
+				}, nil
+			},
+			ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{
+				MergeBeforeUpdate: func(current, desired *corev1.ConfigMap) {
+					current.Data = desired.Data
+				},
+			},
+			ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {},
+			SkipOwnerReference:         true,
+			ListOptions: func(ctx context.Context, parent *resources.TestResource) []client.ListOption {
+				return []client.ListOption{
+					client.InNamespace(parent.Namespace),
+					client.MatchingLabels{"owner": parent.Name},
+				}
+			},
+			OurChild: func(parent *resources.TestResource, child *corev1.ConfigMap) bool {
+				return child.Labels["owner"] == parent.Name
+			},
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"adds finalizer and creates remote child": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithRemoteFinalizer[*resources.TestResource]{
+						Cluster:       testCluster,
+						ClusterConfig: clusterConfig,
+						Finalizer:     testFinalizer,
+						Reconciler:    remoteChildReconciler(),
+					}
+				},
+			},
+			AdditionalConfigs: map[string]rtesting.ExpectConfig{
+				testCluster: {
+					Scheme: scheme,
+					ExpectCreates: []client.Object{
+						remoteConfigMap,
+					},
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizer)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created",
+					`Created ConfigMap %q`, testName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"deletes remote child and clears finalizer": {
+			Resource: resourceTerminating.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithRemoteFinalizer[*resources.TestResource]{
+						Cluster:       testCluster,
+						ClusterConfig: clusterConfig,
+						Finalizer:     testFinalizer,
+						Reconciler:    remoteChildReconciler(),
+					}
+				},
+			},
+			AdditionalConfigs: map[string]rtesting.ExpectConfig{
+				testCluster: {
+					Scheme: scheme,
+					GivenObjects: []client.Object{
+						remoteConfigMap,
+					},
+					ExpectDeletes: []rtesting.DeleteRef{
+						rtesting.NewDeleteRefFromObject(remoteConfigMap, scheme),
+					},
+				},
+			},
+			ExpectResource: resourceTerminating.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers()
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted",
+					`Deleted ConfigMap %q`, testName),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"keeps finalizer until the remote child is removed": {
+			Resource: resourceTerminating.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithRemoteFinalizer[*resources.TestResource]{
+						Cluster:       testCluster,
+						ClusterConfig: clusterConfig,
+						Finalizer:     testFinalizer,
+						ReadyToClearFinalizer: func(ctx context.Context, parent *resources.TestResource) bool {
+							rc := reconcilers.RetrieveConfigOrDie(ctx)
+							child := &corev1.ConfigMap{}
+							err := rc.Get(ctx, types.NamespacedName{Namespace: parent.Namespace, Name: parent.Name}, child)
+							return apierrs.IsNotFound(err)
+						},
+						Reconciler: remoteChildReconciler(),
+					}
+				},
+			},
+			AdditionalConfigs: map[string]rtesting.ExpectConfig{
+				testCluster: {
+					Scheme: scheme,
+					GivenObjects: []client.Object{
+						remoteConfigMap.
+							MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.Finalizers("remote-finalizer")
+							}),
+					},
+					ExpectDeletes: []rtesting.DeleteRef{
+						rtesting.NewDeleteRefFromObject(remoteConfigMap, scheme),
+					},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted",
+					`Deleted ConfigMap %q`, testName),
+			},
+		},
+		"remote cluster unreachable, keeps finalizer": {
+			Resource: resourceTerminating.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithRemoteFinalizer[*resources.TestResource]{
+						Cluster:       testCluster,
+						ClusterConfig: clusterConfig,
+						Finalizer:     testFinalizer,
+						Reconciler:    remoteChildReconciler(),
+					}
+				},
+			},
+			AdditionalConfigs: map[string]rtesting.ExpectConfig{
+				testCluster: {
+					Scheme: scheme,
+					GivenObjects: []client.Object{
+						remoteConfigMap,
+					},
+					WithReactors: []rtesting.ReactionFunc{
+						rtesting.InduceFailure("list", "ConfigMapList"),
+					},
+				},
+			},
+			ShouldErr: true,
+		},
+		"unknown cluster, keeps finalizer": {
+			Resource: resourceTerminating.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.WithRemoteFinalizer[*resources.TestResource]{
+						Cluster:       "unknown",
+						ClusterConfig: clusterConfig,
+						Finalizer:     testFinalizer,
+						Reconciler:    remoteChildReconciler(),
+					}
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c)
+	})
+}
+
+func TestWithRemoteFinalizer_Validate(t *testing.T) {
+	config := reconcilers.Config{}
+	clusterConfig := func(ctx context.Context, cluster string, c reconcilers.Config) (reconcilers.Config, error) {
+		return config, nil
+	}
+
+	tests := []struct {
+		name           string
+		reconciler     *reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]
+		validateNested bool
+		shouldErr      string
+	}{
+		{
+			name:       "empty",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{},
+			shouldErr:  `[WithRemoteFinalizer "WithRemoteFinalizer" must define Cluster, WithRemoteFinalizer "WithRemoteFinalizer" must define ClusterConfig, WithRemoteFinalizer "WithRemoteFinalizer" must define Finalizer, WithRemoteFinalizer "WithRemoteFinalizer" must define Reconciler]`,
+		},
+		{
+			name: "valid",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Finalizer:     "test-finalizer",
+				Reconciler:    &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+		},
+		{
+			name: "missing cluster config",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{
+				Name:       "missing cluster config",
+				Cluster:    "remote",
+				Finalizer:  "test-finalizer",
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+			shouldErr: `WithRemoteFinalizer "missing cluster config" must define ClusterConfig`,
+		},
+		{
+			name: "missing finalizer",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{
+				Name:          "missing finalizer",
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Reconciler:    &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+			shouldErr: `WithRemoteFinalizer "missing finalizer" must define Finalizer`,
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{
+				Name:          "missing reconciler",
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Finalizer:     "test-finalizer",
+			},
+			shouldErr: `WithRemoteFinalizer "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.WithRemoteFinalizer[*corev1.ConfigMap]{
+				Cluster:       "remote",
+				ClusterConfig: clusterConfig,
+				Finalizer:     "test-finalizer",
+				Reconciler:    &reconcilers.SyncReconciler[*corev1.ConfigMap]{},
+			},
+			validateNested: true,
+			shouldErr:      `WithRemoteFinalizer "WithRemoteFinalizer" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if c.validateNested {
+				ctx = validation.WithRecursive(ctx)
+			}
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"reconciler.io/runtime/internal"
 	rtime "reconciler.io/runtime/time"
@@ -40,6 +41,56 @@ var (
 	ErrDurable = errors.Join(ErrQuiet, ErrHaltSubReconcilers)
 )
 
+// ControlFlowError attaches a cause to a control flow error, like ErrQuiet, ErrHaltSubReconcilers
+// or OnlyReconcileChildStatus, providing detail about why the flow was altered. The error matches
+// both the control flow error and the cause with errors.Is and errors.As, so callers detecting
+// the control flow error are not affected by the cause.
+//
+// Create a ControlFlowError with Quiet, HaltSubReconcilers or OnlyReconcileChildStatusBecause.
+type ControlFlowError struct {
+	// ControlFlow is the sentinel error that alters the flow of reconciliation
+	ControlFlow error
+	// Cause describes why the flow was altered
+	//
+	// +optional
+	Cause error
+}
+
+func (e *ControlFlowError) Error() string {
+	if e.Cause == nil {
+		return e.ControlFlow.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.ControlFlow, e.Cause)
+}
+
+// Unwrap returns the control flow error and the cause
+func (e *ControlFlowError) Unwrap() []error {
+	if e.Cause == nil {
+		return []error{e.ControlFlow}
+	}
+	return []error{e.ControlFlow, e.Cause}
+}
+
+// Quiet returns an error for the cause that matches ErrQuiet. The error is returned as an error,
+// but is not logged or recorded.
+func Quiet(cause error) error {
+	return &ControlFlowError{ControlFlow: ErrQuiet, Cause: cause}
+}
+
+// HaltSubReconcilers returns an error for the cause that matches ErrHaltSubReconcilers, and
+// therefore ErrQuiet. SubReconcilers stop processing the request, while the root reconciler
+// proceeds as if there was no error.
+func HaltSubReconcilers(cause error) error {
+	return &ControlFlowError{ControlFlow: ErrHaltSubReconcilers, Cause: cause}
+}
+
+// OnlyReconcileChildStatusBecause returns an error for the cause that matches
+// OnlyReconcileChildStatus. The child resource is not created, updated or deleted, while the
+// existing child's status is still reflected on the reconciled resource.
+func OnlyReconcileChildStatusBecause(cause error) error {
+	return &ControlFlowError{ControlFlow: OnlyReconcileChildStatus, Cause: cause}
+}
+
 type SuppressTransientErrors[Type client.Object, ListType client.ObjectList] struct {
 	// Name used to identify this reconciler.  Defaults to `ForEach`. Ideally unique, but not
 	// required to be so.
@@ -152,19 +203,21 @@ func (r *SuppressTransientErrors[T, LT]) checkStaleCounters(ctx context.Context)
 func (r *SuppressTransientErrors[T, LT]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Reconciler
 	if r.Reconciler == nil {
-		return fmt.Errorf("SuppressTransientErrors %q must implement Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("SuppressTransientErrors %q must implement Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("SuppressTransientErrors %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("SuppressTransientErrors %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *SuppressTransientErrors[T, LT]) Reconcile(ctx context.Context, resource T) (Result, error) {
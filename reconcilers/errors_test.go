@@ -474,3 +474,73 @@ func TestSuppressTransientErrors_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestControlFlowError(t *testing.T) {
+	cause := fmt.Errorf("child %q is not ready", "blue")
+
+	tests := []struct {
+		name        string
+		err         error
+		expectedMsg string
+		matches     []error
+		notMatches  []error
+	}{{
+		name:        "quiet",
+		err:         reconcilers.Quiet(cause),
+		expectedMsg: `quiet errors are returned as errors, but not logged or recorded: child "blue" is not ready`,
+		matches:     []error{reconcilers.ErrQuiet, cause},
+		notMatches:  []error{reconcilers.ErrHaltSubReconcilers, reconcilers.OnlyReconcileChildStatus, reconcilers.ErrDurable},
+	}, {
+		name:        "halt sub reconcilers",
+		err:         reconcilers.HaltSubReconcilers(cause),
+		expectedMsg: `stop processing SubReconcilers, without returning an error: quiet errors are returned as errors, but not logged or recorded: child "blue" is not ready`,
+		matches:     []error{reconcilers.ErrHaltSubReconcilers, reconcilers.ErrQuiet, cause},
+		notMatches:  []error{reconcilers.OnlyReconcileChildStatus},
+	}, {
+		name:        "only reconcile child status",
+		err:         reconcilers.OnlyReconcileChildStatusBecause(cause),
+		expectedMsg: `skip reconciler create/update/delete behavior for the child resource, while still reflecting the existing child's status on the reconciled resource: child "blue" is not ready`,
+		matches:     []error{reconcilers.OnlyReconcileChildStatus, cause},
+		notMatches:  []error{reconcilers.ErrQuiet, reconcilers.ErrHaltSubReconcilers},
+	}, {
+		name:        "nil cause",
+		err:         reconcilers.HaltSubReconcilers(nil),
+		expectedMsg: reconcilers.ErrHaltSubReconcilers.Error(),
+		matches:     []error{reconcilers.ErrHaltSubReconcilers, reconcilers.ErrQuiet},
+	}, {
+		name:        "wrapped",
+		err:         fmt.Errorf("reconciling: %w", reconcilers.HaltSubReconcilers(cause)),
+		expectedMsg: `reconciling: stop processing SubReconcilers, without returning an error: quiet errors are returned as errors, but not logged or recorded: child "blue" is not ready`,
+		matches:     []error{reconcilers.ErrHaltSubReconcilers, reconcilers.ErrQuiet, cause},
+	}, {
+		name:        "joined",
+		err:         errors.Join(fmt.Errorf("other"), reconcilers.Quiet(cause)),
+		expectedMsg: "other\n" + `quiet errors are returned as errors, but not logged or recorded: child "blue" is not ready`,
+		matches:     []error{reconcilers.ErrQuiet, cause},
+	}}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := c.err.Error(); actual != c.expectedMsg {
+				t.Errorf("Error() = %q, expected %q", actual, c.expectedMsg)
+			}
+			for _, target := range c.matches {
+				if !errors.Is(c.err, target) {
+					t.Errorf("expected error to match %q", target)
+				}
+			}
+			for _, target := range c.notMatches {
+				if errors.Is(c.err, target) {
+					t.Errorf("expected error not to match %q", target)
+				}
+			}
+			var cfe *reconcilers.ControlFlowError
+			if !errors.As(c.err, &cfe) {
+				t.Fatalf("expected error to be a ControlFlowError")
+			}
+			if cfe.Cause != nil && cfe.Cause != cause {
+				t.Errorf("Cause = %v, expected %v", cfe.Cause, cause)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"reconciler.io/runtime/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -38,6 +40,10 @@ var _ SubReconciler[client.Object] = (*WithFinalizer[client.Object])(nil)
 // already set, before calling the nested reconciler. When the resource is terminating, the
 // finalizer is cleared after returning from the nested reconciler without error and
 // ReadyToClearFinalizer returns true.
+//
+// Multiple finalizers may be managed by a single WithFinalizer via Finalizers. They are added in
+// order and cleared in order, each finalizer is only cleared once ReadyToClearFinalizers returns
+// true for it and every finalizer preceding it has been cleared.
 type WithFinalizer[Type client.Object] struct {
 	// Name used to identify this reconciler.  Defaults to `WithFinalizer`.  Ideally unique, but
 	// not required to be so.
@@ -51,8 +57,28 @@ type WithFinalizer[Type client.Object] struct {
 	//
 	// Using a finalizer is encouraged when state needs to be manually cleaned up before a resource
 	// is fully deleted. This commonly include state allocated outside of the current cluster.
+	//
+	// Required unless Finalizers is defined.
+	//
+	// +optional
 	Finalizer string
 
+	// Finalizers to set on the reconciled resource in addition to Finalizer. Each value must be
+	// unique to this specific reconciler instance and not shared. When Finalizer is also defined,
+	// it is treated as the first entry.
+	//
+	// Finalizers are added in order with a single patch before the nested reconciler is called.
+	// When the resource is terminating, finalizers are cleared in order. Clearing stops at the
+	// first finalizer that is not ready to be cleared, later finalizers remain until a subsequent
+	// reconcile.
+	//
+	// A nested ChildReconciler or ChildSetReconciler that defines its own Finalizer skips owner
+	// references and manages that finalizer independently of the finalizers defined here. As the
+	// nested reconciler runs first, its finalizer is cleared before any finalizer defined here.
+	//
+	// +optional
+	Finalizers []string
+
 	// ReadyToClearFinalizer must return true before the finalizer is cleared from the resource.
 	// Only called when the resource is terminating.
 	//
@@ -61,6 +87,14 @@ type WithFinalizer[Type client.Object] struct {
 	// +optional
 	ReadyToClearFinalizer func(ctx context.Context, resource Type) bool
 
+	// ReadyToClearFinalizers must return true before the specific finalizer is cleared from the
+	// resource. Only called when the resource is terminating.
+	//
+	// Defaults to ReadyToClearFinalizer.
+	//
+	// +optional
+	ReadyToClearFinalizers func(ctx context.Context, resource Type, finalizer string) bool
+
 	// Reconciler is called for each reconciler request with the reconciled
 	// resource being reconciled. Typically a Sequence is used to compose
 	// multiple SubReconcilers.
@@ -79,9 +113,21 @@ func (r *WithFinalizer[T]) init() {
 				return true
 			}
 		}
+		if r.ReadyToClearFinalizers == nil {
+			r.ReadyToClearFinalizers = func(ctx context.Context, resource T, finalizer string) bool {
+				return r.ReadyToClearFinalizer(ctx, resource)
+			}
+		}
 	})
 }
 
+func (r *WithFinalizer[T]) finalizers() []string {
+	if r.Finalizer == "" {
+		return r.Finalizers
+	}
+	return append([]string{r.Finalizer}, r.Finalizers...)
+}
+
 func (r *WithFinalizer[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
 	r.init()
 
@@ -98,24 +144,37 @@ func (r *WithFinalizer[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manage
 func (r *WithFinalizer[T]) Validate(ctx context.Context) error {
 	r.init()
 
-	// validate Finalizer value
-	if r.Finalizer == "" {
-		return fmt.Errorf("WithFinalizer %q must define Finalizer", r.Name)
+	errs := []error{}
+
+	// validate Finalizer and Finalizers value
+	if r.Finalizer == "" && len(r.Finalizers) == 0 {
+		errs = append(errs, fmt.Errorf("WithFinalizer %q must define Finalizer or Finalizers", r.Name))
+	}
+	seen := sets.New[string]()
+	for _, finalizer := range r.finalizers() {
+		if finalizer == "" {
+			errs = append(errs, fmt.Errorf("WithFinalizer %q must not define an empty finalizer", r.Name))
+			continue
+		}
+		if seen.Has(finalizer) {
+			errs = append(errs, fmt.Errorf("WithFinalizer %q must not define duplicate finalizer %q", r.Name, finalizer))
+		}
+		seen.Insert(finalizer)
 	}
 
 	// validate Reconciler value
 	if r.Reconciler == nil {
-		return fmt.Errorf("WithFinalizer %q must define Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("WithFinalizer %q must define Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("WithFinalizer %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("WithFinalizer %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *WithFinalizer[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -126,7 +185,7 @@ func (r *WithFinalizer[T]) Reconcile(ctx context.Context, resource T) (Result, e
 	ctx = logr.NewContext(ctx, log)
 
 	if resource.GetDeletionTimestamp() == nil {
-		if err := AddFinalizer(ctx, resource, r.Finalizer); err != nil {
+		if err := ensureFinalizers(ctx, resource, r.finalizers(), true); err != nil {
 			return Result{}, err
 		}
 	}
@@ -134,9 +193,14 @@ func (r *WithFinalizer[T]) Reconcile(ctx context.Context, resource T) (Result, e
 	if err != nil {
 		return result, err
 	}
-	if resource.GetDeletionTimestamp() != nil && r.ReadyToClearFinalizer(ctx, resource) {
-		if err := ClearFinalizer(ctx, resource, r.Finalizer); err != nil {
-			return Result{}, err
+	if resource.GetDeletionTimestamp() != nil {
+		for _, finalizer := range r.finalizers() {
+			if !r.ReadyToClearFinalizers(ctx, resource, finalizer) {
+				break
+			}
+			if err := ClearFinalizer(ctx, resource, finalizer); err != nil {
+				return Result{}, err
+			}
 		}
 	}
 	return result, err
@@ -145,17 +209,25 @@ func (r *WithFinalizer[T]) Reconcile(ctx context.Context, resource T) (Result, e
 // AddFinalizer ensures the desired finalizer exists on the reconciled resource. The client that
 // loaded the reconciled resource is used to patch it with the finalizer if not already set.
 func AddFinalizer(ctx context.Context, resource client.Object, finalizer string) error {
-	return ensureFinalizer(ctx, resource, finalizer, true)
+	return ensureFinalizers(ctx, resource, []string{finalizer}, true)
 }
 
 // ClearFinalizer ensures the desired finalizer does not exist on the reconciled resource. The
 // client that loaded the reconciled resource is used to patch it with the finalizer if set.
 func ClearFinalizer(ctx context.Context, resource client.Object, finalizer string) error {
-	return ensureFinalizer(ctx, resource, finalizer, false)
+	return ensureFinalizers(ctx, resource, []string{finalizer}, false)
 }
 
-func ensureFinalizer(ctx context.Context, current client.Object, finalizer string, add bool) error {
-	if finalizer == "" || controllerutil.ContainsFinalizer(current, finalizer) == add {
+// ensureFinalizers adds or removes each finalizer on the reconciled resource with a single patch.
+func ensureFinalizers(ctx context.Context, current client.Object, finalizers []string, add bool) error {
+	pending := []string{}
+	for _, finalizer := range finalizers {
+		if finalizer == "" || controllerutil.ContainsFinalizer(current, finalizer) == add {
+			continue
+		}
+		pending = append(pending, finalizer)
+	}
+	if len(pending) == 0 {
 		// nothing to do
 		return nil
 	}
@@ -164,25 +236,31 @@ func ensureFinalizer(ctx context.Context, current client.Object, finalizer strin
 	log := logr.FromContextOrDiscard(ctx)
 
 	desired := current.DeepCopyObject().(client.Object)
-	if add {
-		log.Info("adding finalizer", "finalizer", finalizer)
-		controllerutil.AddFinalizer(desired, finalizer)
-	} else {
-		log.Info("removing finalizer", "finalizer", finalizer)
-		controllerutil.RemoveFinalizer(desired, finalizer)
+	for _, finalizer := range pending {
+		if add {
+			log.Info("adding finalizer", "finalizer", finalizer)
+			controllerutil.AddFinalizer(desired, finalizer)
+		} else {
+			log.Info("removing finalizer", "finalizer", finalizer)
+			controllerutil.RemoveFinalizer(desired, finalizer)
+		}
 	}
 
 	patch := client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{})
 	if err := config.Patch(ctx, desired, patch); err != nil {
 		if !errors.Is(err, ErrQuiet) {
-			log.Error(err, "unable to patch finalizers", "finalizer", finalizer)
-			config.Recorder.Eventf(current, corev1.EventTypeWarning, "FinalizerPatchFailed",
-				"Failed to patch finalizer %q: %s", finalizer, err)
+			for _, finalizer := range pending {
+				log.Error(err, "unable to patch finalizers", "finalizer", finalizer)
+				config.RecorderFor(ctx).Eventf(current, corev1.EventTypeWarning, "FinalizerPatchFailed",
+					"Failed to patch finalizer %q: %s", finalizer, err)
+			}
 		}
 		return err
 	}
-	config.Recorder.Eventf(current, corev1.EventTypeNormal, "FinalizerPatched",
-		"Patched finalizer %q", finalizer)
+	for _, finalizer := range pending {
+		config.RecorderFor(ctx).Eventf(current, corev1.EventTypeNormal, "FinalizerPatched",
+			"Patched finalizer %q", finalizer)
+	}
 
 	// update current object with values from the api server after patching
 	current.SetFinalizers(desired.GetFinalizers())
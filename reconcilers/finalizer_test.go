@@ -86,6 +86,33 @@ func TestWithFinalizer(t *testing.T) {
 				},
 			},
 		},
+		"add finalizer, persisted metadata": {
+			Resource: resource.DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizer)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Sync", ""),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectResourceMetadata: &rtesting.ResourceMetadata{
+				Finalizers: []string{testFinalizer},
+			},
+		},
 		"error adding finalizer": {
 			Resource: resource.DieReleasePtr(),
 			WithReactors: []rtesting.ReactionFunc{
@@ -235,6 +262,175 @@ func TestWithFinalizer(t *testing.T) {
 	})
 }
 
+func TestWithFinalizer_Multiple(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testFinalizerA := "test-finalizer-a"
+	testFinalizerB := "test-finalizer-b"
+
+	now := &metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"in sync": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizerA, testFinalizerB)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Sync", ""),
+			},
+		},
+		"add finalizers in order": {
+			Resource: resource.DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizerA, testFinalizerB)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizerA),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizerB),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Sync", ""),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer-a","test-finalizer-b"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"clear finalizers in order": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizerA, testFinalizerB)
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.ResourceVersion("1001")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Finalize", ""),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizerA),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizerB),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer-b"],"resourceVersion":"999"}}`),
+				},
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"1000"}}`),
+				},
+			},
+		},
+		"stop clearing at first finalizer not ready": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizerA, testFinalizerB)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"ReadyToClearFinalizers": func(ctx context.Context, resource *resources.TestResource, finalizer string) bool {
+					return finalizer != testFinalizerB
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizerB)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Finalize", ""),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizerA),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer-b"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"later finalizers wait for earlier finalizers": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizerA, testFinalizerB)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"ReadyToClearFinalizers": func(ctx context.Context, resource *resources.TestResource, finalizer string) bool {
+					return finalizer != testFinalizerA
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Finalize", ""),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		var readyToClearFinalizers func(context.Context, *resources.TestResource, string) bool
+		if ready, ok := rtc.Metadata["ReadyToClearFinalizers"]; ok {
+			readyToClearFinalizers = ready.(func(context.Context, *resources.TestResource, string) bool)
+		}
+
+		return &reconcilers.WithFinalizer[*resources.TestResource]{
+			Finalizers:             []string{testFinalizerA, testFinalizerB},
+			ReadyToClearFinalizers: readyToClearFinalizers,
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					c.Recorder.Event(resource, corev1.EventTypeNormal, "Sync", "")
+					return nil
+				},
+				Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+					c.Recorder.Event(resource, corev1.EventTypeNormal, "Finalize", "")
+					return nil
+				},
+			},
+		}
+	})
+}
+
 func TestWithFinalizer_Validate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -247,7 +443,7 @@ func TestWithFinalizer_Validate(t *testing.T) {
 			name:       "empty",
 			resource:   &corev1.ConfigMap{},
 			reconciler: &reconcilers.WithFinalizer[*corev1.ConfigMap]{},
-			shouldErr:  `WithFinalizer "WithFinalizer" must define Finalizer`,
+			shouldErr:  `[WithFinalizer "WithFinalizer" must define Finalizer or Finalizers, WithFinalizer "WithFinalizer" must define Reconciler]`,
 		},
 		{
 			name:     "valid",
@@ -264,7 +460,7 @@ func TestWithFinalizer_Validate(t *testing.T) {
 				Name:       "missing finalizer",
 				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
 			},
-			shouldErr: `WithFinalizer "missing finalizer" must define Finalizer`,
+			shouldErr: `WithFinalizer "missing finalizer" must define Finalizer or Finalizers`,
 		},
 		{
 			name:     "missing reconciler",
@@ -275,6 +471,34 @@ func TestWithFinalizer_Validate(t *testing.T) {
 			},
 			shouldErr: `WithFinalizer "missing reconciler" must define Reconciler`,
 		},
+		{
+			name:     "valid finalizers",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.WithFinalizer[*corev1.ConfigMap]{
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+				Finalizer:  "my-finalizer",
+				Finalizers: []string{"my-other-finalizer"},
+			},
+		},
+		{
+			name:     "empty finalizers",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.WithFinalizer[*corev1.ConfigMap]{
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+				Finalizers: []string{"my-finalizer", ""},
+			},
+			shouldErr: `WithFinalizer "WithFinalizer" must not define an empty finalizer`,
+		},
+		{
+			name:     "duplicate finalizers",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.WithFinalizer[*corev1.ConfigMap]{
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+				Finalizer:  "my-finalizer",
+				Finalizers: []string{"my-finalizer"},
+			},
+			shouldErr: `WithFinalizer "WithFinalizer" must not define duplicate finalizer "my-finalizer"`,
+		},
 		{
 			name:     "valid reconciler",
 			resource: &corev1.ConfigMap{},
@@ -317,3 +541,155 @@ func TestWithFinalizer_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAddFinalizer_ClearFinalizer(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testFinalizer := "test-finalizer"
+
+	now := &metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"add missing finalizer": {
+			Resource: resource.DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizer)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test-finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"add existing finalizer": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizer)
+				}).
+				DieReleasePtr(),
+			ExpectActions: []rtesting.ActionRef{},
+		},
+		"add finalizer preserving other finalizers": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers("other-finalizer")
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers("other-finalizer", testFinalizer)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["other-finalizer","test-finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"clear existing finalizer": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizer)
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"clear missing finalizer": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers("other-finalizer")
+				}).
+				DieReleasePtr(),
+			ExpectActions: []rtesting.ActionRef{},
+		},
+		"error clearing finalizer": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(now)
+					d.Finalizers(testFinalizer)
+				}).
+				DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "TestResource"),
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "FinalizerPatchFailed",
+					`Failed to patch finalizer %q: inducing failure for patch TestResource`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.SyncReconciler[*resources.TestResource]{
+			Sync: func(ctx context.Context, resource *resources.TestResource) error {
+				return reconcilers.AddFinalizer(ctx, resource, testFinalizer)
+			},
+			Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+				return reconcilers.ClearFinalizer(ctx, resource, testFinalizer)
+			},
+		}
+	})
+}
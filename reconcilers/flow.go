@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/utils/ptr"
 	"reconciler.io/runtime/stash"
 	"reconciler.io/runtime/validation"
@@ -82,19 +83,21 @@ func (r *IfThen[T]) init() {
 func (r *IfThen[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate If
 	if r.If == nil {
-		return fmt.Errorf("IfThen %q must implement If", r.Name)
+		errs = append(errs, fmt.Errorf("IfThen %q must implement If", r.Name))
 	}
 
 	// validate Then
 	if r.Then == nil {
-		return fmt.Errorf("IfThen %q must implement Then", r.Name)
+		errs = append(errs, fmt.Errorf("IfThen %q must implement Then", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Then.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("IfThen %q must have a valid Then: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("IfThen %q must have a valid Then: %w", r.Name, err))
 			}
 		}
 	}
@@ -103,12 +106,12 @@ func (r *IfThen[T]) Validate(ctx context.Context) error {
 	if r.Else != nil && validation.IsRecursive(ctx) {
 		if v, ok := r.Else.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("IfThen %q must have a valid Else: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("IfThen %q must have a valid Else: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *IfThen[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
@@ -236,24 +239,26 @@ func (r *While[T]) init() {
 func (r *While[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Condition
 	if r.Condition == nil {
-		return fmt.Errorf("While %q must implement Condition", r.Name)
+		errs = append(errs, fmt.Errorf("While %q must implement Condition", r.Name))
 	}
 
 	// validate Reconciler
 	if r.Reconciler == nil {
-		return fmt.Errorf("While %q must implement Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("While %q must implement Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("While %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("While %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *While[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
@@ -366,22 +371,24 @@ func (r *ForEach[T, I]) init() {
 func (r *ForEach[T, I]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Reconciler
 	if r.Reconciler == nil {
-		return fmt.Errorf("ForEach %q must implement Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("ForEach %q must implement Reconciler", r.Name))
 	}
 	if r.Items == nil {
-		return fmt.Errorf("ForEach %q must implement Items", r.Name)
+		errs = append(errs, fmt.Errorf("ForEach %q must implement Items", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("ForEach %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("ForEach %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *ForEach[T, I]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -499,14 +506,16 @@ func (r *TryCatch[T]) init() {
 func (r *TryCatch[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Try
 	if r.Try == nil {
-		return fmt.Errorf("TryCatch %q must implement Try", r.Name)
+		errs = append(errs, fmt.Errorf("TryCatch %q must implement Try", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Try.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("TryCatch %q must have a valid Try: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("TryCatch %q must have a valid Try: %w", r.Name, err))
 			}
 		}
 	}
@@ -515,12 +524,12 @@ func (r *TryCatch[T]) Validate(ctx context.Context) error {
 	if r.Finally != nil && validation.IsRecursive(ctx) {
 		if v, ok := r.Finally.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("TryCatch %q must have a valid Finally: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("TryCatch %q must have a valid Finally: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *TryCatch[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
@@ -613,21 +622,23 @@ func (r *OverrideSetup[T]) init() {
 func (r *OverrideSetup[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Setup || Reconciler
 	if r.Setup == nil && r.Reconciler == nil {
-		return fmt.Errorf("OverrideSetup %q must implement at least one of Setup or Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("OverrideSetup %q must implement at least one of Setup or Reconciler", r.Name))
 	}
 
 	// validate Reconciler
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("OverrideSetup %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("OverrideSetup %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *OverrideSetup[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
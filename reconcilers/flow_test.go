@@ -268,6 +268,23 @@ func TestIfThen_Validate(t *testing.T) {
 			validateNested: true,
 			shouldErr:      `IfThen "IfThen" must have a valid Else: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "reports all violations",
+			reconciler: &reconcilers.IfThen[*resources.TestResource]{
+				Then: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				Else: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			validateNested: true,
+			shouldErr:      `[IfThen "IfThen" must implement If, IfThen "IfThen" must have a valid Then: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult, IfThen "IfThen" must have a valid Else: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -471,6 +488,18 @@ func TestWhile_Validate(t *testing.T) {
 			validateNested: true,
 			shouldErr:      `While "While" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "reports all violations",
+			reconciler: &reconcilers.While[*resources.TestResource]{
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			validateNested: true,
+			shouldErr:      `[While "While" must implement Condition, While "While" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -683,6 +712,18 @@ func TestForEach_Validate(t *testing.T) {
 			validateNested: true,
 			shouldErr:      `ForEach "invalid reconciler" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "reports all violations",
+			reconciler: &reconcilers.ForEach[*resources.TestResource, any]{
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			validateNested: true,
+			shouldErr:      `[ForEach "reports all violations" must implement Items, ForEach "reports all violations" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -1003,6 +1044,23 @@ func TestTryCatch_Validate(t *testing.T) {
 			validateNested: true,
 			shouldErr:      `TryCatch "TryCatch" must have a valid Finally: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "reports all violations",
+			reconciler: &reconcilers.TryCatch[*resources.TestResource]{
+				Try: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				Finally: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			validateNested: true,
+			shouldErr:      `[TryCatch "TryCatch" must have a valid Try: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult, TryCatch "TryCatch" must have a valid Finally: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
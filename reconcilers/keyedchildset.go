@@ -0,0 +1,268 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/runtime/internal"
+	"reconciler.io/runtime/validation"
+)
+
+// ChildKeyAnnotation holds the key of the item a child was created for by a
+// KeyedChildSetReconciler.
+const ChildKeyAnnotation = "reconciler.io/child-key"
+
+var (
+	_ SubReconciler[client.Object] = (*KeyedChildSetReconciler[client.Object, client.Object, client.ObjectList, interface{}])(nil)
+)
+
+// KeyedChildSetReconciler is a sub reconciler that manages a child resource for each item of a
+// map defined by the reconciled resource, like a map in the resource's spec. Children are created,
+// updated and deleted as items are added, changed and removed from the map. The children are
+// managed by a ChildSetReconciler.
+//
+// The key of each item is set on its child as the ChildKeyAnnotation and is used to correlate
+// desired and actual children. Only children with the annotation are considered ours.
+type KeyedChildSetReconciler[Type, ChildType client.Object, ChildListType client.ObjectList, ValueType any] struct {
+	// Name used to identify this reconciler.  Defaults to `{ChildType}KeyedChildSetReconciler`.
+	// Ideally unique, but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// ChildType is the resource being created/updated/deleted by the reconciler. Required when the
+	// generic type is not a struct, or is unstructured.
+	//
+	// +optional
+	ChildType ChildType
+	// ChildListType is the listing type for the child type. Required when the generic type is not
+	// a struct. For an unstructured ChildType, defaults to an unstructured list of the ChildType's
+	// kind suffixed with List.
+	//
+	// +optional
+	ChildListType ChildListType
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// Items returns the items of the reconciled resource to create a child for, by key. Keys must
+	// not be empty.
+	Items func(resource Type) map[string]ValueType
+
+	// DesiredChild returns the desired child for an item, or nil if the item should not have a
+	// child. Children are requested in the order of their keys. The ChildKeyAnnotation is set on
+	// the returned child. The name of each child must be unique, desiring the same name for
+	// different keys is an error.
+	//
+	// To skip reconciliation of the child resources while still reflecting an existing child's
+	// status on the reconciled resource, return OnlyReconcileChildStatus as an error.
+	DesiredChild func(ctx context.Context, resource Type, key string, value ValueType) (ChildType, error)
+
+	// ChildObjectManager synchronizes the desired child state to the API Server.
+	ChildObjectManager ObjectManager[ChildType]
+
+	// ReflectChildrenStatusOnParent updates the reconciled resource's status with values from the
+	// child reconciliations. The Id of each child result is the key of the item. See
+	// ChildSetReconciler#ReflectChildrenStatusOnParent.
+	ReflectChildrenStatusOnParent func(ctx context.Context, parent Type, result ChildSetResult[ChildType])
+
+	// ReflectChildrenStatusOnParentWithError is equivalent to ReflectChildrenStatusOnParent, but
+	// also able to return an error.
+	ReflectChildrenStatusOnParentWithError func(ctx context.Context, parent Type, result ChildSetResult[ChildType]) error
+
+	// OurChild is used when there are multiple sources of children of the same ChildType
+	// controlled by the same reconciled resource. Children must also define the
+	// ChildKeyAnnotation. See ChildSetReconciler#OurChild.
+	//
+	// +optional
+	OurChild func(resource Type, child ChildType) bool
+
+	// ListOptions allows custom options to be use when listing potential child resources. See
+	// ChildSetReconciler#ListOptions.
+	//
+	// +optional
+	ListOptions func(ctx context.Context, resource Type) []client.ListOption
+
+	lazyInit sync.Once
+	childSet *ChildSetReconciler[Type, ChildType, ChildListType]
+}
+
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) init() {
+	r.lazyInit.Do(func() {
+		if internal.IsNil(r.ChildType) {
+			var nilCT CT
+			r.ChildType = newEmpty(nilCT).(CT)
+		}
+		if internal.IsNil(r.ChildListType) {
+			var nilCLT CLT
+			r.ChildListType = newEmptyList(nilCLT, r.ChildType).(CLT)
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("%sKeyedChildSetReconciler", typeName(r.ChildType))
+		}
+		r.childSet = &ChildSetReconciler[T, CT, CLT]{
+			Name:                                   r.Name,
+			ChildType:                              r.ChildType,
+			ChildListType:                          r.ChildListType,
+			DesiredChildren:                        r.desiredChildren,
+			ChildObjectManager:                     r.ChildObjectManager,
+			ReflectChildrenStatusOnParent:          r.ReflectChildrenStatusOnParent,
+			ReflectChildrenStatusOnParentWithError: r.ReflectChildrenStatusOnParentWithError,
+			OurChild:                               r.ourChild,
+			IdentifyChild:                          childKey[CT],
+			ListOptions:                            r.ListOptions,
+		}
+	})
+}
+
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	c := RetrieveConfigOrDie(ctx)
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("childType", gvk(c, r.ChildType))
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+
+	if r.Setup != nil {
+		if err := r.Setup(ctx, mgr, bldr); err != nil {
+			return err
+		}
+	}
+
+	return r.childSet.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// require Items
+	if r.Items == nil {
+		errs = append(errs, fmt.Errorf("KeyedChildSetReconciler %q must implement Items", r.Name))
+	}
+
+	// require DesiredChild
+	if r.DesiredChild == nil {
+		errs = append(errs, fmt.Errorf("KeyedChildSetReconciler %q must implement DesiredChild", r.Name))
+	}
+
+	// require ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError
+	if r.ReflectChildrenStatusOnParent == nil && r.ReflectChildrenStatusOnParentWithError == nil {
+		errs = append(errs, fmt.Errorf("KeyedChildSetReconciler %q must implement ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError", r.Name))
+	}
+
+	// require ChildObjectManager
+	if r.ChildObjectManager == nil {
+		errs = append(errs, fmt.Errorf("KeyedChildSetReconciler %q must implement ChildObjectManager", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.ChildObjectManager.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("KeyedChildSetReconciler %q must have a valid ChildObjectManager: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	c := RetrieveConfigOrDie(ctx)
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("childType", gvk(c, r.ChildType))
+	ctx = logr.NewContext(ctx, log)
+
+	return r.childSet.Reconcile(ctx, resource)
+}
+
+// desiredChildren returns the desired child for each item, annotated with the key of the item
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) desiredChildren(ctx context.Context, resource T) ([]CT, error) {
+	items := r.Items(resource)
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	children := []CT{}
+	keysByName := map[string]string{}
+	var onlyReconcileChildStatus error
+	for _, key := range keys {
+		child, err := r.DesiredChild(ctx, resource, key, items[key])
+		if err != nil {
+			if !errors.Is(err, OnlyReconcileChildStatus) {
+				return nil, err
+			}
+			onlyReconcileChildStatus = err
+		}
+		if internal.IsNil(child) {
+			continue
+		}
+		// children without a name are created with a generated name and are unique
+		if name := child.GetName(); name != "" {
+			if other, ok := keysByName[name]; ok {
+				return nil, fmt.Errorf("duplicate child name %q desired for keys %q and %q", name, other, key)
+			}
+			keysByName[name] = key
+		}
+		annotations := child.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[ChildKeyAnnotation] = key
+		child.SetAnnotations(annotations)
+		children = append(children, child)
+	}
+	return children, onlyReconcileChildStatus
+}
+
+func (r *KeyedChildSetReconciler[T, CT, CLT, VT]) ourChild(resource T, child CT) bool {
+	if _, ok := child.GetAnnotations()[ChildKeyAnnotation]; !ok {
+		return false
+	}
+	return r.OurChild == nil || r.OurChild(resource, child)
+}
+
+// childKey returns the key of the item the child was created for
+func childKey[CT client.Object](child CT) string {
+	return child.GetAnnotations()[ChildKeyAnnotation]
+}
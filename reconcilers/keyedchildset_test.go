@@ -0,0 +1,354 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestKeyedChildSetReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.CreationTimestamp(now)
+		})
+	resourceBlue := resource.
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("blue", "foo")
+		})
+	resourceBlueGreen := resourceBlue.
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("green", "bar")
+		})
+
+	configMapCreate := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.ControlledBy(resource, scheme)
+		})
+	configMapBlueCreate := configMapCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-blue")
+			d.AddAnnotation(reconcilers.ChildKeyAnnotation, "blue")
+		}).
+		AddData("value", "foo")
+	configMapBlueGiven := configMapBlueCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+		})
+	configMapGreenCreate := configMapCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-green")
+			d.AddAnnotation(reconcilers.ChildKeyAnnotation, "green")
+		}).
+		AddData("value", "bar")
+	configMapGreenGiven := configMapGreenCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"no items": {
+			Resource: resource.DieReleasePtr(),
+		},
+		"creates a child for each item": {
+			Resource: resourceBlueGreen.DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-blue"),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-green"),
+			},
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.DieReleasePtr(),
+				configMapGreenCreate.DieReleasePtr(),
+			},
+			ExpectResource: resourceBlueGreen.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue,green")
+				}).
+				DieReleasePtr(),
+		},
+		"children in sync": {
+			Resource: resourceBlueGreen.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			ExpectResource: resourceBlueGreen.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue,green")
+				}).
+				DieReleasePtr(),
+		},
+		"updates the child when the item changes": {
+			Resource: resourceBlue.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("blue", "baz")
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-blue"),
+			},
+			ExpectUpdates: []client.Object{
+				configMapBlueGiven.
+					AddData("value", "baz").
+					DieReleasePtr(),
+			},
+			ExpectResource: resourceBlue.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("blue", "baz")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue")
+				}).
+				DieReleasePtr(),
+		},
+		"deletes the child when the item is removed": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+				configMapGreenGiven.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName+"-green"),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGreenGiven, scheme),
+			},
+			ExpectResource: resourceBlue.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue")
+				}).
+				DieReleasePtr(),
+		},
+		"skips items without a desired child": {
+			Resource: resourceBlueGreen.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"SkipKey": "green",
+			},
+			ExpectResource: resourceBlueGreen.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue")
+				}).
+				DieReleasePtr(),
+		},
+		"ignores children without a key": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapBlueGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Annotations(nil)
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"duplicate child name": {
+			Resource: resourceBlueGreen.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"ChildName": testName,
+			},
+			ShouldErr: true,
+		},
+		"creates children with generated names": {
+			Resource: resourceBlueGreen.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"ChildName":         "",
+				"ChildGenerateName": testName + "-",
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-001"),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-002"),
+			},
+			ExpectCreates: []client.Object{
+				configMapBlueCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("")
+						d.GenerateName(testName + "-")
+					}).
+					DieReleasePtr(),
+				configMapGreenCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("")
+						d.GenerateName(testName + "-")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectResource: resourceBlueGreen.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("children", "blue,green")
+				}).
+				DieReleasePtr(),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+			Items: func(resource *resources.TestResource) map[string]string {
+				return resource.Spec.Fields
+			},
+			DesiredChild: func(ctx context.Context, resource *resources.TestResource, key string, value string) (*corev1.ConfigMap, error) {
+				if skip, ok := rtc.Metadata["SkipKey"]; ok && skip.(string) == key {
+					return nil, nil
+				}
+				name := resource.Name + "-" + key
+				if childName, ok := rtc.Metadata["ChildName"]; ok {
+					name = childName.(string)
+				}
+				generateName := ""
+				if childGenerateName, ok := rtc.Metadata["ChildGenerateName"]; ok {
+					generateName = childGenerateName.(string)
+				}
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    resource.Namespace,
+						Name:         name,
+						GenerateName: generateName,
+					},
+					Data: map[string]string{
+						"value": value,
+					},
+				}, nil
+			},
+			ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{
+				MergeBeforeUpdate: func(current, desired *corev1.ConfigMap) {
+					current.Data = desired.Data
+				},
+			},
+			ReflectChildrenStatusOnParent: func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+				keys := []string{}
+				for _, child := range result.Children {
+					if child.Child != nil {
+						keys = append(keys, child.Id)
+					}
+				}
+				if len(keys) == 0 {
+					return
+				}
+				if parent.Status.Fields == nil {
+					parent.Status.Fields = map[string]string{}
+				}
+				parent.Status.Fields["children"] = strings.Join(keys, ",")
+			},
+		}
+	})
+}
+
+func TestKeyedChildSetReconciler_Validate(t *testing.T) {
+	desiredChild := func(ctx context.Context, resource *resources.TestResource, key string, value string) (*corev1.ConfigMap, error) {
+		return nil, nil
+	}
+	items := func(resource *resources.TestResource) map[string]string {
+		return resource.Spec.Fields
+	}
+	reflect := func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+	}
+
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+				Items:                         items,
+				DesiredChild:                  desiredChild,
+				ChildObjectManager:            &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{},
+				ReflectChildrenStatusOnParent: reflect,
+			},
+		},
+		{
+			name: "missing items",
+			reconciler: &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+				Name:                          "missing items",
+				DesiredChild:                  desiredChild,
+				ChildObjectManager:            &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{},
+				ReflectChildrenStatusOnParent: reflect,
+			},
+			shouldErr: `KeyedChildSetReconciler "missing items" must implement Items`,
+		},
+		{
+			name: "missing desired child",
+			reconciler: &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+				Name:                          "missing desired child",
+				Items:                         items,
+				ChildObjectManager:            &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{},
+				ReflectChildrenStatusOnParent: reflect,
+			},
+			shouldErr: `KeyedChildSetReconciler "missing desired child" must implement DesiredChild`,
+		},
+		{
+			name: "missing reflect children status on parent",
+			reconciler: &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+				Name:               "missing reflect children status on parent",
+				Items:              items,
+				DesiredChild:       desiredChild,
+				ChildObjectManager: &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{},
+			},
+			shouldErr: `KeyedChildSetReconciler "missing reflect children status on parent" must implement ReflectChildrenStatusOnParent or ReflectChildrenStatusOnParentWithError`,
+		},
+		{
+			name: "missing child object manager",
+			reconciler: &reconcilers.KeyedChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList, string]{
+				Name:                          "missing child object manager",
+				Items:                         items,
+				DesiredChild:                  desiredChild,
+				ReflectChildrenStatusOnParent: reflect,
+			},
+			shouldErr: `KeyedChildSetReconciler "missing child object manager" must implement ChildObjectManager`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.reconciler.Validate(context.TODO())
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,10 +29,17 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	jsonpatch "gomodules.xyz/jsonpatch/v2"
+	jsonpatchv3 "gomodules.xyz/jsonpatch/v3"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/cache"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,9 +54,29 @@ type ObjectManager[Type client.Object] interface {
 	Manage(ctx context.Context, resource client.Object, actual, desired Type) (Type, error)
 }
 
+// DriftDetector is implemented by an ObjectManager that is able to report whether the actual
+// resource has drifted from the desired resource without making any changes. The actual and
+// desired objects follow the same conventions as ObjectManager#Manage, a nil actual object
+// represents a resource that does not exist and a nil desired object a resource that should not
+// exist.
+type DriftDetector[Type client.Object] interface {
+	Detect(ctx context.Context, actual, desired Type) (bool, error)
+}
+
 var _ ObjectManager[client.Object] = (*UpdatingObjectManager[client.Object])(nil)
+var _ DriftDetector[client.Object] = (*UpdatingObjectManager[client.Object])(nil)
 var _ validation.Validator = (*UpdatingObjectManager[client.Object])(nil)
 
+// UpdateStrategy defines how changes to an existing resource are sent to the API Server.
+type UpdateStrategy string
+
+const (
+	// UpdateStrategyUpdate replaces the resource with an update request.
+	UpdateStrategyUpdate UpdateStrategy = "Update"
+	// UpdateStrategyJSONPatch sends the changes to the resource as an RFC 6902 JSON Patch.
+	UpdateStrategyJSONPatch UpdateStrategy = "JSONPatch"
+)
+
 // UpdatingObjectManager compares the actual and desired resources to create/update/delete as desired.
 type UpdatingObjectManager[Type client.Object] struct {
 	// Name used to identify this reconciler.  Defaults to `{Type}UpdatingObjectManager`.  Ideally
@@ -83,14 +111,60 @@ type UpdatingObjectManager[Type client.Object] struct {
 	// object to be copied to the desired object in order to avoid creating
 	// updates which are guaranteed to fail.
 	//
+	// Only called when the resource already exists, before admission mutations are reapplied to
+	// the desired object and before MergeBeforeUpdate. Values copied on to the desired object
+	// are then merged on to the current object by MergeBeforeUpdate and considered when deciding
+	// if an update is required. Server populated immutable fields (like a Job's selector) that
+	// are missing from the desired object should be copied here, otherwise each reconcile
+	// attempts an update that is rejected.
+	//
 	// +optional
 	HarmonizeImmutableFields func(current, desired Type)
 
+	// RecreateOnImmutableError when true, deletes the current resource and creates the desired
+	// resource if an update is rejected by the API Server as invalid due to a change to an
+	// immutable field. An update is only recreated when a field cause of the invalid error reports
+	// the field as immutable. The desired resource is created as returned from the reconciler,
+	// before HarmonizeImmutableFields is applied.
+	//
+	// The resource is recreated at most once per call to Manage. If the current resource has
+	// finalizers, the create is deferred until a later reconcile after the resource is fully
+	// deleted.
+	//
+	// +optional
+	RecreateOnImmutableError bool
+
 	// MergeBeforeUpdate copies desired fields on to the current object before
 	// calling update. Typically fields to copy are the Spec, Labels and
 	// Annotations.
 	MergeBeforeUpdate func(current, desired Type)
 
+	// UpdateStrategy defines how changes to an existing resource are sent to the API Server.
+	// Defaults to UpdateStrategyUpdate.
+	//
+	// UpdateStrategyJSONPatch computes an RFC 6902 JSON Patch from the actual resource to the
+	// merged resource. Unlike a merge patch, a JSON Patch is able to remove individual items from
+	// an array, or replace an item at a specific index. Array operations are addressed by index,
+	// so the patch is only meaningful against the exact resource it was computed from. The patch
+	// starts with a test of the actual resource's resourceVersion, a concurrent write to the
+	// resource causes the patch to be rejected rather than applied against a different array.
+	// The rejected request is returned as an error and the reconcile is retried with the latest
+	// state of the resource.
+	//
+	// +optional
+	UpdateStrategy UpdateStrategy
+
+	// IgnoreFields are paths to fields excluded when comparing the current and actual objects to
+	// decide if an update is required. Paths are dot separated field names of the resource's JSON
+	// representation, like `status` or `metadata.annotations`.
+	//
+	// Fields modified by other controllers that MergeBeforeUpdate may disturb can be ignored to
+	// suppress no-op updates. When an update is required for other reasons, the ignored fields
+	// are sent to the API Server as they exist on the current object.
+	//
+	// +optional
+	IgnoreFields []string
+
 	// Sanitize is called with an object before logging the value. Any value may
 	// be returned. A meaningful subset of the resource is typically returned,
 	// like the Spec.
@@ -147,7 +221,8 @@ func (r *UpdatingObjectManager[T]) SetupWithManager(ctx context.Context, mgr ctr
 			ct.GetObjectKind().SetGroupVersionKind(gvk)
 		}
 
-		bldr.Watches(ct, EnqueueTracked(ctx))
+		// filtered by the WatchPredicates of a ChildReconciler using this manager
+		bldr.Watches(ct, EnqueueTracked(ctx), builder.WithPredicates(retrieveWatchPredicates(ctx)...))
 	}
 
 	return nil
@@ -156,17 +231,26 @@ func (r *UpdatingObjectManager[T]) SetupWithManager(ctx context.Context, mgr ctr
 func (r *UpdatingObjectManager[T]) Validate(ctx context.Context) error {
 	c := RetrieveConfigOrDie(ctx)
 
+	errs := []error{}
+
 	// require MergeBeforeUpdate
 	if r.MergeBeforeUpdate == nil {
-		return fmt.Errorf("UpdatingObjectManager %q must define MergeBeforeUpdate", r.Name)
+		errs = append(errs, fmt.Errorf("UpdatingObjectManager %q must define MergeBeforeUpdate", r.Name))
+	}
+
+	// require a known UpdateStrategy
+	switch r.UpdateStrategy {
+	case "", UpdateStrategyUpdate, UpdateStrategyJSONPatch:
+	default:
+		errs = append(errs, fmt.Errorf("UpdatingObjectManager %q must define a known UpdateStrategy, found %q", r.Name, r.UpdateStrategy))
 	}
 
 	// require DangerouslyAllowDuckTypes for duck types
 	if !r.DangerouslyAllowDuckTypes && duck.IsDuck(r.Type, c.Scheme()) {
-		return fmt.Errorf("UpdatingObjectManager %q must enable DangerouslyAllowDuckTypes to use a duck type", r.Name)
+		errs = append(errs, fmt.Errorf("UpdatingObjectManager %q must enable DangerouslyAllowDuckTypes to use a duck type", r.Name))
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 // Manage a specific resource to create/update/delete based on the actual and desired state. The
@@ -199,12 +283,12 @@ func (r *UpdatingObjectManager[T]) Manage(ctx context.Context, resource client.O
 			if err := c.Delete(ctx, actual); err != nil {
 				if !errors.Is(err, ErrQuiet) {
 					log.Error(err, "unable to delete unwanted resource", "resource", namespaceName(actual))
-					pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "DeleteFailed",
+					pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "DeleteFailed",
 						"Failed to delete %s %q: %v", typeName(actual), actual.GetName(), err)
 				}
 				return nilT, err
 			}
-			pc.Recorder.Eventf(resource, corev1.EventTypeNormal, "Deleted",
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Deleted",
 				"Deleted %s %q", typeName(actual), actual.GetName())
 
 		}
@@ -217,26 +301,13 @@ func (r *UpdatingObjectManager[T]) Manage(ctx context.Context, resource client.O
 
 	// create resource if it doesn't exist
 	if internal.IsNil(actual) || actual.GetCreationTimestamp().Time.IsZero() {
-		log.Info("creating resource", "resource", r.sanitize(desired))
-		if err := c.Create(ctx, desired); err != nil {
-			if !errors.Is(err, ErrQuiet) {
-				log.Error(err, "unable to create resource", "resource", namespaceName(desired))
-				pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "CreationFailed",
-					"Failed to create %s %q: %v", typeName(desired), desired.GetName(), err)
-			}
-			return nilT, err
-		}
-		if r.TrackDesired {
-			// normally tracks should occur before API operations, but when creating a resource with a
-			// generated name, we need to know the actual resource name.
+		return r.create(ctx, resource, desired)
+	}
 
-			if err := c.Tracker.TrackObject(desired, resource); err != nil {
-				return nilT, err
-			}
-		}
-		pc.Recorder.Eventf(resource, corev1.EventTypeNormal, "Created",
-			"Created %s %q", typeName(desired), desired.GetName())
-		return desired, nil
+	// retain the unharmonized desired resource in case the resource needs to be recreated
+	var recreate T
+	if r.RecreateOnImmutableError {
+		recreate = desired.DeepCopyObject().(T)
 	}
 
 	// overwrite fields that should not be mutated
@@ -263,16 +334,20 @@ func (r *UpdatingObjectManager[T]) Manage(ctx context.Context, resource client.O
 		}
 	}
 	r.MergeBeforeUpdate(current, desiredPatched)
-	if equality.Semantic.DeepEqual(current, actual) {
+	if r.inSync(current, actual) {
 		// resource is unchanged
 		log.Info("resource is in sync, no update required")
 		return actual, nil
 	}
 	log.Info("updating resource", "diff", cmp.Diff(r.sanitize(actual), r.sanitize(current), IgnoreAllUnexported))
-	if err := c.Update(ctx, current); err != nil {
+	if err := r.update(ctx, c, actual, current); err != nil {
+		if r.RecreateOnImmutableError && isImmutableFieldError(err) {
+			log.Info("resource update rejected due to immutable field, recreating", "resource", namespaceName(current), "error", err.Error())
+			return r.recreate(ctx, resource, actual, recreate)
+		}
 		if !errors.Is(err, ErrQuiet) {
 			log.Error(err, "unable to update resource", "resource", namespaceName(current))
-			pc.Recorder.Eventf(resource, corev1.EventTypeWarning, "UpdateFailed",
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "UpdateFailed",
 				"Failed to update %s %q: %v", typeName(current), current.GetName(), err)
 		}
 		return nilT, err
@@ -291,12 +366,194 @@ func (r *UpdatingObjectManager[T]) Manage(ctx context.Context, resource client.O
 	}
 
 	log.Info("updated resource")
-	pc.Recorder.Eventf(resource, corev1.EventTypeNormal, "Updated",
+	pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Updated",
 		"Updated %s %q", typeName(current), current.GetName())
 
 	return current, nil
 }
 
+// Detect returns true when Manage would create, update or delete the resource to reconcile the
+// actual state with the desired state. The API Server is not called and neither the actual nor the
+// desired objects are modified, which makes Detect suitable for reporting drift on the status of
+// the reconciled resource while changes are suspended.
+//
+// An update is detected by merging the desired object on to a copy of the actual object with
+// HarmonizeImmutableFields and MergeBeforeUpdate, respecting IgnoreFields and remote mutations
+// observed by prior updates.
+func (r *UpdatingObjectManager[T]) Detect(ctx context.Context, actual, desired T) (bool, error) {
+	r.init()
+
+	exists := !internal.IsNil(actual) && !actual.GetCreationTimestamp().Time.IsZero()
+	if internal.IsNil(desired) {
+		// a resource being deleted is not deleted again
+		return exists && actual.GetDeletionTimestamp() == nil, nil
+	}
+	if !exists {
+		return true, nil
+	}
+
+	actual = actual.DeepCopyObject().(T)
+	desired = desired.DeepCopyObject().(T)
+	if r.HarmonizeImmutableFields != nil {
+		r.HarmonizeImmutableFields(actual, desired)
+	}
+	if patch, ok := r.mutationCache.Get(actual.GetUID()); ok {
+		// the only object added to the cache is *Patch, an error is ignored as it is by Manage
+		_ = patch.(*Patch).Apply(desired)
+	}
+	current := actual.DeepCopyObject().(T)
+	r.MergeBeforeUpdate(current, desired)
+	return !r.inSync(current, actual), nil
+}
+
+// update sends the changes from the actual resource to the current resource to the API Server
+// using the UpdateStrategy. The current resource is updated with the response.
+func (r *UpdatingObjectManager[T]) update(ctx context.Context, c Config, actual, current T) error {
+	if r.UpdateStrategy != UpdateStrategyJSONPatch {
+		return c.Update(ctx, current)
+	}
+
+	patch, err := newJSONPatch(actual, current)
+	if err != nil {
+		return err
+	}
+	return c.Patch(ctx, current, patch)
+}
+
+// newJSONPatch creates an RFC 6902 JSON Patch transforming the base object into the update
+// object. The patch is guarded by a test of the base object's resourceVersion.
+func newJSONPatch(base, update client.Object) (client.Patch, error) {
+	baseBytes, err := json.Marshal(base)
+	if err != nil {
+		return nil, err
+	}
+	updateBytes, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+	// jsonpatch v3 preserves the order of fields, keeping the operations stable
+	ops, err := jsonpatchv3.CreatePatch(baseBytes, updateBytes)
+	if err != nil {
+		return nil, err
+	}
+	if resourceVersion := base.GetResourceVersion(); resourceVersion != "" {
+		test := jsonpatchv3.NewOperation("test", "/metadata/resourceVersion", resourceVersion)
+		ops = append([]jsonpatchv3.Operation{test}, ops...)
+	}
+	patchBytes, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	return client.RawPatch(types.JSONPatchType, patchBytes), nil
+}
+
+func (r *UpdatingObjectManager[T]) create(ctx context.Context, resource client.Object, desired T) (T, error) {
+	var nilT T
+
+	log := logr.FromContextOrDiscard(ctx)
+	pc := RetrieveOriginalConfigOrDie(ctx)
+	c := RetrieveConfigOrDie(ctx)
+
+	log.Info("creating resource", "resource", r.sanitize(desired))
+	if err := c.Create(ctx, desired); err != nil {
+		if !errors.Is(err, ErrQuiet) {
+			log.Error(err, "unable to create resource", "resource", namespaceName(desired))
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "CreationFailed",
+				"Failed to create %s %q: %v", typeName(desired), desired.GetName(), err)
+		}
+		return nilT, err
+	}
+	if r.TrackDesired {
+		// normally tracks should occur before API operations, but when creating a resource with a
+		// generated name, we need to know the actual resource name.
+
+		if err := c.Tracker.TrackObject(desired, resource); err != nil {
+			return nilT, err
+		}
+	}
+	pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Created",
+		"Created %s %q", typeName(desired), desired.GetName())
+	return desired, nil
+}
+
+// recreate deletes the actual resource and creates the desired resource in its place. The create
+// is skipped when the actual resource has finalizers, as the name is not released until the
+// finalizers are cleared. A create failure is returned rather than retried.
+func (r *UpdatingObjectManager[T]) recreate(ctx context.Context, resource client.Object, actual, desired T) (T, error) {
+	var nilT T
+
+	log := logr.FromContextOrDiscard(ctx)
+	pc := RetrieveOriginalConfigOrDie(ctx)
+	c := RetrieveConfigOrDie(ctx)
+
+	log.Info("deleting resource to recreate", "resource", namespaceName(actual))
+	if err := c.Delete(ctx, actual); err != nil && !apierrs.IsNotFound(err) {
+		if !errors.Is(err, ErrQuiet) {
+			log.Error(err, "unable to delete resource to recreate", "resource", namespaceName(actual))
+			pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "DeleteFailed",
+				"Failed to delete %s %q: %v", typeName(actual), actual.GetName(), err)
+		}
+		return nilT, err
+	}
+	pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "Deleted",
+		"Deleted %s %q", typeName(actual), actual.GetName())
+
+	if len(actual.GetFinalizers()) != 0 {
+		log.Info("waiting for finalizers to be cleared before recreating resource", "resource", namespaceName(actual))
+		return nilT, nil
+	}
+
+	return r.create(ctx, resource, desired)
+}
+
+// isImmutableFieldError returns true when the error is an invalid status from the API Server
+// with a field cause that rejects the change of an immutable field.
+func isImmutableFieldError(err error) bool {
+	if !apierrs.IsInvalid(err) {
+		return false
+	}
+	var status apierrs.APIStatus
+	if !errors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+	if details == nil {
+		return false
+	}
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldValueInvalid && cause.Type != metav1.CauseTypeForbidden {
+			continue
+		}
+		if strings.Contains(cause.Message, apivalidation.FieldImmutableErrorMsg) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UpdatingObjectManager[T]) inSync(current, actual T) bool {
+	if len(r.IgnoreFields) == 0 {
+		return equality.Semantic.DeepEqual(current, actual)
+	}
+
+	currentContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return equality.Semantic.DeepEqual(current, actual)
+	}
+	actualContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(actual)
+	if err != nil {
+		return equality.Semantic.DeepEqual(current, actual)
+	}
+	// the content of an unstructured resource is not copied by the converter
+	currentContent = runtime.DeepCopyJSON(currentContent)
+	actualContent = runtime.DeepCopyJSON(actualContent)
+	for _, field := range r.IgnoreFields {
+		unstructured.RemoveNestedField(currentContent, strings.Split(field, ".")...)
+		unstructured.RemoveNestedField(actualContent, strings.Split(field, ".")...)
+	}
+	return equality.Semantic.DeepEqual(currentContent, actualContent)
+}
+
 func (r *UpdatingObjectManager[T]) sanitize(resource T) interface{} {
 	if r.Sanitize == nil {
 		return resource
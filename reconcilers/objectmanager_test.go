@@ -26,10 +26,13 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	diecorev1 "reconciler.io/dies/apis/core/v1"
@@ -102,6 +105,19 @@ func TestUpdatingObjectManager(t *testing.T) {
 			om.HarmonizeImmutableFields = harmonizeImmutableFields
 		}
 	}
+	withRecreateOnImmutableError := func(recreateOnImmutableError bool) func(*reconcilers.UpdatingObjectManager[*corev1.ConfigMap]) {
+		return func(om *reconcilers.UpdatingObjectManager[*corev1.ConfigMap]) {
+			om.RecreateOnImmutableError = recreateOnImmutableError
+		}
+	}
+
+	immutableErr := apierrs.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, testName, field.ErrorList{
+		field.Forbidden(field.NewPath("data"), "field is immutable when `immutable` is set"),
+	})
+	// the field path mentions immutable, but the value is rejected for another reason
+	invalidErr := apierrs.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, testName, field.ErrorList{
+		field.Invalid(field.NewPath("data").Key("immutable"), "", "must not be empty"),
+	})
 
 	actualStashKey := rtesting.ObjectManagerReconcilerTestHarnessActualStasher[*corev1.ConfigMap]().Key()
 	desiredStashKey := rtesting.ObjectManagerReconcilerTestHarnessDesiredStasher[*corev1.ConfigMap]().Key()
@@ -423,6 +439,44 @@ func TestUpdatingObjectManager(t *testing.T) {
 					DieReleasePtr(),
 			},
 		},
+		"custom generated names and uids": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(),
+			},
+			NameGenerator: func(obj client.Object) string {
+				return obj.GetGenerateName() + "custom"
+			},
+			UIDGenerator: rtesting.NewSequentialUIDGenerator(),
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: nil,
+				desiredStashKey: desiredConfigMap.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("")
+						d.GenerateName(testName + "-")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-custom"),
+			},
+			ExpectCreates: []client.Object{
+				desiredConfigMap.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("")
+						d.GenerateName(testName + "-")
+					}),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: desiredConfigMap.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-custom")
+						d.GenerateName(testName + "-")
+						d.UID(types.UID("00000000-0000-0000-0000-000000000001"))
+					}).
+					DieReleasePtr(),
+			},
+		},
 		"ignore drift in immutable fields": rtesting.SubReconcilerTestCase[client.Object]{
 			Resource: resource.DieReleasePtr(),
 			Metadata: map[string]any{
@@ -449,6 +503,223 @@ func TestUpdatingObjectManager(t *testing.T) {
 					DieReleasePtr(),
 			},
 		},
+		"recreate on immutable field error": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(
+					withRecreateOnImmutableError(true),
+				),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(givenConfigMap, scheme),
+			},
+			ExpectCreates: []client.Object{
+				desiredConfigMap,
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+		},
+		"recreate creates the unharmonized desired resource": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(
+					withRecreateOnImmutableError(true),
+					withHarmonizeImmutableFields(func(actual, desired *corev1.ConfigMap) {
+						desired.Immutable = actual.Immutable
+					}),
+				),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(givenConfigMap, scheme),
+			},
+			ExpectCreates: []client.Object{
+				desiredConfigMap,
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+		},
+		"recreate waits for finalizers": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(
+					withRecreateOnImmutableError(true),
+				),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers(testFinalizer)
+					}).
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers(testFinalizer)
+					}).
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(givenConfigMap, scheme),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: nil,
+			},
+		},
+		"recreate only once when create fails": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(
+					withRecreateOnImmutableError(true),
+				),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+				rtesting.InduceFailure("create", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "CreationFailed", `Failed to create ConfigMap %q: %s`, testName, immutableErr),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(givenConfigMap, scheme),
+			},
+			ExpectCreates: []client.Object{
+				desiredConfigMap,
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: nil,
+			},
+		},
+		"invalid update for a mutable field is not recreated": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(
+					withRecreateOnImmutableError(true),
+				),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: invalidErr,
+				}),
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "UpdateFailed", `Failed to update ConfigMap %q: %s`, testName, invalidErr),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: nil,
+			},
+		},
+		"invalid update without recreate": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenConfigMap.
+					Immutable(ptr.To[bool](true)).
+					AddData("foo", "bar").
+					DieReleasePtr(),
+				desiredStashKey: desiredConfigMap.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "UpdateFailed", `Failed to update ConfigMap %q: %s`, testName, immutableErr),
+			},
+			ExpectUpdates: []client.Object{
+				givenConfigMap.
+					Immutable(ptr.To[bool](true)),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: nil,
+			},
+		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
@@ -458,6 +729,433 @@ func TestUpdatingObjectManager(t *testing.T) {
 	})
 }
 
+func TestUpdatingObjectManager_Detect(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	desired := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-child")
+		}).
+		AddData("foo", "bar")
+	actual := desired.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+			d.ResourceVersion("999")
+		})
+
+	tests := []struct {
+		name         string
+		actual       *corev1.ConfigMap
+		desired      *corev1.ConfigMap
+		ignoreFields []string
+		drift        bool
+	}{
+		{
+			name:  "not desired and does not exist",
+			drift: false,
+		},
+		{
+			name:    "desired and does not exist",
+			desired: desired.DieReleasePtr(),
+			drift:   true,
+		},
+		{
+			name:   "exists and is not desired",
+			actual: actual.DieReleasePtr(),
+			drift:  true,
+		},
+		{
+			name: "deleting and is not desired",
+			actual: actual.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+				}).
+				DieReleasePtr(),
+			drift: false,
+		},
+		{
+			name:    "in sync",
+			actual:  actual.DieReleasePtr(),
+			desired: desired.DieReleasePtr(),
+			drift:   false,
+		},
+		{
+			name:    "drifted",
+			actual:  actual.AddData("foo", "baz").DieReleasePtr(),
+			desired: desired.DieReleasePtr(),
+			drift:   true,
+		},
+		{
+			name:         "drifted in an ignored field",
+			actual:       actual.AddData("foo", "baz").DieReleasePtr(),
+			desired:      desired.DieReleasePtr(),
+			ignoreFields: []string{"data"},
+			drift:        false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &reconcilers.UpdatingObjectManager[*corev1.ConfigMap]{
+				MergeBeforeUpdate: func(current, desired *corev1.ConfigMap) {
+					current.Data = desired.Data
+				},
+				IgnoreFields: tc.ignoreFields,
+			}
+			var originalActual, originalDesired *corev1.ConfigMap
+			if tc.actual != nil {
+				originalActual = tc.actual.DeepCopy()
+			}
+			if tc.desired != nil {
+				originalDesired = tc.desired.DeepCopy()
+			}
+
+			drift, err := r.Detect(context.TODO(), tc.actual, tc.desired)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if drift != tc.drift {
+				t.Errorf("expected drift %v, actual %v", tc.drift, drift)
+			}
+			if diff := cmp.Diff(originalActual, tc.actual); diff != "" {
+				t.Errorf("unexpected mutation of actual (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(originalDesired, tc.desired); diff != "" {
+				t.Errorf("unexpected mutation of desired (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdatingObjectManager_IgnoreFields(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testChildName := "test-child"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	now := metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	desiredChild := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testChildName)
+		}).
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("hello", "world")
+		})
+	givenChild := desiredChild.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+		}).
+		StatusDie(func(d *dies.TestResourceStatusDie) {
+			d.ConditionsDie(
+				diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+			)
+		})
+
+	makeUpdatingObjectManager := func(ignoreFields ...string) *reconcilers.UpdatingObjectManager[*resources.TestResource] {
+		return &reconcilers.UpdatingObjectManager[*resources.TestResource]{
+			MergeBeforeUpdate: func(current, desired *resources.TestResource) {
+				// replaces the whole object, including the status owned by another controller
+				current.Labels = desired.Labels
+				current.Spec = desired.Spec
+				current.Status = desired.Status
+			},
+			IgnoreFields: ignoreFields,
+		}
+	}
+
+	actualStashKey := rtesting.ObjectManagerReconcilerTestHarnessActualStasher[*resources.TestResource]().Key()
+	desiredStashKey := rtesting.ObjectManagerReconcilerTestHarnessDesiredStasher[*resources.TestResource]().Key()
+	resultStashKey := rtesting.ObjectManagerReconcilerTestHarnessResultStasher[*resources.TestResource]().Key()
+
+	rts := rtesting.SubReconcilerTests[client.Object]{
+		"status drift updates": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager(),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey:  givenChild.DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated TestResource %q`, testChildName),
+			},
+			ExpectUpdates: []client.Object{
+				desiredChild,
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: desiredChild.DieReleasePtr(),
+			},
+		},
+		"ignored status drift is in sync": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager("status"),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey:  givenChild.DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: givenChild.DieReleasePtr(),
+			},
+		},
+		"ignored nested field drift is in sync": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager("status", "metadata.labels"),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenChild.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("foo", "bar")
+					}).
+					DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: givenChild.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("foo", "bar")
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"spec drift updates with ignored fields": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]any{
+				"ObjectManager": makeUpdatingObjectManager("status"),
+			},
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenChild.
+					SpecDie(func(d *dies.TestResourceSpecDie) {
+						d.AddField("foo", "bar")
+					}).
+					DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated TestResource %q`, testChildName),
+			},
+			ExpectUpdates: []client.Object{
+				desiredChild,
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: desiredChild.DieReleasePtr(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
+		return &rtesting.ObjectManagerReconcilerTestHarness[*resources.TestResource]{
+			ObjectManager: rtc.Metadata["ObjectManager"].(reconcilers.ObjectManager[*resources.TestResource]),
+		}
+	})
+}
+
+func TestUpdatingObjectManager_IgnoreFields_Unstructured(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testChildName := "test-child"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	now := metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	desiredChild := dies.TestResourceBlank.
+		APIVersion(resources.GroupVersion.Identifier()).
+		Kind("TestResource").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testChildName)
+		}).
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("hello", "world")
+		})
+	givenChild := desiredChild.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+			d.AddAnnotation("example.com/managed-by", "someone-else")
+		})
+
+	actualStashKey := rtesting.ObjectManagerReconcilerTestHarnessActualStasher[*unstructured.Unstructured]().Key()
+	desiredStashKey := rtesting.ObjectManagerReconcilerTestHarnessDesiredStasher[*unstructured.Unstructured]().Key()
+
+	rts := rtesting.SubReconcilerTests[client.Object]{
+		"spec drift updates preserving ignored fields": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey: givenChild.
+					SpecDie(func(d *dies.TestResourceSpecDie) {
+						d.AddField("hello", "moon")
+					}).
+					DieReleaseUnstructured(),
+				desiredStashKey: desiredChild.DieReleaseUnstructured(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated TestResource %q`, testChildName),
+			},
+			ExpectUpdates: []client.Object{
+				givenChild.DieReleaseUnstructured(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
+		return &rtesting.ObjectManagerReconcilerTestHarness[*unstructured.Unstructured]{
+			ObjectManager: &reconcilers.UpdatingObjectManager[*unstructured.Unstructured]{
+				MergeBeforeUpdate: func(current, desired *unstructured.Unstructured) {
+					current.Object["spec"] = desired.Object["spec"]
+				},
+				IgnoreFields: []string{"metadata.annotations"},
+			},
+		}
+	})
+}
+
+func TestUpdatingObjectManager_JSONPatch(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	testChildName := "test-child"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	now := metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	withContainers := func(names ...string) func(d *dies.TestResourceSpecDie) {
+		return func(d *dies.TestResourceSpecDie) {
+			d.DieStamp(func(r *resources.TestResourceSpec) {
+				r.Template.Spec.Containers = nil
+				for _, name := range names {
+					r.Template.Spec.Containers = append(r.Template.Spec.Containers, corev1.Container{Name: name})
+				}
+			})
+		}
+	}
+
+	desiredChild := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testChildName)
+		}).
+		SpecDie(withContainers("a", "c"))
+	givenChild := desiredChild.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+			d.ResourceVersion("999")
+		}).
+		SpecDie(withContainers("a", "b", "c"))
+
+	makeUpdatingObjectManager := func() *reconcilers.UpdatingObjectManager[*resources.TestResource] {
+		return &reconcilers.UpdatingObjectManager[*resources.TestResource]{
+			MergeBeforeUpdate: func(current, desired *resources.TestResource) {
+				current.Spec = desired.Spec
+			},
+			UpdateStrategy: reconcilers.UpdateStrategyJSONPatch,
+		}
+	}
+
+	actualStashKey := rtesting.ObjectManagerReconcilerTestHarnessActualStasher[*resources.TestResource]().Key()
+	desiredStashKey := rtesting.ObjectManagerReconcilerTestHarnessDesiredStasher[*resources.TestResource]().Key()
+	resultStashKey := rtesting.ObjectManagerReconcilerTestHarnessResultStasher[*resources.TestResource]().Key()
+
+	rts := rtesting.SubReconcilerTests[client.Object]{
+		"in sync": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey:  givenChild.SpecDie(withContainers("a", "c")).DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: givenChild.SpecDie(withContainers("a", "c")).DieReleasePtr(),
+			},
+		},
+		"removes array item": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey:  givenChild.DieReleasePtr(),
+				desiredStashKey: desiredChild.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated TestResource %q`, testChildName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testChildName,
+					PatchType: types.JSONPatchType,
+					Patch: []byte(`[
+						{"op": "test", "path": "/metadata/resourceVersion", "value": "999"},
+						{"op": "remove", "path": "/spec/template/spec/containers/2"},
+						{"op": "replace", "path": "/spec/template/spec/containers/1/name", "value": "c"}
+					]`),
+				},
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: givenChild.SpecDie(withContainers("a", "c")).DieReleasePtr(),
+			},
+		},
+		"replaces array item": rtesting.SubReconcilerTestCase[client.Object]{
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]any{
+				actualStashKey:  givenChild.DieReleasePtr(),
+				desiredStashKey: desiredChild.SpecDie(withContainers("a", "d", "c")).DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated TestResource %q`, testChildName),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testChildName,
+					PatchType: types.JSONPatchType,
+					Patch: []byte(`[
+						{"op": "test", "path": "/metadata/resourceVersion", "value": "999"},
+						{"op": "replace", "path": "/spec/template/spec/containers/1/name", "value": "d"}
+					]`),
+				},
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				resultStashKey: givenChild.SpecDie(withContainers("a", "d", "c")).DieReleasePtr(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
+		return &rtesting.ObjectManagerReconcilerTestHarness[*resources.TestResource]{
+			ObjectManager: makeUpdatingObjectManager(),
+		}
+	})
+}
+
 func TestUpdatingObjectManager_Duck(t *testing.T) {
 	testNamespace := "test-namespace"
 	testName := "test-resource"
@@ -947,6 +1645,24 @@ func TestUpdatingObjectManager_Validate(t *testing.T) {
 				Sanitize:          func(child *resources.TestResource) interface{} { return child.Spec },
 			},
 		},
+		{
+			name: "UpdateStrategy",
+			objectManager: &reconcilers.UpdatingObjectManager[*resources.TestResource]{
+				Type:              &resources.TestResource{},
+				MergeBeforeUpdate: func(current, desired *resources.TestResource) {},
+				UpdateStrategy:    reconcilers.UpdateStrategyJSONPatch,
+			},
+		},
+		{
+			name: "UpdateStrategy unknown",
+			objectManager: &reconcilers.UpdatingObjectManager[*resources.TestResource]{
+				Name:              "UpdateStrategy unknown",
+				Type:              &resources.TestResource{},
+				MergeBeforeUpdate: func(current, desired *resources.TestResource) {},
+				UpdateStrategy:    "Apply",
+			},
+			shouldErr: `UpdatingObjectManager "UpdateStrategy unknown" must define a known UpdateStrategy, found "Apply"`,
+		},
 	}
 
 	for _, tc := range tests {
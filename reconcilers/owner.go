@@ -0,0 +1,114 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// maxOwnerDepth bounds the number of controller references followed while resolving a root
+// owner, guarding against cycles in the chain of ownership.
+const maxOwnerDepth = 16
+
+// RootOwner resolves the top-most owner of the group kind by following the controller
+// references of the object up the chain of ownership. Each owner is read as metadata with the
+// reader. The chain ends at an object without a controller reference, or at an owner that no
+// longer exists or was recreated since the reference was set. False is returned when no owner
+// of the group kind is found.
+func RootOwner(ctx context.Context, c client.Reader, obj client.Object, ownerGroupKind schema.GroupKind) (types.NamespacedName, bool, error) {
+	var root types.NamespacedName
+	found := false
+
+	current := obj
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		ref := metav1.GetControllerOfNoCopy(current)
+		if ref == nil {
+			break
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return types.NamespacedName{}, false, err
+		}
+		owner := &metav1.PartialObjectMetadata{}
+		owner.SetGroupVersionKind(gv.WithKind(ref.Kind))
+		// owners are either in the same namespace as the object, or cluster scoped
+		if err := c.Get(ctx, types.NamespacedName{Namespace: current.GetNamespace(), Name: ref.Name}, owner); err != nil {
+			if apierrs.IsNotFound(err) {
+				break
+			}
+			return types.NamespacedName{}, false, err
+		}
+		if owner.GetUID() != ref.UID {
+			// the reference is for a previous incarnation of the owner
+			break
+		}
+		if gv.Group == ownerGroupKind.Group && ref.Kind == ownerGroupKind.Kind {
+			root = types.NamespacedName{Namespace: owner.GetNamespace(), Name: owner.GetName()}
+			found = true
+		}
+		current = owner
+	}
+
+	return root, found, nil
+}
+
+// EnqueueRootOwner returns an event handler that enqueues the root owner of the watched object
+// that is of the reconciled resource type, see RootOwner. Unlike Owns, which only considers the
+// immediate controller of an object, the watched object may be any number of levels of
+// ownership below the reconciled resource.
+//
+// The owners are read as metadata with the APIReader. Reading them with the cached client would
+// start an informer for each kind of owner in the chain, caching every object of those kinds.
+func EnqueueRootOwner(ctx context.Context) handler.EventHandler {
+	c := RetrieveConfigOrDie(ctx)
+	log := logr.FromContextOrDiscard(ctx)
+
+	resourceType := RetrieveResourceType(ctx)
+	if resourceType == nil {
+		panic(fmt.Errorf("resource type must be stashed in the context"))
+	}
+	ownerGroupKind := gvk(c, resourceType).GroupKind()
+
+	return handler.EnqueueRequestsFromMapFunc(
+		func(ctx context.Context, obj client.Object) []Request {
+			owner, found, err := RootOwner(ctx, c.APIReader, obj, ownerGroupKind)
+			if err != nil {
+				if !errors.Is(err, ErrQuiet) {
+					log.Error(err, "unable to get root owner")
+				}
+				return nil
+			}
+			if !found {
+				return nil
+			}
+
+			return []Request{
+				{NamespacedName: owner},
+			}
+		},
+	)
+}
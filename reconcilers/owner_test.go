@@ -0,0 +1,223 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestRootOwner(t *testing.T) {
+	testNamespace := "test-namespace"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	controlledBy := func(obj client.Object, owner client.Object) {
+		gvk, err := apiutil.GVKForObject(owner, scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(owner, gvk)})
+	}
+
+	resource := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-resource", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c01"},
+	}
+	parentResource := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-parent-resource", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c02"},
+	}
+	nestedResource := resource.DeepCopy()
+	controlledBy(nestedResource, parentResource)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-deployment", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c03"},
+	}
+	controlledBy(deployment, resource)
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-replicaset", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c04"},
+	}
+	controlledBy(replicaSet, deployment)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-pod", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c05"},
+	}
+	controlledBy(pod, replicaSet)
+	notController := pod.DeepCopy()
+	notController.OwnerReferences[0].Controller = nil
+	recreatedReplicaSet := replicaSet.DeepCopy()
+	recreatedReplicaSet.UID = "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c06"
+
+	testResourceGroupKind := schema.GroupKind{Group: resources.GroupVersion.Group, Kind: "TestResource"}
+
+	tests := map[string]struct {
+		givenObjects  []client.Object
+		obj           client.Object
+		groupKind     schema.GroupKind
+		expectedOwner types.NamespacedName
+		expectedFound bool
+	}{
+		"no owner": {
+			givenObjects: []client.Object{resource},
+			obj:          resource,
+			groupKind:    testResourceGroupKind,
+		},
+		"direct owner": {
+			givenObjects:  []client.Object{resource},
+			obj:           deployment,
+			groupKind:     testResourceGroupKind,
+			expectedOwner: types.NamespacedName{Namespace: testNamespace, Name: "my-resource"},
+			expectedFound: true,
+		},
+		"2-level ownership": {
+			givenObjects:  []client.Object{resource, deployment},
+			obj:           replicaSet,
+			groupKind:     testResourceGroupKind,
+			expectedOwner: types.NamespacedName{Namespace: testNamespace, Name: "my-resource"},
+			expectedFound: true,
+		},
+		"3-level ownership": {
+			givenObjects:  []client.Object{resource, deployment, replicaSet},
+			obj:           pod,
+			groupKind:     testResourceGroupKind,
+			expectedOwner: types.NamespacedName{Namespace: testNamespace, Name: "my-resource"},
+			expectedFound: true,
+		},
+		"intermediate owner": {
+			givenObjects:  []client.Object{resource, deployment, replicaSet},
+			obj:           pod,
+			groupKind:     schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			expectedOwner: types.NamespacedName{Namespace: testNamespace, Name: "my-deployment"},
+			expectedFound: true,
+		},
+		"top-most owner of the kind": {
+			givenObjects:  []client.Object{parentResource, nestedResource, deployment, replicaSet},
+			obj:           pod,
+			groupKind:     testResourceGroupKind,
+			expectedOwner: types.NamespacedName{Namespace: testNamespace, Name: "my-parent-resource"},
+			expectedFound: true,
+		},
+		"no owner of the kind": {
+			givenObjects: []client.Object{resource, deployment, replicaSet},
+			obj:          pod,
+			groupKind:    schema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+		},
+		"owner is not a controller": {
+			givenObjects: []client.Object{resource, deployment, replicaSet},
+			obj:          notController,
+			groupKind:    testResourceGroupKind,
+		},
+		"owner not found": {
+			givenObjects: []client.Object{resource, replicaSet},
+			obj:          pod,
+			groupKind:    testResourceGroupKind,
+		},
+		"owner recreated": {
+			givenObjects: []client.Object{resource, deployment, recreatedReplicaSet},
+			obj:          pod,
+			groupKind:    testResourceGroupKind,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := (&rtesting.ExpectConfig{Scheme: scheme, GivenObjects: tc.givenObjects}).Config()
+			owner, found, err := reconcilers.RootOwner(context.TODO(), c, tc.obj, tc.groupKind)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if found != tc.expectedFound {
+				t.Errorf("expected found %t, actually %t", tc.expectedFound, found)
+			}
+			if diff := cmp.Diff(tc.expectedOwner, owner); diff != "" {
+				t.Errorf("unexpected owner (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnqueueRootOwner(t *testing.T) {
+	testNamespace := "test-namespace"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "my-resource", UID: "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c01"},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "my-deployment",
+			UID:       "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c03",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(resource, resources.GroupVersion.WithKind("TestResource")),
+			},
+		},
+	}
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "my-replicaset",
+			UID:       "b6f6ac3b-5e2d-4e1f-9d0a-6a7b1c7e9c04",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+	}
+
+	expectConfig := &rtesting.ExpectConfig{
+		Scheme: scheme,
+		// owners are read with the APIReader, not the cache
+		APIGivenObjects:      []client.Object{resource, deployment},
+		ExpectAPIReaderReads: ptr.To(2),
+	}
+	c := expectConfig.Config()
+	ctx := reconcilers.StashConfig(context.TODO(), c)
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+
+	reconcilers.EnqueueRootOwner(ctx).Create(ctx, event.CreateEvent{Object: replicaSet}, q)
+
+	if expected, actual := 1, q.Len(); expected != actual {
+		t.Fatalf("expected %d enqueued requests, actually %d", expected, actual)
+	}
+	req, _ := q.Get()
+	if diff := cmp.Diff(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "my-resource"}}, req); diff != "" {
+		t.Errorf("unexpected request (-expected, +actual): %s", diff)
+	}
+	expectConfig.AssertAPIReaderExpectations(t)
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/runtime/internal"
+)
+
+var (
+	_ SubReconciler[client.Object] = (*PropagateReconciler[client.Object, client.Object, client.ObjectList])(nil)
+)
+
+// PropagateReconciler is a sub reconciler that copies a field from the reconciled resource onto
+// each of its existing children, and optionally reflects a field from the children back onto the
+// reconciled resource. Children are not created or deleted, only the target field of a child is
+// updated.
+//
+// Children are the resources of the ChildType controlled by the reconciled resource. The children
+// are managed by a ChildSetReconciler, which registers the child type to watch for changes during
+// setup. The PropagateReconciler does not watch the child type itself.
+type PropagateReconciler[Type, ChildType client.Object, ChildListType client.ObjectList] struct {
+	// Name used to identify this reconciler.  Defaults to `{ChildType}PropagateReconciler`.  Ideally
+	// unique, but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// ChildType is the resource being propagated to. For example if the reconciled resource is a
+	// Deployment resource, the child might be a ReplicaSet. Required when the generic type is not a
+	// struct, or is unstructured.
+	//
+	// +optional
+	ChildType ChildType
+	// ChildListType is the listing type for the child type. For example,
+	// PodList is the list type for Pod. Required when the generic type is not
+	// a struct. For an unstructured ChildType, defaults to an unstructured list
+	// of the ChildType's kind suffixed with List.
+	//
+	// +optional
+	ChildListType ChildListType
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// SourceField is the path to the field of the reconciled resource to propagate. Each item is
+	// a field name of the resource's JSON representation, like
+	// `[]string{"metadata", "labels", "example.com/team"}`. Field names may contain dots, as is
+	// common for labels and annotations.
+	//
+	// When the source field is not set, the target field is removed from each child.
+	SourceField []string
+
+	// TargetField is the path to the field of each child the source field is copied to, in the
+	// same form as SourceField.
+	TargetField []string
+
+	// ChildStatusField is the path to the field of each child reflected on the reconciled resource,
+	// in the same form as SourceField.
+	//
+	// +optional
+	ChildStatusField []string
+
+	// AggregateChildStatus updates the reconciled resource with the values of the ChildStatusField
+	// for each child, in the order the children were reconciled (sorted by name). Children without
+	// the field are omitted.
+	//
+	// AggregateChildStatus is required when ChildStatusField is defined.
+	//
+	// +optional
+	AggregateChildStatus func(ctx context.Context, parent Type, values []interface{}) error
+
+	// OurChild is used when only some of the children of the reconciled resource should receive
+	// the propagated field. If not specified, all children match.
+	//
+	// +optional
+	OurChild func(resource Type, child ChildType) bool
+
+	// ListOptions allows custom options to be use when listing potential child resources.
+	//
+	// Defaults to filtering by the reconciled resource's namespace:
+	//     []client.ListOption{
+	//         client.InNamespace(resource.GetNamespace()),
+	//     }
+	//
+	// +optional
+	ListOptions func(ctx context.Context, resource Type) []client.ListOption
+
+	lazyInit sync.Once
+	childSet *ChildSetReconciler[Type, ChildType, ChildListType]
+}
+
+func (r *PropagateReconciler[T, CT, CLT]) init() {
+	r.lazyInit.Do(func() {
+		if internal.IsNil(r.ChildType) {
+			var nilCT CT
+			r.ChildType = newEmpty(nilCT).(CT)
+		}
+		if internal.IsNil(r.ChildListType) {
+			var nilCLT CLT
+			r.ChildListType = newEmptyList(nilCLT, r.ChildType).(CLT)
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("%sPropagateReconciler", typeName(r.ChildType))
+		}
+		r.childSet = &ChildSetReconciler[T, CT, CLT]{
+			Name:            r.Name,
+			ChildType:       r.ChildType,
+			ChildListType:   r.ChildListType,
+			DesiredChildren: r.desiredChildren,
+			ChildObjectManager: &propagateObjectManager[T, CT, CLT]{
+				UpdatingObjectManager: &UpdatingObjectManager[CT]{
+					Type:              r.ChildType,
+					MergeBeforeUpdate: r.mergeBeforeUpdate,
+				},
+				reconciler: r,
+			},
+			ReflectChildrenStatusOnParentWithError: r.reflectChildrenStatusOnParent,
+			OurChild:                               r.OurChild,
+			IdentifyChild: func(child CT) string {
+				return child.GetName()
+			},
+			ListOptions: r.ListOptions,
+		}
+	})
+}
+
+func (r *PropagateReconciler[T, CT, CLT]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	c := RetrieveConfigOrDie(ctx)
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("childType", gvk(c, r.ChildType))
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+
+	if err := r.childSet.Validate(ctx); err != nil {
+		return err
+	}
+
+	if err := validateChildSchemeRegistration(c.Scheme(), "PropagateReconciler", r.Name, r.ChildType, r.ChildListType); err != nil {
+		return err
+	}
+
+	// the child type is owned by the ChildSetReconciler that manages the children, owning it
+	// again would register a duplicate watch
+	if err := r.childSet.ChildObjectManager.SetupWithManager(ctx, mgr, bldr); err != nil {
+		return err
+	}
+
+	if r.Setup != nil {
+		if err := r.Setup(ctx, mgr, bldr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PropagateReconciler[T, CT, CLT]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// require SourceField
+	if len(r.SourceField) == 0 {
+		errs = append(errs, fmt.Errorf("PropagateReconciler %q must define SourceField", r.Name))
+	}
+
+	// require TargetField
+	if len(r.TargetField) == 0 {
+		errs = append(errs, fmt.Errorf("PropagateReconciler %q must define TargetField", r.Name))
+	}
+
+	// require ChildStatusField and AggregateChildStatus together
+	if len(r.ChildStatusField) != 0 && r.AggregateChildStatus == nil {
+		errs = append(errs, fmt.Errorf("PropagateReconciler %q must implement AggregateChildStatus when ChildStatusField is defined", r.Name))
+	}
+	if len(r.ChildStatusField) == 0 && r.AggregateChildStatus != nil {
+		errs = append(errs, fmt.Errorf("PropagateReconciler %q must define ChildStatusField when AggregateChildStatus is implemented", r.Name))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *PropagateReconciler[T, CT, CLT]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	c := RetrieveConfigOrDie(ctx)
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name).
+		WithValues("childType", gvk(c, r.ChildType))
+	ctx = logr.NewContext(ctx, log)
+
+	return r.childSet.Reconcile(ctx, resource)
+}
+
+// desiredChildren returns each known child with the source field of the resource copied to the
+// target field
+func (r *PropagateReconciler[T, CT, CLT]) desiredChildren(ctx context.Context, resource T) ([]CT, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+	if err != nil {
+		return nil, err
+	}
+	value, found, err := unstructured.NestedFieldCopy(content, r.SourceField...)
+	if err != nil {
+		return nil, err
+	}
+
+	children := RetrieveKnownChildren[CT](ctx)
+	for i := range children {
+		if children[i], err = r.propagate(children[i], value, found); err != nil {
+			return nil, err
+		}
+	}
+	return children, nil
+}
+
+// propagate returns a copy of the child with the value set on the target field. The target field
+// is removed when the value is not found.
+func (r *PropagateReconciler[T, CT, CLT]) propagate(child CT, value interface{}, found bool) (CT, error) {
+	var nilCT CT
+
+	// the content of an unstructured child is not copied by the converter
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(child.DeepCopyObject())
+	if err != nil {
+		return nilCT, err
+	}
+	if found {
+		if err := unstructured.SetNestedField(content, runtime.DeepCopyJSONValue(value), r.TargetField...); err != nil {
+			return nilCT, err
+		}
+	} else {
+		unstructured.RemoveNestedField(content, r.TargetField...)
+	}
+	propagated := r.ChildType.DeepCopyObject().(CT)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, propagated); err != nil {
+		return nilCT, err
+	}
+	return propagated, nil
+}
+
+// merge returns a copy of the current child with the target field of the desired child
+func (r *PropagateReconciler[T, CT, CLT]) merge(current, desired CT) (CT, error) {
+	var nilCT CT
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired.DeepCopyObject())
+	if err != nil {
+		return nilCT, err
+	}
+	value, found, err := unstructured.NestedFieldNoCopy(content, r.TargetField...)
+	if err != nil {
+		return nilCT, err
+	}
+	return r.propagate(current, value, found)
+}
+
+// mergeBeforeUpdate copies the target field of the desired child onto the current child. An
+// error merging the child is returned by propagateObjectManager before the merge is attempted,
+// the current child is left unchanged.
+func (r *PropagateReconciler[T, CT, CLT]) mergeBeforeUpdate(current, desired CT) {
+	merged, err := r.merge(current, desired)
+	if err != nil {
+		return
+	}
+	// update the current child in place
+	reflect.ValueOf(current).Elem().Set(reflect.ValueOf(merged).Elem())
+}
+
+// propagateObjectManager is an UpdatingObjectManager that returns the error merging the desired
+// child onto the actual child, which MergeBeforeUpdate is unable to return.
+type propagateObjectManager[T, CT client.Object, CLT client.ObjectList] struct {
+	*UpdatingObjectManager[CT]
+	reconciler *PropagateReconciler[T, CT, CLT]
+}
+
+func (m *propagateObjectManager[T, CT, CLT]) Manage(ctx context.Context, resource client.Object, actual, desired CT) (CT, error) {
+	if !internal.IsNil(actual) && !internal.IsNil(desired) {
+		if _, err := m.reconciler.merge(actual, desired); err != nil {
+			var nilCT CT
+			return nilCT, err
+		}
+	}
+	return m.UpdatingObjectManager.Manage(ctx, resource, actual, desired)
+}
+
+func (r *PropagateReconciler[T, CT, CLT]) reflectChildrenStatusOnParent(ctx context.Context, parent T, result ChildSetResult[CT]) error {
+	var errs []error
+	if r.AggregateChildStatus != nil {
+		values := []interface{}{}
+		for _, childResult := range result.Children {
+			if internal.IsNil(childResult.Child) {
+				continue
+			}
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(childResult.Child)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			value, found, err := unstructured.NestedFieldCopy(content, r.ChildStatusField...)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if found {
+				values = append(values, value)
+			}
+		}
+		errs = append(errs, r.AggregateChildStatus(ctx, parent, values))
+	}
+	errs = append(errs, result.AggregateError())
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPropagateReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	teamLabel := "example.com/team"
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	resourceBlue := resource.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddLabel(teamLabel, "blue")
+		})
+
+	configMapGiven := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.ControlledBy(resource, scheme)
+			d.CreationTimestamp(now)
+		})
+	configMap1Given := configMapGiven.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-1")
+			d.UID(types.UID("3b298fdb-b0b6-4603-9708-939e05daf183"))
+		}).
+		AddData("ready", "true")
+	configMap2Given := configMapGiven.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(testName + "-2")
+			d.UID(types.UID("a0e91ff9-bf42-4bc7-9253-2a6581b07e4d"))
+		}).
+		AddData("ready", "false")
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"no children": {
+			Resource: resourceBlue.DieReleasePtr(),
+		},
+		"propagates the source field to each child": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.DieReleasePtr(),
+				configMap2Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "red")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceBlue, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-1"),
+				rtesting.NewEvent(resourceBlue, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-2"),
+			},
+			ExpectUpdates: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+				configMap2Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"children in sync": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"removes the target field when the source field is not set": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "red")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-1"),
+			},
+			ExpectUpdates: []client.Object{
+				configMap1Given.DieReleasePtr(),
+			},
+		},
+		"ignores children not controlled by the resource": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"ignores children not matching OurChild": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.DieReleasePtr(),
+				configMap2Given.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"OurChild": func(resource *resources.TestResource, child *corev1.ConfigMap) bool {
+					return child.Name == testName+"-2"
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceBlue, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-2"),
+			},
+			ExpectUpdates: []client.Object{
+				configMap2Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+			},
+		},
+		"aggregates the child status field on the resource": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+				configMap2Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-3")
+						d.AddLabel(teamLabel, "blue")
+						d.UID(types.UID("62af4b9a-767a-4f32-b62c-e4bccbfa8ef0"))
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"ChildStatusField": []string{"data", "ready"},
+			},
+			ExpectResource: resourceBlue.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ready", "true,false")
+				}).
+				DieReleasePtr(),
+		},
+		"aggregate error": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"ChildStatusField": []string{"data", "ready"},
+				"AggregateError":   fmt.Errorf("aggregate error"),
+			},
+			ShouldErr: true,
+			ExpectResource: resourceBlue.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ready", "true")
+				}).
+				DieReleasePtr(),
+		},
+		"update error": {
+			Resource: resourceBlue.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap1Given.DieReleasePtr(),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap"),
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceBlue, scheme, corev1.EventTypeWarning, "UpdateFailed", `Failed to update ConfigMap %q: inducing failure for update ConfigMap`, testName+"-1"),
+			},
+			ExpectUpdates: []client.Object{
+				configMap1Given.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleasePtr(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		r := &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+			SourceField: []string{"metadata", "labels", teamLabel},
+			TargetField: []string{"metadata", "labels", teamLabel},
+		}
+		if ourChild, ok := rtc.Metadata["OurChild"]; ok {
+			r.OurChild = ourChild.(func(*resources.TestResource, *corev1.ConfigMap) bool)
+		}
+		if field, ok := rtc.Metadata["ChildStatusField"]; ok {
+			r.ChildStatusField = field.([]string)
+			r.AggregateChildStatus = func(ctx context.Context, parent *resources.TestResource, values []interface{}) error {
+				ready := []string{}
+				for _, value := range values {
+					ready = append(ready, value.(string))
+				}
+				if parent.Status.Fields == nil {
+					parent.Status.Fields = map[string]string{}
+				}
+				parent.Status.Fields["ready"] = strings.Join(ready, ",")
+				if err, ok := rtc.Metadata["AggregateError"]; ok {
+					return err.(error)
+				}
+				return nil
+			}
+		}
+		return r
+	})
+}
+
+func TestPropagateReconciler_Unstructured(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+	teamLabel := "example.com/team"
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.AddLabel(teamLabel, "blue")
+		})
+
+	configMapGiven := diecorev1.ConfigMapBlank.
+		APIVersion("v1").
+		Kind("ConfigMap").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName + "-1")
+			d.UID(types.UID("3b298fdb-b0b6-4603-9708-939e05daf183"))
+			d.ControlledBy(resource, scheme)
+			d.CreationTimestamp(now)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"propagates the source field to each child": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "red")
+					}).
+					DieReleaseUnstructured(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName+"-1"),
+			},
+			ExpectUpdates: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleaseUnstructured(),
+			},
+		},
+		"children in sync": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(teamLabel, "blue")
+					}).
+					DieReleaseUnstructured(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.PropagateReconciler[*resources.TestResource, *unstructured.Unstructured, *unstructured.UnstructuredList]{
+			ChildType: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+				},
+			},
+			SourceField: []string{"metadata", "labels", teamLabel},
+			TargetField: []string{"metadata", "labels", teamLabel},
+		}
+	})
+}
+
+func TestPropagateReconciler_SetupWithManager(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	expectConfig := rtesting.ExpectConfig{
+		Scheme: scheme,
+	}
+
+	ctx := context.Background()
+	ctx = reconcilers.StashConfig(ctx, expectConfig.Config())
+	ctx = reconcilers.StashResourceType(ctx, &resources.TestResource{})
+
+	childSet := &reconcilers.ChildSetReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+		DesiredChildren: func(ctx context.Context, resource *resources.TestResource) ([]*corev1.ConfigMap, error) {
+			return nil, nil
+		},
+		ChildObjectManager: &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+		ReflectChildrenStatusOnParent: func(ctx context.Context, parent *resources.TestResource, result reconcilers.ChildSetResult[*corev1.ConfigMap]) {
+		},
+		IdentifyChild: func(child *corev1.ConfigMap) string {
+			return child.Name
+		},
+	}
+	propagate := &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+		SourceField: []string{"metadata", "labels", "team"},
+		TargetField: []string{"metadata", "labels", "team"},
+	}
+
+	// the reconciled resource, and the owner and tracked watches of the ChildSetReconciler
+	recorder := startWatches(t, ctx, scheme, 3, func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+		if err := childSet.SetupWithManager(ctx, mgr, bldr); err != nil {
+			return err
+		}
+		return propagate.SetupWithManager(ctx, mgr, bldr)
+	})
+	// allow any additional watch to start
+	time.Sleep(100 * time.Millisecond)
+	if expected, actual := 3, len(recorder.Watched()); expected != actual {
+		t.Errorf("expected %d watches, got %d", expected, actual)
+	}
+}
+
+func TestPropagateReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				SourceField: []string{"metadata", "labels", "team"},
+				TargetField: []string{"metadata", "labels", "team"},
+			},
+		},
+		{
+			name: "valid with child status",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				SourceField:      []string{"metadata", "labels", "team"},
+				TargetField:      []string{"metadata", "labels", "team"},
+				ChildStatusField: []string{"data", "ready"},
+				AggregateChildStatus: func(ctx context.Context, parent *resources.TestResource, values []interface{}) error {
+					return nil
+				},
+			},
+		},
+		{
+			name: "missing source field",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				Name:        "missing source field",
+				TargetField: []string{"metadata", "labels", "team"},
+			},
+			shouldErr: `PropagateReconciler "missing source field" must define SourceField`,
+		},
+		{
+			name: "missing target field",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				Name:        "missing target field",
+				SourceField: []string{"metadata", "labels", "team"},
+			},
+			shouldErr: `PropagateReconciler "missing target field" must define TargetField`,
+		},
+		{
+			name: "child status field without aggregate",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				Name:             "child status field without aggregate",
+				SourceField:      []string{"metadata", "labels", "team"},
+				TargetField:      []string{"metadata", "labels", "team"},
+				ChildStatusField: []string{"data", "ready"},
+			},
+			shouldErr: `PropagateReconciler "child status field without aggregate" must implement AggregateChildStatus when ChildStatusField is defined`,
+		},
+		{
+			name: "aggregate without child status field",
+			reconciler: &reconcilers.PropagateReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+				Name:        "aggregate without child status field",
+				SourceField: []string{"metadata", "labels", "team"},
+				TargetField: []string{"metadata", "labels", "team"},
+				AggregateChildStatus: func(ctx context.Context, parent *resources.TestResource, values []interface{}) error {
+					return nil
+				},
+			},
+			shouldErr: `PropagateReconciler "aggregate without child status field" must define ChildStatusField when AggregateChildStatus is implemented`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"reconciler.io/runtime/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrReadOnly is returned for each mutating request made with a read-only client, see
+// Config.WithReadOnly.
+var ErrReadOnly = errors.New("mutating request not allowed with a read-only client")
+
+var _ SubReconciler[client.Object] = (*ReadOnly[client.Object])(nil)
+
+// ReadOnly guarantees the reconcilers nested under it perform no writes. The config's client is
+// swapped for a read-only client, see Config.WithReadOnly, which fails each mutating request with
+// ErrReadOnly. This is useful to separate a planning phase, which observes state, from an applying
+// phase, which mutates state.
+//
+// Only the config retrieved with `RetrieveConfig(ctx)` is read-only. Finalizers and the reconciled
+// resource's status are written with the original config.
+type ReadOnly[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `ReadOnly`.  Ideally unique, but
+	// not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	lazyInit sync.Once
+}
+
+func (r *ReadOnly[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "ReadOnly"
+		}
+	})
+}
+
+func (r *ReadOnly[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	ctx = StashConfig(ctx, RetrieveConfigOrDie(ctx).WithReadOnly())
+	return r.Reconciler.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *ReadOnly[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("ReadOnly %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("ReadOnly %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *ReadOnly[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	ctx = StashConfig(ctx, RetrieveConfigOrDie(ctx).WithReadOnly())
+	return r.Reconciler.Reconcile(ctx, resource)
+}
+
+// readOnlyClient fails each mutating request with ErrReadOnly, reads are delegated to the wrapped
+// client.
+type readOnlyClient struct {
+	client.Client
+}
+
+func (c *readOnlyClient) reject(verb string, obj runtime.Object) error {
+	kind := typeName(obj)
+	if gvk, err := c.GroupVersionKindFor(obj); err == nil {
+		kind = gvk.Kind
+	}
+	if o, ok := obj.(client.Object); ok && o.GetName() != "" {
+		return fmt.Errorf("%w: %s %s %s", ErrReadOnly, verb, kind, namespaceName(o))
+	}
+	return fmt.Errorf("%w: %s %s", ErrReadOnly, verb, kind)
+}
+
+func (c *readOnlyClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	return fmt.Errorf("%w: apply", ErrReadOnly)
+}
+
+func (c *readOnlyClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.reject("create", obj)
+}
+
+func (c *readOnlyClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.reject("delete", obj)
+}
+
+func (c *readOnlyClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.reject("update", obj)
+}
+
+func (c *readOnlyClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.reject("patch", obj)
+}
+
+func (c *readOnlyClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.reject("deletecollection", obj)
+}
+
+func (c *readOnlyClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *readOnlyClient) SubResource(subResource string) client.SubResourceClient {
+	return &readOnlySubResourceClient{
+		SubResourceClient: c.Client.SubResource(subResource),
+		client:            c,
+		subResource:       subResource,
+	}
+}
+
+// readOnlySubResourceClient fails each mutating subresource request with ErrReadOnly, reads are
+// delegated to the wrapped client.
+type readOnlySubResourceClient struct {
+	client.SubResourceClient
+	client      *readOnlyClient
+	subResource string
+}
+
+func (c *readOnlySubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return c.client.reject(fmt.Sprintf("create %s of", c.subResource), obj)
+}
+
+func (c *readOnlySubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return c.client.reject(fmt.Sprintf("update %s of", c.subResource), obj)
+}
+
+func (c *readOnlySubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return c.client.reject(fmt.Sprintf("patch %s of", c.subResource), obj)
+}
+
+func (c *readOnlySubResourceClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+	return fmt.Errorf("%w: apply %s", ErrReadOnly, c.subResource)
+}
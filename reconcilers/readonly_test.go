@@ -0,0 +1,167 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReadOnly(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	configMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"reads are allowed": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap,
+			},
+			Metadata: map[string]interface{}{
+				"Sync": func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					return c.Get(ctx, client.ObjectKeyFromObject(resource), &corev1.ConfigMap{})
+				},
+			},
+		},
+		"create is rejected": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Sync": func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					return c.Create(ctx, configMap.DieReleasePtr())
+				},
+			},
+			ShouldErr: true,
+			Verify:    rtesting.VerifyReadOnlyViolation(),
+		},
+		"delete is rejected": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMap,
+			},
+			Metadata: map[string]interface{}{
+				"Sync": func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					return c.Delete(ctx, configMap.DieReleasePtr())
+				},
+			},
+			ShouldErr: true,
+			Verify:    rtesting.VerifyReadOnlyViolation(),
+		},
+		"status update is rejected": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Sync": func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					return c.Status().Update(ctx, resource)
+				},
+			},
+			ShouldErr: true,
+			Verify:    rtesting.VerifyReadOnlyViolation(),
+		},
+		"original config is writable": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Sync": func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveOriginalConfigOrDie(ctx)
+					return c.Create(ctx, configMap.DieReleasePtr())
+				},
+			},
+			ExpectCreates: []client.Object{
+				configMap,
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.ReadOnly[*resources.TestResource]{
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				Sync: rtc.Metadata["Sync"].(func(context.Context, *resources.TestResource) error),
+			},
+		}
+	})
+}
+
+func TestReadOnly_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		reconciler     *reconcilers.ReadOnly[*corev1.ConfigMap]
+		validateNested bool
+		shouldErr      string
+	}{
+		{
+			name:       "empty",
+			reconciler: &reconcilers.ReadOnly[*corev1.ConfigMap]{},
+			shouldErr:  `ReadOnly "ReadOnly" must define Reconciler`,
+		},
+		{
+			name: "valid",
+			reconciler: &reconcilers.ReadOnly[*corev1.ConfigMap]{
+				Reconciler: &reconcilers.Sequence[*corev1.ConfigMap]{},
+			},
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.ReadOnly[*corev1.ConfigMap]{
+				Reconciler: &reconcilers.SyncReconciler[*corev1.ConfigMap]{},
+			},
+			validateNested: true,
+			shouldErr:      `ReadOnly "ReadOnly" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if c.validateNested {
+				ctx = validation.WithRecursive(ctx)
+			}
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -21,11 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/stash"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -62,7 +65,10 @@ const configStashKey stash.Key = "reconciler.io/runtime:config"
 const originalConfigStashKey stash.Key = "reconciler.io/runtime:originalConfig"
 const resourceTypeStashKey stash.Key = "reconciler.io/runtime:resourceType"
 const originalResourceTypeStashKey stash.Key = "reconciler.io/runtime:originalResourceType"
+const originalResourceStashKey stash.Key = "reconciler.io/runtime:originalResource"
 const additionalConfigsStashKey stash.Key = "reconciler.io/runtime:additionalConfigs"
+const clusterNameStashKey stash.Key = "reconciler.io/runtime:clusterName"
+const reconcilerNameStashKey stash.Key = "reconciler.io/runtime:reconcilerName"
 
 func StashRequest(ctx context.Context, req Request) context.Context {
 	return context.WithValue(ctx, requestStashKey, req)
@@ -77,6 +83,20 @@ func RetrieveRequest(ctx context.Context) Request {
 	return Request{}
 }
 
+func StashReconcilerName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, reconcilerNameStashKey, name)
+}
+
+// RetrieveReconcilerName returns the name of the top-level reconciler handling the request, like
+// the ResourceReconciler's Name, or empty if not found.
+func RetrieveReconcilerName(ctx context.Context) string {
+	value := ctx.Value(reconcilerNameStashKey)
+	if name, ok := value.(string); ok {
+		return name
+	}
+	return ""
+}
+
 func StashConfig(ctx context.Context, config Config) context.Context {
 	return context.WithValue(ctx, configStashKey, config)
 }
@@ -149,6 +169,22 @@ func RetrieveOriginalResourceType(ctx context.Context) client.Object {
 	return nil
 }
 
+func StashOriginalResource(ctx context.Context, resource client.Object) context.Context {
+	return context.WithValue(ctx, originalResourceStashKey, resource)
+}
+
+// RetrieveOriginalResource returns a copy of the reconciled resource as it was loaded from the
+// API Server, before any mutation by the reconciler. The zero value is returned if not found, or
+// if the stashed resource is not of the requested type.
+func RetrieveOriginalResource[T client.Object](ctx context.Context) T {
+	value := ctx.Value(originalResourceStashKey)
+	if resource, ok := value.(T); ok {
+		return resource.DeepCopyObject().(T)
+	}
+	var nilT T
+	return nilT
+}
+
 func StashAdditionalConfigs(ctx context.Context, additionalConfigs map[string]Config) context.Context {
 	return context.WithValue(ctx, additionalConfigsStashKey, additionalConfigs)
 }
@@ -163,6 +199,21 @@ func RetrieveAdditionalConfigs(ctx context.Context) map[string]Config {
 	return map[string]Config{}
 }
 
+func StashClusterName(ctx context.Context, cluster string) context.Context {
+	return context.WithValue(ctx, clusterNameStashKey, cluster)
+}
+
+// RetrieveClusterName returns the name of the cluster targeted by the active config, as set by
+// WithClusterConfig. An empty string is returned when the active config targets the cluster the
+// reconciled resource was loaded from.
+func RetrieveClusterName(ctx context.Context) string {
+	value := ctx.Value(clusterNameStashKey)
+	if cluster, ok := value.(string); ok {
+		return cluster
+	}
+	return ""
+}
+
 func typeName(i interface{}) string {
 	if obj, ok := i.(client.Object); ok {
 		kind := obj.GetObjectKind().GroupVersionKind().Kind
@@ -186,6 +237,28 @@ func gvk(c client.Client, obj runtime.Object) schema.GroupVersionKind {
 	return gvk
 }
 
+// isRegisteredInScheme returns false when the type of the object is not registered in the scheme.
+// Duck typed and unstructured resources are not required to be registered.
+func isRegisteredInScheme(scheme *runtime.Scheme, obj runtime.Object) bool {
+	if _, _, err := scheme.ObjectKinds(obj); runtime.IsNotRegisteredError(err) {
+		return duck.IsDuck(obj, scheme)
+	}
+	return true
+}
+
+// validateChildSchemeRegistration ensures the child types are registered in the scheme before they
+// are watched, rather than failing deep within controller-runtime.
+func validateChildSchemeRegistration(scheme *runtime.Scheme, reconciler, name string, childType client.Object, childListType client.ObjectList) error {
+	if !isRegisteredInScheme(scheme, childType) {
+		return fmt.Errorf("%s %q ChildType %s must be registered in the scheme", reconciler, name, typeName(childType))
+	}
+	// the list type of a duck typed child is not registered
+	if !duck.IsDuck(childType, scheme) && !isRegisteredInScheme(scheme, childListType) {
+		return fmt.Errorf("%s %q ChildListType %s must be registered in the scheme", reconciler, name, typeName(childListType))
+	}
+	return nil
+}
+
 func namespaceName(obj client.Object) types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: obj.GetNamespace(),
@@ -208,6 +281,33 @@ func AggregateResults(results ...Result) Result {
 	return aggregate
 }
 
+// WithMinRequeue clamps the result to requeue no sooner than the duration. A result requesting an
+// immediate requeue (Requeue is true) is requeued after the duration instead. A result that does
+// not requeue, or a non-positive duration, is returned unchanged.
+func WithMinRequeue(result Result, d time.Duration) Result {
+	if d <= 0 || (!result.Requeue && result.RequeueAfter == 0) {
+		return result
+	}
+	if result.Requeue || result.RequeueAfter < d {
+		return Result{RequeueAfter: d}
+	}
+	return result
+}
+
+// WithMaxRequeue clamps the result to requeue no later than the duration. A result that does not
+// requeue is requeued after the duration. A result requesting an immediate requeue (Requeue is
+// true) takes precedence over RequeueAfter and is returned unchanged, as is any result for a
+// non-positive duration.
+func WithMaxRequeue(result Result, d time.Duration) Result {
+	if d <= 0 || result.Requeue {
+		return result
+	}
+	if result.RequeueAfter == 0 || result.RequeueAfter > d {
+		return Result{RequeueAfter: d}
+	}
+	return result
+}
+
 // MergeMaps flattens a sequence of maps into a single map. Keys in latter maps
 // overwrite previous keys. None of the arguments are mutated.
 func MergeMaps(maps ...map[string]string) map[string]string {
@@ -256,3 +356,28 @@ func newEmpty(x interface{}) interface{} {
 	t := reflect.TypeOf(x).Elem()
 	return reflect.New(t).Interface()
 }
+
+// newEmptyList returns a new empty list of the same underlying type as x. An unstructured list is
+// given the list kind for the apiVersion and kind of the unstructured item type, since the kind
+// can not be inferred from the Go type.
+func newEmptyList(x interface{}, itemType client.Object) interface{} {
+	list := newEmpty(x)
+	if u, ok := list.(*unstructured.UnstructuredList); ok {
+		if gvk := itemType.GetObjectKind().GroupVersionKind(); gvk.Kind != "" {
+			u.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		}
+	}
+	return list
+}
+
+// validateUnstructuredKind returns an error when the object is unstructured without an apiVersion
+// and kind, which the client requires to operate on the object.
+func validateUnstructuredKind(reconciler, name, field string, obj runtime.Object) error {
+	if _, ok := obj.(runtime.Unstructured); !ok {
+		return nil
+	}
+	if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Version == "" || gvk.Kind == "" {
+		return fmt.Errorf("%s %q must define %s with an apiVersion and kind for unstructured types", reconciler, name, field)
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ package reconcilers_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"reconciler.io/runtime/reconcilers"
 )
@@ -28,3 +29,95 @@ func TestErrHaltSubReconcilers(t *testing.T) {
 		t.Errorf("ErrHaltSubReconcilers should be ErrQuiet")
 	}
 }
+
+func TestWithMinRequeue(t *testing.T) {
+	tests := map[string]struct {
+		result   reconcilers.Result
+		d        time.Duration
+		expected reconcilers.Result
+	}{
+		"no requeue": {
+			result:   reconcilers.Result{},
+			d:        time.Minute,
+			expected: reconcilers.Result{},
+		},
+		"requeue after sooner": {
+			result:   reconcilers.Result{RequeueAfter: time.Second},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"requeue after later": {
+			result:   reconcilers.Result{RequeueAfter: time.Hour},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"requeue immediately": {
+			result:   reconcilers.Result{Requeue: true},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"requeue immediately overrides requeue after": {
+			result:   reconcilers.Result{Requeue: true, RequeueAfter: time.Hour},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"zero duration": {
+			result:   reconcilers.Result{Requeue: true},
+			d:        0,
+			expected: reconcilers.Result{Requeue: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := reconcilers.WithMinRequeue(tc.result, tc.d); actual != tc.expected {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithMaxRequeue(t *testing.T) {
+	tests := map[string]struct {
+		result   reconcilers.Result
+		d        time.Duration
+		expected reconcilers.Result
+	}{
+		"no requeue": {
+			result:   reconcilers.Result{},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"requeue after sooner": {
+			result:   reconcilers.Result{RequeueAfter: time.Second},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Second},
+		},
+		"requeue after later": {
+			result:   reconcilers.Result{RequeueAfter: time.Hour},
+			d:        time.Minute,
+			expected: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"requeue immediately": {
+			result:   reconcilers.Result{Requeue: true},
+			d:        time.Minute,
+			expected: reconcilers.Result{Requeue: true},
+		},
+		"requeue immediately overrides requeue after": {
+			result:   reconcilers.Result{Requeue: true, RequeueAfter: time.Hour},
+			d:        time.Minute,
+			expected: reconcilers.Result{Requeue: true, RequeueAfter: time.Hour},
+		},
+		"zero duration": {
+			result:   reconcilers.Result{},
+			d:        0,
+			expected: reconcilers.Result{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := reconcilers.WithMaxRequeue(tc.result, tc.d); actual != tc.expected {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
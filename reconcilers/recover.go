@@ -0,0 +1,155 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/runtime/validation"
+)
+
+var _ SubReconciler[client.Object] = (*RecoverReconciler[client.Object])(nil)
+
+// PanicError is returned by a RecoverReconciler when the nested reconciler panics.
+type PanicError struct {
+	// Value is the value the nested reconciler panicked with
+	Value interface{}
+	// Stack is the stack trace of the goroutine at the time of the panic
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", e.Value)
+}
+
+// Unwrap returns the value the nested reconciler panicked with, when the value is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// RecoverReconciler recovers from a panic in the nested reconciler. The stack trace is logged, a
+// Warning event is recorded for the reconciled resource and a PanicError is returned, the request
+// is then requeued instead of crashing the process.
+//
+// A panic typically indicates a bug or a misconfigured reconciler. Recovery is opt-in, reconcilers
+// that are not wrapped continue to fail fast.
+type RecoverReconciler[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `RecoverReconciler`.  Ideally unique, but
+	// not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	lazyInit sync.Once
+}
+
+func (r *RecoverReconciler[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "RecoverReconciler"
+		}
+	})
+}
+
+func (r *RecoverReconciler[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	if err := r.Reconciler.SetupWithManager(ctx, mgr, bldr); err != nil {
+		return err
+	}
+	if r.Setup == nil {
+		return nil
+	}
+	return r.Setup(ctx, mgr, bldr)
+}
+
+func (r *RecoverReconciler[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("RecoverReconciler %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("RecoverReconciler %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *RecoverReconciler[T]) Reconcile(ctx context.Context, resource T) (result Result, err error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	defer func() {
+		value := recover()
+		if value == nil {
+			return
+		}
+		panicErr := &PanicError{
+			Value: value,
+			Stack: debug.Stack(),
+		}
+		log.Error(panicErr, "reconciler panicked", "stack", string(panicErr.Stack))
+		pc := RetrieveOriginalConfigOrDie(ctx)
+		pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "ReconcilePanic",
+			"Recovered from panic: %v", value)
+		result, err = Result{}, panicErr
+	}()
+
+	return r.Reconciler.Reconcile(ctx, resource)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+)
+
+func TestRecoverReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	panicErr := fmt.Errorf("panic error")
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"passes through the result": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Result": reconcilers.Result{RequeueAfter: time.Minute},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"passes through the error": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Err": fmt.Errorf("reconcile error"),
+			},
+			ShouldErr: true,
+		},
+		"recovers from a panic": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Panic": "boom",
+			},
+			ShouldRecoverPanic: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcilePanic", "Recovered from panic: boom"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				var recovered *reconcilers.PanicError
+				if !errors.As(err, &recovered) {
+					t.Fatalf("expected PanicError, got %v", err)
+				}
+				if recovered.Value != "boom" {
+					t.Errorf("expected panic value %q, got %v", "boom", recovered.Value)
+				}
+				if len(recovered.Stack) == 0 {
+					t.Errorf("expected stack to be captured")
+				}
+			},
+		},
+		"attributes the recovered panic event to the reconciler": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Panic": "boom",
+			},
+			ShouldRecoverPanic: true,
+			ShouldErr:          true,
+			ExpectEventsMatch: []rtesting.EventMatcher{
+				{Type: corev1.EventTypeWarning, Reason: "ReconcilePanic", Source: "RecoverReconciler"},
+			},
+		},
+		"recovers from a panic with an error": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Panic": panicErr,
+			},
+			ShouldRecoverPanic: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcilePanic", "Recovered from panic: panic error"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				if !errors.Is(err, panicErr) {
+					t.Errorf("expected error to wrap the panic value, got %v", err)
+				}
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.RecoverReconciler[*resources.TestResource]{
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+					if p, ok := rtc.Metadata["Panic"]; ok {
+						panic(p)
+					}
+					var result reconcilers.Result
+					if r, ok := rtc.Metadata["Result"]; ok {
+						result = r.(reconcilers.Result)
+					}
+					var err error
+					if e, ok := rtc.Metadata["Err"]; ok {
+						err = e.(error)
+					}
+					return result, err
+				},
+			},
+		}
+	})
+}
+
+func TestRecoverReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.RecoverReconciler[*resources.TestResource]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.RecoverReconciler[*resources.TestResource]{
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.RecoverReconciler[*resources.TestResource]{
+				Name: "missing reconciler",
+			},
+			shouldErr: `RecoverReconciler "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.RecoverReconciler[*resources.TestResource]{
+				Name:       "invalid reconciler",
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{},
+			},
+			shouldErr: `RecoverReconciler "invalid reconciler" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DataHashAnnotation holds the hash of the data rendered into a child by a RenderReconciler.
+// Copying the value into a pod template annotation triggers a rollout when the data changes.
+const DataHashAnnotation = "reconciler.io/data-hash"
+
+var (
+	_ SubReconciler[client.Object] = (*RenderReconciler[client.Object, *corev1.ConfigMap])(nil)
+)
+
+// RenderChildType is a child resource the RenderReconciler renders data into
+type RenderChildType interface {
+	*corev1.ConfigMap | *corev1.Secret
+	client.Object
+}
+
+// RenderReconciler is a sub reconciler that renders data from the reconciled resource into a child
+// ConfigMap or Secret. The child is created, updated and deleted by a ChildReconciler.
+//
+// The hash of the rendered data is set on the child as the DataHashAnnotation. Secret data is
+// hashed as well, so the annotation should not be used for low entropy secrets that are
+// vulnerable to a brute force search.
+type RenderReconciler[Type client.Object, ChildType RenderChildType] struct {
+	// Name used to identify this reconciler.  Defaults to `{ChildType}RenderReconciler`.  Ideally
+	// unique, but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// ChildName returns the name of the child for the reconciled resource. The child is created in
+	// the namespace of the reconciled resource.
+	//
+	// Defaults to the name of the reconciled resource.
+	//
+	// +optional
+	ChildName func(resource Type) string
+
+	// Render returns the data for the child, or nil if the child should not exist. An empty map
+	// results in a child without data.
+	//
+	// To skip reconciliation of the child resource while still reflecting an existing child's
+	// status on the reconciled resource, return OnlyReconcileChildStatus as an error.
+	Render func(ctx context.Context, resource Type) (map[string]string, error)
+
+	// Immutable when true marks the child as immutable. The API Server rejects changes to the
+	// data of an immutable child, the child is instead deleted and recreated with the rendered
+	// data.
+	//
+	// +optional
+	Immutable bool
+
+	// ReflectChildStatusOnParent updates the reconciled resource's status with values from the
+	// child, like the DataHashAnnotation. See ChildReconciler#ReflectChildStatusOnParent.
+	ReflectChildStatusOnParent func(ctx context.Context, parent Type, child ChildType, err error)
+
+	lazyInit        sync.Once
+	childReconciler *ChildReconciler[Type, ChildType, client.ObjectList]
+}
+
+func (r *RenderReconciler[T, CT]) init() {
+	r.lazyInit.Do(func() {
+		var nilCT CT
+		childType := newEmpty(nilCT).(CT)
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("%sRenderReconciler", typeName(childType))
+		}
+		if r.ChildName == nil {
+			r.ChildName = func(resource T) string {
+				return resource.GetName()
+			}
+		}
+		var childListType client.ObjectList
+		switch client.Object(childType).(type) {
+		case *corev1.ConfigMap:
+			childListType = &corev1.ConfigMapList{}
+		case *corev1.Secret:
+			childListType = &corev1.SecretList{}
+		}
+		r.childReconciler = &ChildReconciler[T, CT, client.ObjectList]{
+			Name:          r.Name,
+			ChildType:     childType,
+			ChildListType: childListType,
+			DesiredChild:  r.desiredChild,
+			ChildObjectManager: &UpdatingObjectManager[CT]{
+				Type:                     childType,
+				MergeBeforeUpdate:        r.mergeBeforeUpdate,
+				RecreateOnImmutableError: r.Immutable,
+			},
+			ReflectChildStatusOnParent: r.ReflectChildStatusOnParent,
+			OurChild: func(resource T, child CT) bool {
+				return child.GetName() == r.ChildName(resource)
+			},
+		}
+	})
+}
+
+func (r *RenderReconciler[T, CT]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+
+	if r.Setup != nil {
+		if err := r.Setup(ctx, mgr, bldr); err != nil {
+			return err
+		}
+	}
+
+	return r.childReconciler.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *RenderReconciler[T, CT]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// require Render
+	if r.Render == nil {
+		errs = append(errs, fmt.Errorf("RenderReconciler %q must implement Render", r.Name))
+	}
+
+	// require ReflectChildStatusOnParent
+	if r.ReflectChildStatusOnParent == nil {
+		errs = append(errs, fmt.Errorf("RenderReconciler %q must implement ReflectChildStatusOnParent", r.Name))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *RenderReconciler[T, CT]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	return r.childReconciler.Reconcile(ctx, resource)
+}
+
+// desiredChild returns the child with the rendered data and the hash of the data
+func (r *RenderReconciler[T, CT]) desiredChild(ctx context.Context, resource T) (CT, error) {
+	var nilCT CT
+
+	data, err := r.Render(ctx, resource)
+	if data == nil {
+		return nilCT, err
+	}
+	hash, hashErr := renderDataHash(data)
+	if hashErr != nil {
+		return nilCT, hashErr
+	}
+
+	meta := metav1.ObjectMeta{
+		Namespace: resource.GetNamespace(),
+		Name:      r.ChildName(resource),
+		Annotations: map[string]string{
+			DataHashAnnotation: hash,
+		},
+	}
+	var immutable *bool
+	if r.Immutable {
+		immutable = ptr.To(true)
+	}
+
+	var child client.Object
+	switch client.Object(nilCT).(type) {
+	case *corev1.ConfigMap:
+		child = &corev1.ConfigMap{
+			ObjectMeta: meta,
+			Immutable:  immutable,
+			Data:       data,
+		}
+	case *corev1.Secret:
+		secretData := make(map[string][]byte, len(data))
+		for k, v := range data {
+			secretData[k] = []byte(v)
+		}
+		child = &corev1.Secret{
+			ObjectMeta: meta,
+			Immutable:  immutable,
+			Type:       corev1.SecretTypeOpaque,
+			Data:       secretData,
+		}
+	}
+	return child.(CT), err
+}
+
+// mergeBeforeUpdate copies the rendered data and the hash of the data onto the current child
+func (r *RenderReconciler[T, CT]) mergeBeforeUpdate(current, desired CT) {
+	annotations := current.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[DataHashAnnotation] = desired.GetAnnotations()[DataHashAnnotation]
+	current.SetAnnotations(annotations)
+
+	switch c := client.Object(current).(type) {
+	case *corev1.ConfigMap:
+		d := client.Object(desired).(*corev1.ConfigMap)
+		c.Immutable = d.Immutable
+		c.Data = d.Data
+	case *corev1.Secret:
+		d := client.Object(desired).(*corev1.Secret)
+		c.Immutable = d.Immutable
+		c.Data = d.Data
+	}
+}
+
+// renderDataHash returns the hex encoded sha256 hash of the data
+func renderDataHash(data map[string]string) (string, error) {
+	// map keys are sorted when encoded
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRenderReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	hash := func(data map[string]string) string {
+		b, _ := json.Marshal(data)
+		sum := sha256.Sum256(b)
+		return hex.EncodeToString(sum[:])
+	}
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.CreationTimestamp(now)
+		}).
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("foo", "bar")
+		})
+	resourceUpdated := resource.
+		SpecDie(func(d *dies.TestResourceSpecDie) {
+			d.AddField("foo", "baz")
+		})
+
+	configMapCreate := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.ControlledBy(resource, scheme)
+			d.AddAnnotation(reconcilers.DataHashAnnotation, hash(map[string]string{"foo": "bar"}))
+		}).
+		AddData("foo", "bar")
+	configMapGiven := configMapCreate.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.CreationTimestamp(now)
+		})
+	configMapUpdated := configMapGiven.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(reconcilers.DataHashAnnotation, hash(map[string]string{"foo": "baz"}))
+		}).
+		AddData("foo", "baz")
+
+	immutableErr := apierrs.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, testName, field.ErrorList{
+		field.Forbidden(field.NewPath("data"), "field is immutable when `immutable` is set"),
+	})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"creates the child": {
+			Resource: resource.DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName),
+			},
+			ExpectCreates: []client.Object{
+				configMapCreate.DieReleasePtr(),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "bar"}))
+				}).
+				DieReleasePtr(),
+		},
+		"child in sync": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.DieReleasePtr(),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "bar"}))
+				}).
+				DieReleasePtr(),
+		},
+		"updates the child when the rendered data changes": {
+			Resource: resourceUpdated.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.DieReleasePtr(),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceUpdated, scheme, corev1.EventTypeNormal, "Updated", `Updated ConfigMap %q`, testName),
+			},
+			ExpectUpdates: []client.Object{
+				configMapUpdated.DieReleasePtr(),
+			},
+			ExpectResource: resourceUpdated.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "baz"}))
+				}).
+				DieReleasePtr(),
+		},
+		"deletes the child when nothing is rendered": {
+			Resource: resource.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"Render": func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+					return nil, nil
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGiven, scheme),
+			},
+			ExpectResource: resource.DieReleasePtr(),
+		},
+		"uses the child name": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"ChildName": func(resource *resources.TestResource) string {
+					return resource.Name + "-config"
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName+"-config"),
+			},
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(testName + "-config")
+					}).
+					DieReleasePtr(),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "bar"}))
+				}).
+				DieReleasePtr(),
+		},
+		"creates an immutable child": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Immutable": true,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName),
+			},
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					Immutable(ptr.To(true)).
+					DieReleasePtr(),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "bar"}))
+				}).
+				DieReleasePtr(),
+		},
+		"recreates an immutable child when the rendered data changes": {
+			Resource: resourceUpdated.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				configMapGiven.
+					Immutable(ptr.To(true)).
+					DieReleasePtr(),
+			},
+			Metadata: map[string]interface{}{
+				"Immutable": true,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "ConfigMap", rtesting.InduceFailureOpts{
+					Error: immutableErr,
+				}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resourceUpdated, scheme, corev1.EventTypeNormal, "Deleted", `Deleted ConfigMap %q`, testName),
+				rtesting.NewEvent(resourceUpdated, scheme, corev1.EventTypeNormal, "Created", `Created ConfigMap %q`, testName),
+			},
+			ExpectUpdates: []client.Object{
+				configMapUpdated.
+					Immutable(ptr.To(true)).
+					DieReleasePtr(),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(configMapGiven, scheme),
+			},
+			ExpectCreates: []client.Object{
+				configMapCreate.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.DataHashAnnotation, hash(map[string]string{"foo": "baz"}))
+					}).
+					Immutable(ptr.To(true)).
+					AddData("foo", "baz").
+					DieReleasePtr(),
+			},
+			ExpectResource: resourceUpdated.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hash", hash(map[string]string{"foo": "baz"}))
+				}).
+				DieReleasePtr(),
+		},
+		"render error": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Render": func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+					return nil, fmt.Errorf("render error")
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		r := &reconcilers.RenderReconciler[*resources.TestResource, *corev1.ConfigMap]{
+			Render: func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+				return resource.Spec.Fields, nil
+			},
+			ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {
+				if child == nil {
+					return
+				}
+				if parent.Status.Fields == nil {
+					parent.Status.Fields = map[string]string{}
+				}
+				parent.Status.Fields["hash"] = child.Annotations[reconcilers.DataHashAnnotation]
+			},
+		}
+		if render, ok := rtc.Metadata["Render"]; ok {
+			r.Render = render.(func(context.Context, *resources.TestResource) (map[string]string, error))
+		}
+		if childName, ok := rtc.Metadata["ChildName"]; ok {
+			r.ChildName = childName.(func(*resources.TestResource) string)
+		}
+		if immutable, ok := rtc.Metadata["Immutable"]; ok {
+			r.Immutable = immutable.(bool)
+		}
+		return r
+	})
+}
+
+func TestRenderReconciler_Secret(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	data := map[string]string{"password": "hunter2"}
+	b, _ := json.Marshal(data)
+	sum := sha256.Sum256(b)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"creates the child": {
+			Resource: resource.DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "Created", `Created Secret %q`, testName),
+			},
+			ExpectCreates: []client.Object{
+				diecorev1.SecretBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Namespace(testNamespace)
+						d.Name(testName)
+						d.ControlledBy(resource, scheme)
+						d.AddAnnotation(reconcilers.DataHashAnnotation, hex.EncodeToString(sum[:]))
+					}).
+					Type(corev1.SecretTypeOpaque).
+					Data(map[string][]byte{"password": []byte("hunter2")}).
+					DieReleasePtr(),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.RenderReconciler[*resources.TestResource, *corev1.Secret]{
+			Render: func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+				return data, nil
+			},
+			ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.Secret, err error) {},
+		}
+	})
+}
+
+func TestRenderReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.RenderReconciler[*resources.TestResource, *corev1.ConfigMap]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.RenderReconciler[*resources.TestResource, *corev1.ConfigMap]{
+				Render: func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+					return nil, nil
+				},
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {},
+			},
+		},
+		{
+			name: "missing render",
+			reconciler: &reconcilers.RenderReconciler[*resources.TestResource, *corev1.ConfigMap]{
+				Name:                       "missing render",
+				ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {},
+			},
+			shouldErr: `RenderReconciler "missing render" must implement Render`,
+		},
+		{
+			name: "missing reflect child status on parent",
+			reconciler: &reconcilers.RenderReconciler[*resources.TestResource, *corev1.ConfigMap]{
+				Name: "missing reflect child status on parent",
+				Render: func(ctx context.Context, resource *resources.TestResource) (map[string]string, error) {
+					return nil, nil
+				},
+			},
+			shouldErr: `RenderReconciler "missing reflect child status on parent" must implement ReflectChildStatusOnParent`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
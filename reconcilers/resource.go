@@ -18,12 +18,14 @@ package reconcilers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"sync"
 	"time"
 
+	jsonmergepatch "github.com/evanphx/json-patch/v5"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/internal"
 	"reconciler.io/runtime/stash"
@@ -56,6 +61,18 @@ var _ reconcile.Reconciler = (*ResourceReconciler[client.Object])(nil)
 //	errors.Join(ErrSkipStatusUpdate, ErrQuiet)
 var ErrSkipStatusUpdate = errors.New("skip ResourceReconciler status update for this request")
 
+const (
+	// PausedAnnotation is the conventional annotation key to use as a ResourceReconciler's
+	// PauseAnnotation.
+	PausedAnnotation = "reconciler.io/paused"
+	// ConditionSuspended is marked True on the status of a resource whose reconciliation is
+	// paused by the ResourceReconciler's PauseAnnotation.
+	ConditionSuspended = "Suspended"
+	// SuspendedPausedReason is the reason of the ConditionSuspended condition for a paused
+	// resource.
+	SuspendedPausedReason = "Paused"
+)
+
 // ResourceReconciler is a controller-runtime reconciler that reconciles a given
 // existing resource. The Type resource is fetched for the reconciler
 // request and passed in turn to each SubReconciler. Finally, the reconciled
@@ -93,6 +110,24 @@ type ResourceReconciler[Type client.Object] struct {
 	// when the resource is marked for deletion.
 	SyncStatusDuringFinalization bool
 
+	// AlwaysUpdateStatus when true, the resource's status is updated for each reconcile request,
+	// even when the status is unchanged. By default, the status update is skipped when the
+	// reconciled status is semantically equal to the stored status. The LastTransitionTime of a
+	// condition is restored from the stored condition when the condition is otherwise unchanged,
+	// so conditions that are re-marked with the same values do not cause an update.
+	//
+	// Updating an unchanged status does not trigger a new reconcile request, the reconcile result
+	// is returned as if the status was not updated.
+	//
+	// +optional
+	AlwaysUpdateStatus bool
+
+	// StampConditionObservedGeneration when true, each condition set on the resource's status
+	// records the generation of the resource it reflects as its ObservedGeneration. Conditions
+	// must be managed by a ConditionSet with the context passed to the sub reconcilers, see
+	// apis.WithConditionObservedGeneration.
+	StampConditionObservedGeneration bool
+
 	// Reconciler is called for each reconciler request with the resource being reconciled.
 	// Typically, Reconciler is a Sequence of multiple SubReconcilers.
 	//
@@ -116,6 +151,26 @@ type ResourceReconciler[Type client.Object] struct {
 	// +optional
 	AfterReconcile func(ctx context.Context, req Request, res Result, err error) (Result, error)
 
+	// BeforeReconcileResource is called with the fetched resource before the Reconciler, after
+	// the resource's defaults are applied and before the status conditions are initialized. It is
+	// intended to normalize the resource, like migrating deprecated fields, before the sub
+	// reconcilers run. Mutations to the status are persisted with the status update.
+	//
+	// Errors skip the Reconciler and are passed to AfterReconcileResource.
+	//
+	// If BeforeReconcileResource is not defined, there is no effect.
+	//
+	// +optional
+	BeforeReconcileResource func(ctx context.Context, resource Type) error
+
+	// AfterReconcileResource is called with the resource following the Reconciler, before the
+	// resource's status is updated. The result and error are provided and may be modified.
+	//
+	// If AfterReconcileResource is not defined, the result and error are returned directly.
+	//
+	// +optional
+	AfterReconcileResource func(ctx context.Context, resource Type, result Result, err error) (Result, error)
+
 	// SkipResource shortcuts the reconciler for the specific request. While the context and logger
 	// are initialized, no work is preformed. The request is removed from the workqueue.
 	//
@@ -126,11 +181,27 @@ type ResourceReconciler[Type client.Object] struct {
 	// called and the resource's status is not updated.
 	//
 	// BeforeReconcile and AfterReconcile are called, and the resource's defaults are applied
-	// before calling this method.
+	// before calling this method. BeforeReconcileResource and AfterReconcileResource are not called.
 	//
 	// +optional
 	SkipResource func(ctx context.Context, resource Type) bool
 
+	// PauseAnnotation is the annotation key that suspends reconciliation of a resource when its
+	// value is "true", like PausedAnnotation. The Reconciler is not called for a paused resource,
+	// and the ConditionSuspended condition is marked True on the resource's status. The condition
+	// is removed once the annotation is removed, or set to any other value. The conditions of an
+	// unstructured resource are managed at status.conditions.
+	//
+	// A paused resource that is being deleted is reconciled, so the Reconciler is able to release
+	// its finalizers.
+	//
+	// BeforeReconcileResource and AfterReconcileResource are called for paused resources.
+	//
+	// If PauseAnnotation is not defined, resources are never paused.
+	//
+	// +optional
+	PauseAnnotation string
+
 	Config Config
 
 	lazyInit sync.Once
@@ -155,6 +226,16 @@ func (r *ResourceReconciler[T]) init() {
 				return res, err
 			}
 		}
+		if r.BeforeReconcileResource == nil {
+			r.BeforeReconcileResource = func(ctx context.Context, resource T) error {
+				return nil
+			}
+		}
+		if r.AfterReconcileResource == nil {
+			r.AfterReconcileResource = func(ctx context.Context, resource T, result Result, err error) (Result, error) {
+				return result, err
+			}
+		}
 		if r.SkipRequest == nil {
 			r.SkipRequest = func(ctx context.Context, req reconcile.Request) bool {
 				return false
@@ -227,22 +308,43 @@ func (r *ResourceReconciler[T]) Validate(ctx context.Context) error {
 
 	ctx = r.withContext(ctx)
 
+	errs := []error{}
+
 	// validate Reconciler value
 	if r.Reconciler == nil {
-		return fmt.Errorf("ResourceReconciler %q must define Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("ResourceReconciler %q must define Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("ResourceReconciler %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("ResourceReconciler %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
+	// require the kind of an unstructured resource
+	if err := validateUnstructuredKind("ResourceReconciler", r.Name, "Type", r.Type); err != nil {
+		errs = append(errs, err)
+	}
+
+	// require a single status update mode
+	if r.SkipStatusUpdate && r.AlwaysUpdateStatus {
+		errs = append(errs, fmt.Errorf("ResourceReconciler %q must not define both SkipStatusUpdate and AlwaysUpdateStatus", r.Name))
+	}
+
+	if len(errs) != 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
 	// warn users of common pitfalls. These are not blockers.
 
 	log := logr.FromContextOrDiscard(ctx)
 
+	if _, ok := runtime.Object(r.Type).(runtime.Unstructured); ok {
+		// unstructured resources have no fields to inspect
+		return nil
+	}
+
 	resourceType := reflect.TypeOf(r.Type).Elem()
 	statusField, hasStatus := resourceType.FieldByName("Status")
 	if !hasStatus {
@@ -290,6 +392,7 @@ func (r *ResourceReconciler[T]) Reconcile(ctx context.Context, req Request) (Res
 
 	ctx = rtime.StashNow(ctx, time.Now())
 	ctx = StashRequest(ctx, req)
+	ctx = StashReconcilerName(ctx, r.Name)
 	ctx = r.withContext(ctx)
 
 	if r.SkipRequest(ctx, req) {
@@ -333,6 +436,7 @@ func (r *ResourceReconciler[T]) reconcileOuter(ctx context.Context, req Request)
 
 		return Result{}, err
 	}
+	ctx = StashOriginalResource(ctx, originalResource)
 	resource := originalResource.DeepCopyObject().(T)
 
 	if defaulter, ok := client.Object(resource).(validation.Defaulter); ok {
@@ -359,8 +463,25 @@ func (r *ResourceReconciler[T]) reconcileOuter(ctx context.Context, req Request)
 		return Result{}, nil
 	}
 
+	if r.StampConditionObservedGeneration {
+		ctx = apis.WithConditionObservedGeneration(ctx, resource.GetGeneration())
+	}
+	var result Result
+	err := r.BeforeReconcileResource(ctx, resource)
 	r.initializeConditions(ctx, resource)
-	result, err := r.reconcileInner(ctx, resource)
+	// a resource being deleted is reconciled while paused so its finalizers are released
+	paused := r.paused(resource) && resource.GetDeletionTimestamp() == nil
+	if r.PauseAnnotation != "" {
+		r.markSuspended(ctx, resource, paused)
+	}
+	if err == nil {
+		if paused {
+			log.Info("reconciliation paused", "annotation", r.PauseAnnotation)
+		} else {
+			result, err = r.reconcileInner(ctx, resource)
+		}
+	}
+	result, err = r.AfterReconcileResource(ctx, resource, result, err)
 
 	if r.SkipStatusUpdate {
 		return result, err
@@ -371,20 +492,21 @@ func (r *ResourceReconciler[T]) reconcileOuter(ctx context.Context, req Request)
 
 	// check if status has changed before updating
 	resourceStatus, originalResourceStatus := r.status(resource), r.status(originalResource)
-	if !errors.Is(err, ErrSkipStatusUpdate) && !equality.Semantic.DeepEqual(resourceStatus, originalResourceStatus) && (resource.GetDeletionTimestamp() == nil || r.SyncStatusDuringFinalization) {
+	statusChanged := !equality.Semantic.DeepEqual(resourceStatus, originalResourceStatus)
+	if !errors.Is(err, ErrSkipStatusUpdate) && (statusChanged || r.AlwaysUpdateStatus) && (resource.GetDeletionTimestamp() == nil || r.SyncStatusDuringFinalization) {
 		if duck.IsDuck(resource, c.Scheme()) {
 			// patch status
 			log.Info("patching status", "diff", cmp.Diff(originalResourceStatus, resourceStatus, IgnoreAllUnexported))
 			if patchErr := c.Status().Patch(ctx, resource, client.MergeFrom(originalResource)); patchErr != nil {
 				if !errors.Is(patchErr, ErrQuiet) {
 					log.Error(patchErr, "unable to patch status")
-					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "StatusPatchFailed",
+					c.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "StatusPatchFailed",
 						"Failed to patch status: %v", patchErr)
 				}
 
 				return result, patchErr
 			}
-			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "StatusPatched",
+			c.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "StatusPatched",
 				"Patched status")
 		} else {
 			// update status
@@ -399,24 +521,62 @@ func (r *ResourceReconciler[T]) reconcileOuter(ctx context.Context, req Request)
 				}
 				if !errors.Is(updateErr, ErrQuiet) {
 					log.Error(updateErr, "unable to update status")
-					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "StatusUpdateFailed",
+					c.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "StatusUpdateFailed",
 						"Failed to update status: %v", updateErr)
 				}
 				return result, updateErr
 			}
-			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "StatusUpdated",
+			c.RecorderFor(ctx).Eventf(resource, corev1.EventTypeNormal, "StatusUpdated",
 				"Updated status")
 		}
 
-		// Suppress result. Let the informer discover the resource mutation and requeue. Requeueing
-		// now may result in re-processing a stale cache.
-		return Result{}, nil
+		if statusChanged {
+			// Suppress result. Let the informer discover the resource mutation and requeue.
+			// Requeueing now may result in re-processing a stale cache.
+			return Result{}, nil
+		}
 	}
 
 	// return original reconcile result
 	return result, err
 }
 
+// NewStatusPatch creates a JSON merge patch for the status subresource containing the changes to
+// the status of the original object found in the updated object. Changes outside of the status are
+// ignored.
+func NewStatusPatch(original, updated client.Object) (client.Patch, error) {
+	originalBytes, err := statusJSON(original)
+	if err != nil {
+		return nil, err
+	}
+	updatedBytes, err := statusJSON(updated)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonmergepatch.CreateMergePatch(originalBytes, updatedBytes)
+	if err != nil {
+		return nil, err
+	}
+	return client.RawPatch(types.MergePatchType, patch), nil
+}
+
+// statusJSON returns the JSON encoding of an object holding only the status of the object
+func statusJSON(obj client.Object) ([]byte, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(objBytes, &fields); err != nil {
+		return nil, err
+	}
+	status := map[string]json.RawMessage{}
+	if s, ok := fields["status"]; ok {
+		status["status"] = s
+	}
+	return json.Marshal(status)
+}
+
 func (r *ResourceReconciler[T]) reconcileInner(ctx context.Context, resource T) (Result, error) {
 	if resource.GetDeletionTimestamp() != nil && len(resource.GetFinalizers()) == 0 {
 		// resource is being deleted and has no pending finalizers, nothing to do
@@ -424,7 +584,8 @@ func (r *ResourceReconciler[T]) reconcileInner(ctx context.Context, resource T)
 	}
 
 	result, err := r.Reconciler.Reconcile(ctx, resource)
-	if err != nil && !errors.Is(err, ErrHaltSubReconcilers) {
+	if err != nil && !(errors.Is(err, ErrHaltSubReconcilers) && errors.Is(err, ErrQuiet)) {
+		// a halt combined with an error that is not quiet is still an error
 		return result, err
 	}
 
@@ -432,6 +593,26 @@ func (r *ResourceReconciler[T]) reconcileInner(ctx context.Context, resource T)
 	return result, err
 }
 
+// paused returns true when the resource is annotated with the PauseAnnotation set to "true".
+func (r *ResourceReconciler[T]) paused(obj T) bool {
+	if r.PauseAnnotation == "" {
+		return false
+	}
+	return obj.GetAnnotations()[r.PauseAnnotation] == "true"
+}
+
+// markSuspended sets the ConditionSuspended condition for a paused resource, or removes the
+// condition for a resource that is not paused.
+func (r *ResourceReconciler[T]) markSuspended(ctx context.Context, obj T, paused bool) {
+	conditions := apis.ConditionSet{}.ManageWithContext(ctx, &resourceConditionsAccessor{obj: obj})
+	if paused {
+		conditions.MarkTrue(ConditionSuspended, SuspendedPausedReason, "reconciliation is paused by the %q annotation", r.PauseAnnotation)
+		return
+	}
+	// the condition is not terminal, an error is never returned
+	_ = conditions.ClearCondition(ConditionSuspended)
+}
+
 func (r *ResourceReconciler[T]) initializeConditions(ctx context.Context, obj T) {
 	status := r.status(obj)
 	if status == nil {
@@ -455,8 +636,18 @@ func (r *ResourceReconciler[T]) initializeConditions(ctx context.Context, obj T)
 }
 
 func (r *ResourceReconciler[T]) conditions(obj T) []metav1.Condition {
+	return resourceConditions(obj)
+}
+
+// resourceConditions returns the conditions from the status of the object, or nil if the status
+// does not define conditions. The conditions of an unstructured object are a copy of
+// status.conditions.
+func resourceConditions(obj client.Object) []metav1.Condition {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return unstructuredConditions(u)
+	}
 	// return obj.Status.Conditions
-	status := r.status(obj)
+	status := resourceStatus(obj)
 	if status == nil {
 		return nil
 	}
@@ -476,6 +667,83 @@ func (r *ResourceReconciler[T]) conditions(obj T) []metav1.Condition {
 	return conditions
 }
 
+// setResourceConditions replaces the conditions on the status of the object. Objects whose status
+// does not define conditions are not modified, while the conditions of an unstructured object are
+// set at status.conditions.
+func setResourceConditions(obj client.Object, conditions []metav1.Condition) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		setUnstructuredConditions(u, conditions)
+		return
+	}
+	// obj.Status.Conditions = conditions
+	status := resourceStatus(obj)
+	if status == nil {
+		return
+	}
+	statusValue := reflect.ValueOf(status)
+	if statusValue.Type().Kind() == reflect.Map {
+		return
+	}
+	conditionsValue := statusValue.Elem().FieldByName("Conditions")
+	if !conditionsValue.IsValid() || !conditionsValue.CanSet() || !reflect.TypeOf(conditions).AssignableTo(conditionsValue.Type()) {
+		return
+	}
+	conditionsValue.Set(reflect.ValueOf(conditions))
+}
+
+// unstructuredConditions returns a copy of the status.conditions of the object. Conditions that
+// are not well formed are skipped.
+func unstructuredConditions(obj *unstructured.Unstructured) []metav1.Condition {
+	items, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+	conditions := []metav1.Condition{}
+	for _, item := range items {
+		content, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &condition); err != nil {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// setUnstructuredConditions replaces the status.conditions of the object, creating the status
+// when missing.
+func setUnstructuredConditions(obj *unstructured.Unstructured, conditions []metav1.Condition) {
+	items := make([]interface{}, 0, len(conditions))
+	for i := range conditions {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&conditions[i])
+		if err != nil {
+			continue
+		}
+		items = append(items, content)
+	}
+	// the object content is a map, an error is only returned when status is not a map
+	_ = unstructured.SetNestedSlice(obj.Object, items, "status", "conditions")
+}
+
+// resourceConditionsAccessor exposes the conditions on the status of the object to a
+// ConditionManager, see resourceConditions and setResourceConditions.
+type resourceConditionsAccessor struct {
+	obj client.Object
+}
+
+var _ apis.ConditionsAccessor = (*resourceConditionsAccessor)(nil)
+
+func (a *resourceConditionsAccessor) GetConditions() []metav1.Condition {
+	return resourceConditions(a.obj)
+}
+
+func (a *resourceConditionsAccessor) SetConditions(conditions []metav1.Condition) {
+	setResourceConditions(a.obj, conditions)
+}
+
 func (r *ResourceReconciler[T]) copyGeneration(obj T) {
 	// obj.Status.ObservedGeneration = obj.Generation
 	status := r.status(obj)
@@ -504,10 +772,16 @@ func (r *ResourceReconciler[T]) hasStatus(obj T) bool {
 }
 
 func (r *ResourceReconciler[T]) status(obj T) interface{} {
-	if client.Object(obj) == nil {
+	return resourceStatus(obj)
+}
+
+// resourceStatus returns a pointer to the status of the object, or nil if the object does not
+// have a status.
+func resourceStatus(obj client.Object) interface{} {
+	if obj == nil {
 		return nil
 	}
-	if u, ok := client.Object(obj).(*unstructured.Unstructured); ok {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
 		return u.UnstructuredContent()["status"]
 	}
 	statusValue := reflect.ValueOf(obj).Elem().FieldByName("Status")
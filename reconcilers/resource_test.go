@@ -290,6 +290,8 @@ func TestResourceReconciler_Unstructured(t *testing.T) {
 		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
 	}
 
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+
 	scheme := runtime.NewScheme()
 	_ = resources.AddToScheme(scheme)
 
@@ -355,9 +357,84 @@ func TestResourceReconciler_Unstructured(t *testing.T) {
 				}).DieReleaseUnstructured(),
 			},
 		},
+		"paused resource is suspended": {
+			Request: testRequest,
+			Now:     now,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				resource.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+				}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return &reconcilers.SyncReconciler[*unstructured.Unstructured]{
+						Sync: func(ctx context.Context, resource *unstructured.Unstructured) error {
+							t.Error("should not be called")
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "StatusUpdated", `Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				resource.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+					}).
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.ConditionsDie(
+							diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+							diemetav1.ConditionBlank.Type(reconcilers.ConditionSuspended).Status(metav1.ConditionTrue).Reason(reconcilers.SuspendedPausedReason).
+								Message(`reconciliation is paused by the "reconciler.io/paused" annotation`).
+								LastTransitionTime(metav1.NewTime(now)),
+						)
+					}).
+					DieReleaseUnstructured(),
+			},
+		},
+		"resumed resource is no longer suspended": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				resource.StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+						diemetav1.ConditionBlank.Type(reconcilers.ConditionSuspended).Status(metav1.ConditionTrue).Reason(reconcilers.SuspendedPausedReason),
+					)
+				}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured] {
+					return &reconcilers.SyncReconciler[*unstructured.Unstructured]{
+						Sync: func(ctx context.Context, resource *unstructured.Unstructured) error {
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "StatusUpdated", `Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				resource.DieReleaseUnstructured(),
+			},
+		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		pauseAnnotation := ""
+		if annotation, ok := rtc.Metadata["PauseAnnotation"].(string); ok {
+			pauseAnnotation = annotation
+		}
 		return &reconcilers.ResourceReconciler[*unstructured.Unstructured]{
 			Type: &unstructured.Unstructured{
 				Object: map[string]interface{}{
@@ -365,8 +442,9 @@ func TestResourceReconciler_Unstructured(t *testing.T) {
 					"kind":       "TestResource",
 				},
 			},
-			Reconciler: rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured])(t, c),
-			Config:     c,
+			Reconciler:      rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*unstructured.Unstructured])(t, c),
+			PauseAnnotation: pauseAnnotation,
+			Config:          c,
 		}
 	})
 }
@@ -982,6 +1060,90 @@ func TestResourceReconciler(t *testing.T) {
 				}),
 			},
 		},
+		"re-marked conditions do not update status": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+							// replace the condition with an equivalent condition at a new time
+							resource.Status.Conditions = []metav1.Condition{
+								{Type: apis.ConditionReady, Status: metav1.ConditionUnknown, Reason: "Initializing", LastTransitionTime: metav1.Now()},
+							}
+							return reconcilers.Result{RequeueAfter: 10}, nil
+						},
+					}
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: 10},
+		},
+		"always update unchanged status": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"AlwaysUpdateStatus": true,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+							// the result is preserved because the status is unchanged
+							return reconcilers.Result{RequeueAfter: 10}, nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource,
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: 10},
+		},
+		"always update changed status": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"AlwaysUpdateStatus": true,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+							if resource.Status.Fields == nil {
+								resource.Status.Fields = map[string]string{}
+							}
+							resource.Status.Fields["Reconciler"] = "ran"
+							// the result is ignored because the status is updated
+							return reconcilers.Result{RequeueAfter: 10}, nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Reconciler", "ran")
+				}),
+			},
+		},
 		"status does not update for deleted resource": {
 			Request: testRequest,
 			GivenObjects: []client.Object{
@@ -1112,6 +1274,46 @@ func TestResourceReconciler(t *testing.T) {
 			},
 			ExpectedResult: reconcilers.Result{Requeue: true},
 		},
+		"sub reconciler halted after an error": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.Sequence[*resources.TestResource]{
+						reconcilers.BestEffortSequence[*resources.TestResource]{
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									return fmt.Errorf("reconciler error")
+								},
+							},
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									c := reconcilers.RetrieveConfigOrDie(ctx)
+									c.Recorder.Eventf(resource, corev1.EventTypeNormal, "Want", "")
+									return reconcilers.ErrHaltSubReconcilers
+								},
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								c := reconcilers.RetrieveConfigOrDie(ctx)
+								c.Recorder.Eventf(resource, corev1.EventTypeNormal, "DontWant", "")
+								return nil
+							},
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "Want", ""),
+			},
+			ShouldErr: true,
+		},
 		"skip status update": {
 			Request: testRequest,
 			StatusSubResourceTypes: []client.Object{
@@ -1278,6 +1480,30 @@ func TestResourceReconciler(t *testing.T) {
 				},
 			},
 		},
+		"context has request and reconciler name": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if req := reconcilers.RetrieveRequest(ctx); req != testRequest {
+								t.Errorf("expected request %v in context, found %v", testRequest, req)
+							}
+							if expected, actual := "TestResourceResourceReconciler", reconcilers.RetrieveReconcilerName(ctx); expected != actual {
+								t.Errorf("expected reconciler name %q in context, found %q", expected, actual)
+							}
+							return nil
+						},
+					}
+				},
+			},
+		},
 		"context has resource type": {
 			Request: testRequest,
 			StatusSubResourceTypes: []client.Object{
@@ -1302,6 +1528,53 @@ func TestResourceReconciler(t *testing.T) {
 				},
 			},
 		},
+		"context has original resource": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							resource.Status.Fields = map[string]string{"Reconciler": "ran"}
+
+							original := reconcilers.RetrieveOriginalResource[*resources.TestResource](ctx)
+							if original == nil {
+								t.Fatalf("expected original resource in context")
+							}
+							if _, ok := original.Spec.Fields["Defaulter"]; ok {
+								t.Errorf("expected original resource to not be defaulted")
+							}
+							if original.Status.Fields != nil {
+								t.Errorf("expected original resource to not be mutated")
+							}
+							// mutations to the retrieved value are not shared
+							original.Status.Fields = map[string]string{"Mutated": "true"}
+							if reconcilers.RetrieveOriginalResource[*resources.TestResource](ctx).Status.Fields != nil {
+								t.Errorf("expected original resource to be a copy")
+							}
+							if other := reconcilers.RetrieveOriginalResource[*resources.TestResourceNoStatus](ctx); other != nil {
+								t.Errorf("expected original resource of a different type to be nil, found %#v", other)
+							}
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Reconciler", "ran")
+				}),
+			},
+		},
 		"context can be augmented in Prepare and accessed in Cleanup": {
 			Request: testRequest,
 			StatusSubResourceTypes: []client.Object{
@@ -1478,6 +1751,132 @@ func TestResourceReconciler(t *testing.T) {
 				},
 			},
 		},
+		"before reconcile resource normalizes the resource before the reconciler": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"BeforeReconcileResource": func(ctx context.Context, resource *resources.TestResource) error {
+					if resource.Status.Fields == nil {
+						resource.Status.Fields = map[string]string{}
+					}
+					resource.Status.Fields["Normalized"] = "true"
+					return nil
+				},
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if resource.Status.Fields["Normalized"] != "true" {
+								t.Errorf("expected resource to be normalized before the reconciler")
+							}
+							resource.Status.Fields["Reconciler"] = "ran"
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Normalized", "true")
+					d.AddField("Reconciler", "ran")
+				}),
+			},
+		},
+		"before reconcile resource error skips the reconciler": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"BeforeReconcileResource": func(ctx context.Context, resource *resources.TestResource) error {
+					return errors.New("test")
+				},
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							t.Fatalf("reconcile should have been skipped")
+							return nil
+						},
+					}
+				},
+			},
+			ShouldErr: true,
+		},
+		"after reconcile resource is called with the reconciled resource": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if resource.Status.Fields == nil {
+								resource.Status.Fields = map[string]string{}
+							}
+							resource.Status.Fields["Reconciler"] = "ran"
+							return errors.New("test")
+						},
+					}
+				},
+				"AfterReconcileResource": func(ctx context.Context, resource *resources.TestResource, result reconcilers.Result, err error) (reconcilers.Result, error) {
+					if err == nil {
+						return result, errors.New("expected reconciler error")
+					}
+					resource.Status.Fields["AfterReconcileResource"] = "ran"
+					// suppress error
+					return result, nil
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Reconciler", "ran")
+					d.AddField("AfterReconcileResource", "ran")
+				}),
+			},
+		},
+		"after reconcile resource can influence the result": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource,
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+					}
+				},
+				"AfterReconcileResource": func(ctx context.Context, resource *resources.TestResource, result reconcilers.Result, err error) (reconcilers.Result, error) {
+					return reconcilers.Result{Requeue: true}, err
+				},
+			},
+			ExpectedResult: reconcile.Result{
+				Requeue: true,
+			},
+		},
 		"skip request": {
 			Request: testRequest,
 			StatusSubResourceTypes: []client.Object{
@@ -1522,6 +1921,161 @@ func TestResourceReconciler(t *testing.T) {
 				},
 			},
 		},
+		"paused resource is suspended": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+				}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							t.Error("should not be called")
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+					}).
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.ConditionsDie(
+							diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+							diemetav1.ConditionBlank.Type(reconcilers.ConditionSuspended).Status(metav1.ConditionTrue).Reason(reconcilers.SuspendedPausedReason).
+								Message(`reconciliation is paused by the "reconciler.io/paused" annotation`),
+						)
+					}),
+			},
+		},
+		"suspended resource is not mutated": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+					}).
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.ConditionsDie(
+							diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+							diemetav1.ConditionBlank.Type(reconcilers.ConditionSuspended).Status(metav1.ConditionTrue).Reason(reconcilers.SuspendedPausedReason).
+								Message(`reconciliation is paused by the "reconciler.io/paused" annotation`),
+						)
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							t.Error("should not be called")
+							return nil
+						},
+					}
+				},
+			},
+			ExpectActions: []rtesting.ActionRef{},
+		},
+		"resumed resource is no longer suspended": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.PausedAnnotation, "false")
+					}).
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.ConditionsDie(
+							diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing"),
+							diemetav1.ConditionBlank.Type(reconcilers.ConditionSuspended).Status(metav1.ConditionTrue).Reason(reconcilers.SuspendedPausedReason),
+						)
+					}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if resource.Status.Fields == nil {
+								resource.Status.Fields = map[string]string{}
+							}
+							resource.Status.Fields["Reconciler"] = "ran"
+							return nil
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "StatusUpdated",
+					`Updated status`),
+			},
+			ExpectStatusUpdates: []client.Object{
+				givenResource.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(reconcilers.PausedAnnotation, "false")
+					}).
+					StatusDie(func(d *dies.TestResourceStatusDie) {
+						d.AddField("Reconciler", "ran")
+					}),
+			},
+		},
+		"paused resource being deleted releases finalizers": {
+			Request: testRequest,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				givenResource.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(reconcilers.PausedAnnotation, "true")
+					d.DeletionTimestamp(&deletedAt)
+					d.Finalizers(testFinalizer)
+				}),
+			},
+			Metadata: map[string]interface{}{
+				"PauseAnnotation": reconcilers.PausedAnnotation,
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+						Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+							return reconcilers.ClearFinalizer(ctx, resource, testFinalizer)
+						},
+					}
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(givenResource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
@@ -1533,6 +2087,10 @@ func TestResourceReconciler(t *testing.T) {
 		if allow, ok := rtc.Metadata["SyncStatusDuringFinalization"].(bool); ok {
 			syncStatusDuringFinalization = allow
 		}
+		alwaysUpdateStatus := false
+		if always, ok := rtc.Metadata["AlwaysUpdateStatus"].(bool); ok {
+			alwaysUpdateStatus = always
+		}
 		var beforeReconcile func(context.Context, reconcilers.Request) (context.Context, reconcilers.Result, error)
 		if before, ok := rtc.Metadata["BeforeReconcile"].(func(context.Context, reconcilers.Request) (context.Context, reconcilers.Result, error)); ok {
 			beforeReconcile = before
@@ -1541,6 +2099,14 @@ func TestResourceReconciler(t *testing.T) {
 		if after, ok := rtc.Metadata["AfterReconcile"].(func(context.Context, reconcilers.Request, reconcilers.Result, error) (reconcilers.Result, error)); ok {
 			afterReconcile = after
 		}
+		var beforeReconcileResource func(context.Context, *resources.TestResource) error
+		if before, ok := rtc.Metadata["BeforeReconcileResource"].(func(context.Context, *resources.TestResource) error); ok {
+			beforeReconcileResource = before
+		}
+		var afterReconcileResource func(context.Context, *resources.TestResource, reconcilers.Result, error) (reconcilers.Result, error)
+		if after, ok := rtc.Metadata["AfterReconcileResource"].(func(context.Context, *resources.TestResource, reconcilers.Result, error) (reconcilers.Result, error)); ok {
+			afterReconcileResource = after
+		}
 		var skipRequest func(context.Context, reconcilers.Request) bool
 		if skip, ok := rtc.Metadata["SkipRequest"].(func(context.Context, reconcilers.Request) bool); ok {
 			skipRequest = skip
@@ -1549,14 +2115,22 @@ func TestResourceReconciler(t *testing.T) {
 		if skip, ok := rtc.Metadata["SkipResource"].(func(context.Context, *resources.TestResource) bool); ok {
 			skipResource = skip
 		}
+		pauseAnnotation := ""
+		if annotation, ok := rtc.Metadata["PauseAnnotation"].(string); ok {
+			pauseAnnotation = annotation
+		}
 		return &reconcilers.ResourceReconciler[*resources.TestResource]{
 			Reconciler:                   rtc.Metadata["SubReconciler"].(func(*testing.T, reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource])(t, c),
 			SkipStatusUpdate:             skipStatusUpdate,
 			SyncStatusDuringFinalization: syncStatusDuringFinalization,
+			AlwaysUpdateStatus:           alwaysUpdateStatus,
 			BeforeReconcile:              beforeReconcile,
 			AfterReconcile:               afterReconcile,
+			BeforeReconcileResource:      beforeReconcileResource,
+			AfterReconcileResource:       afterReconcileResource,
 			SkipRequest:                  skipRequest,
 			SkipResource:                 skipResource,
+			PauseAnnotation:              pauseAnnotation,
 			Config:                       c,
 		}
 	})
@@ -1979,6 +2553,105 @@ func TestResourceReconciler_ObjectDefault(t *testing.T) {
 	})
 }
 
+func TestNewStatusPatch(t *testing.T) {
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-resource")
+		})
+
+	tests := []struct {
+		name         string
+		original     client.Object
+		updated      client.Object
+		expected     string
+		newShouldErr bool
+	}{
+		{
+			name:     "identity",
+			original: resource.DieReleasePtr(),
+			updated:  resource.DieReleasePtr(),
+			expected: `{}`,
+		},
+		{
+			name:     "status changed",
+			original: resource.DieReleasePtr(),
+			updated: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			expected: `{"status":{"fields":{"foo":"bar"}}}`,
+		},
+		{
+			name: "status field removed",
+			original: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+					d.AddField("hello", "world")
+				}).
+				DieReleasePtr(),
+			updated: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("hello", "world")
+				}).
+				DieReleasePtr(),
+			expected: `{"status":{"fields":{"foo":null}}}`,
+		},
+		{
+			name:     "ignores changes outside of status",
+			original: resource.DieReleasePtr(),
+			updated: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("foo", "bar")
+				}).
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			expected: `{"status":{"fields":{"foo":"bar"}}}`,
+		},
+		{
+			name:         "bad original",
+			original:     &boom{ShouldErr: true},
+			updated:      &boom{},
+			newShouldErr: true,
+		},
+		{
+			name:         "bad updated",
+			original:     &boom{},
+			updated:      &boom{ShouldErr: true},
+			newShouldErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			patch, err := reconcilers.NewStatusPatch(c.original, c.updated)
+			if actual, expected := err != nil, c.newShouldErr; actual != expected {
+				t.Errorf("%s: unexpected new error, actually = %v, expected = %v", c.name, actual, expected)
+			}
+			if c.newShouldErr {
+				return
+			}
+
+			if actual, expected := patch.Type(), types.MergePatchType; actual != expected {
+				t.Errorf("%s: unexpected patch type, actually = %v, expected = %v", c.name, actual, expected)
+			}
+			data, err := patch.Data(c.updated)
+			if err != nil {
+				t.Fatalf("%s: unexpected data error: %v", c.name, err)
+			}
+			if diff := cmp.Diff(c.expected, string(data)); diff != "" {
+				t.Errorf("%s: unexpected patch (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
 func TestResourceReconciler_Validate_TestResource(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2008,6 +2681,32 @@ func TestResourceReconciler_Validate_TestResource(t *testing.T) {
 			},
 			shouldErr: `ResourceReconciler "missing reconciler" must define Reconciler`,
 		},
+		{
+			name: "always update status",
+			reconciler: &reconcilers.ResourceReconciler[*resources.TestResource]{
+				Reconciler:         reconcilers.Sequence[*resources.TestResource]{},
+				AlwaysUpdateStatus: true,
+			},
+		},
+		{
+			name: "skip and always update status",
+			reconciler: &reconcilers.ResourceReconciler[*resources.TestResource]{
+				Name:               "skip and always update status",
+				Reconciler:         reconcilers.Sequence[*resources.TestResource]{},
+				SkipStatusUpdate:   true,
+				AlwaysUpdateStatus: true,
+			},
+			shouldErr: `ResourceReconciler "skip and always update status" must not define both SkipStatusUpdate and AlwaysUpdateStatus`,
+		},
+		{
+			name: "reports all violations",
+			reconciler: &reconcilers.ResourceReconciler[*resources.TestResource]{
+				Name:               "reports all violations",
+				SkipStatusUpdate:   true,
+				AlwaysUpdateStatus: true,
+			},
+			shouldErr: `[ResourceReconciler "reports all violations" must define Reconciler, ResourceReconciler "reports all violations" must not define both SkipStatusUpdate and AlwaysUpdateStatus]`,
+		},
 		{
 			name: "valid reconciler",
 			reconciler: &reconcilers.ResourceReconciler[*resources.TestResource]{
@@ -2151,3 +2850,60 @@ func TestResourceReconciler_Validate_TestResourceNilableStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceReconciler_Validate_Unstructured(t *testing.T) {
+	tests := []struct {
+		name         string
+		reconciler   *reconcilers.ResourceReconciler[*unstructured.Unstructured]
+		shouldErr    string
+		expectedLogs []string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.ResourceReconciler[*unstructured.Unstructured]{
+				Type: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": resources.GroupVersion.String(),
+						"kind":       "TestResource",
+					},
+				},
+				Reconciler: reconcilers.Sequence[*unstructured.Unstructured]{},
+			},
+		},
+		{
+			name: "missing type",
+			reconciler: &reconcilers.ResourceReconciler[*unstructured.Unstructured]{
+				Name:       "missing type",
+				Reconciler: reconcilers.Sequence[*unstructured.Unstructured]{},
+			},
+			shouldErr: `ResourceReconciler "missing type" must define Type with an apiVersion and kind for unstructured types`,
+		},
+		{
+			name: "missing kind",
+			reconciler: &reconcilers.ResourceReconciler[*unstructured.Unstructured]{
+				Name: "missing kind",
+				Type: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": resources.GroupVersion.String(),
+					},
+				},
+				Reconciler: reconcilers.Sequence[*unstructured.Unstructured]{},
+			},
+			shouldErr: `ResourceReconciler "missing kind" must define Type with an apiVersion and kind for unstructured types`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			sink := &bufferedSink{}
+			ctx := logr.NewContext(context.TODO(), logr.New(sink))
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+			if diff := cmp.Diff(c.expectedLogs, sink.Lines); diff != "" {
+				t.Errorf("%s: unexpected logs (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtime "reconciler.io/runtime/time"
+	"reconciler.io/runtime/validation"
+)
+
+// ScheduledLastRunAnnotation holds the time, formatted as RFC 3339, a ScheduledReconciler last
+// ran its nested reconciler.
+const ScheduledLastRunAnnotation = "reconciler.io/scheduled-last-run"
+
+var scheduledAnnotations = []string{ScheduledLastRunAnnotation}
+
+var _ SubReconciler[client.Object] = (*ScheduledReconciler[client.Object])(nil)
+
+// ScheduledReconciler runs the nested reconciler periodically, independent of the watches that
+// trigger reconcile requests. The next run is computed from the time of the last run, either at
+// a fixed Interval or by a custom Schedule. Reconcile requests received before the next run is
+// due skip the nested reconciler and are requeued for the time remaining. After the nested
+// reconciler returns without error, the time of the run is recorded and the resource is requeued
+// for the next run.
+//
+// The time of the last run is persisted on the reconciled resource with the
+// ScheduledLastRunAnnotation annotation by default. The annotation is patched with the client
+// that loaded the reconciled resource. Resources may instead persist the time in their status by
+// defining LastRun and SetLastRun.
+//
+// The nested reconciler is always called for resources that are being deleted.
+type ScheduledReconciler[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `ScheduledReconciler`.  Ideally unique,
+	// but not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// Reconciler is called when a run of the resource is due. Typically a Sequence is used to
+	// compose multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	// Interval between consecutive runs of the nested reconciler.
+	//
+	// Mutually exclusive with Schedule.
+	//
+	// +optional
+	Interval time.Duration
+
+	// Schedule returns the time the next run is due after the last run. Cron expressions are not
+	// parsed by this package; a Schedule that wraps a cron library may be used to run on a cron
+	// schedule.
+	//
+	// Mutually exclusive with Interval.
+	//
+	// +optional
+	Schedule func(ctx context.Context, resource Type, lastRun time.Time) time.Time
+
+	// LastRun returns the time the nested reconciler last ran for the resource, or the zero time
+	// if it never ran. Typically the time is read from a field on the resource's status.
+	//
+	// Defaults to parsing the ScheduledLastRunAnnotation annotation. Must be defined with
+	// SetLastRun.
+	//
+	// +optional
+	LastRun func(ctx context.Context, resource Type) time.Time
+
+	// SetLastRun records the time the nested reconciler last ran for the resource. Changes to
+	// the resource's status are persisted by the ResourceReconciler.
+	//
+	// Defaults to patching the ScheduledLastRunAnnotation annotation. Must be defined with
+	// LastRun.
+	//
+	// +optional
+	SetLastRun func(ctx context.Context, resource Type, lastRun time.Time) error
+
+	lazyInit sync.Once
+}
+
+func (r *ScheduledReconciler[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "ScheduledReconciler"
+		}
+		if r.LastRun == nil && r.SetLastRun == nil {
+			r.LastRun = func(ctx context.Context, resource T) time.Time {
+				lastRun, err := time.Parse(time.RFC3339, resource.GetAnnotations()[ScheduledLastRunAnnotation])
+				if err != nil {
+					// an invalid time is treated as never having run
+					return time.Time{}
+				}
+				return lastRun
+			}
+			r.SetLastRun = func(ctx context.Context, resource T, lastRun time.Time) error {
+				return patchAnnotations(ctx, resource, scheduledAnnotations, map[string]string{
+					ScheduledLastRunAnnotation: lastRun.UTC().Format(time.RFC3339),
+				})
+			}
+		}
+	})
+}
+
+func (r *ScheduledReconciler[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	if err := r.Reconciler.SetupWithManager(ctx, mgr, bldr); err != nil {
+		return err
+	}
+	if r.Setup == nil {
+		return nil
+	}
+	return r.Setup(ctx, mgr, bldr)
+}
+
+func (r *ScheduledReconciler[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Interval and Schedule values
+	if r.Interval == 0 && r.Schedule == nil {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must define Interval or Schedule", r.Name))
+	}
+	if r.Interval != 0 && r.Schedule != nil {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must not define both Interval and Schedule", r.Name))
+	}
+	if r.Interval < 0 {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must not define a negative Interval", r.Name))
+	}
+
+	// validate LastRun and SetLastRun values
+	if r.LastRun == nil {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must define LastRun when SetLastRun is defined", r.Name))
+	}
+	if r.SetLastRun == nil {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must define SetLastRun when LastRun is defined", r.Name))
+	}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("ScheduledReconciler %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("ScheduledReconciler %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *ScheduledReconciler[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if resource.GetDeletionTimestamp() != nil {
+		return r.Reconciler.Reconcile(ctx, resource)
+	}
+
+	now := rtime.RetrieveNow(ctx)
+	if lastRun := r.LastRun(ctx, resource); !lastRun.IsZero() {
+		if next := r.next(ctx, resource, lastRun); now.Before(next) {
+			log.V(1).Info("run is not due, skipping", "next", next)
+			return Result{RequeueAfter: next.Sub(now)}, nil
+		}
+	}
+
+	result, err := r.Reconciler.Reconcile(ctx, resource)
+	if err != nil {
+		return result, err
+	}
+
+	if err := r.SetLastRun(ctx, resource, now); err != nil {
+		return result, err
+	}
+	if next := r.next(ctx, resource, now); now.Before(next) {
+		return WithMaxRequeue(result, next.Sub(now)), nil
+	}
+	return result, nil
+}
+
+// next returns the time the run following lastRun is due
+func (r *ScheduledReconciler[T]) next(ctx context.Context, resource T, lastRun time.Time) time.Time {
+	if r.Schedule != nil {
+		return r.Schedule(ctx, resource, lastRun)
+	}
+	return lastRun.Add(r.Interval)
+}
+
+// patchAnnotations sets the annotation keys on the reconciled resource, or removes them when values
+// is nil. The client that loaded the reconciled resource is used to patch it.
+func patchAnnotations(ctx context.Context, current client.Object, keys []string, values map[string]string) error {
+	config := RetrieveOriginalConfigOrDie(ctx)
+	log := logr.FromContextOrDiscard(ctx)
+
+	desired := current.DeepCopyObject().(client.Object)
+	annotations := desired.GetAnnotations()
+	for _, key := range keys {
+		if values == nil {
+			delete(annotations, key)
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = values[key]
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	desired.SetAnnotations(annotations)
+
+	patch := client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{})
+	if err := config.Patch(ctx, desired, patch); err != nil {
+		if !errors.Is(err, ErrQuiet) {
+			log.Error(err, "unable to patch annotations", "keys", keys)
+		}
+		return err
+	}
+
+	// update current object with values from the api server after patching
+	current.SetAnnotations(desired.GetAnnotations())
+	current.SetResourceVersion(desired.GetResourceVersion())
+	current.SetGeneration(desired.GetGeneration())
+
+	return nil
+}
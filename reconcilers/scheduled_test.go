@@ -0,0 +1,430 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+)
+
+func TestScheduledReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+	withLastRun := func(lastRun time.Time) func(d *diemetav1.ObjectMetaDie) {
+		return func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(reconcilers.ScheduledLastRunAnnotation, lastRun.Format(time.RFC3339))
+		}
+	}
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"first run": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now)).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"run is due": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(withLastRun(now.Add(-2 * time.Hour))).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now)).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"run is not due": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(withLastRun(now.Add(-20 * time.Minute))).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: 40 * time.Minute},
+		},
+		"invalid last run is treated as never run": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(reconcilers.ScheduledLastRunAnnotation, "not a time")
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now)).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"preserves shorter requeue from reconciler": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(withLastRun(now.Add(-2 * time.Hour))).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Result": reconcilers.Result{RequeueAfter: time.Minute},
+			},
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now)).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"custom schedule": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(withLastRun(now.Add(-20 * time.Minute))).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				// runs at the top of each hour
+				"Schedule": func(ctx context.Context, resource *resources.TestResource, lastRun time.Time) time.Time {
+					return lastRun.Truncate(time.Hour).Add(time.Hour)
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now)).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.ResourceVersion("1000")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"last run in status": {
+			Now: now,
+			Resource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("lastRun", now.Add(-2*time.Hour).Format(time.RFC3339))
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"LastRun": func(ctx context.Context, resource *resources.TestResource) time.Time {
+					lastRun, _ := time.Parse(time.RFC3339, resource.Status.Fields["lastRun"])
+					return lastRun
+				},
+				"SetLastRun": func(ctx context.Context, resource *resources.TestResource, lastRun time.Time) error {
+					resource.Status.Fields["lastRun"] = lastRun.Format(time.RFC3339)
+					return nil
+				},
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("lastRun", now.Format(time.RFC3339))
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Hour},
+		},
+		"deleted resource always runs": {
+			Now: now,
+			Resource: resource.
+				MetadataDie(withLastRun(now.Add(-20 * time.Minute))).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&metav1.Time{Time: now})
+					d.Finalizers("test.finalizer")
+				}).
+				DieReleasePtr(),
+			ExpectResource: resource.
+				MetadataDie(withLastRun(now.Add(-20 * time.Minute))).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&metav1.Time{Time: now})
+					d.Finalizers("test.finalizer")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+		},
+		"reconciler error": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Err": fmt.Errorf("reconciler error"),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ShouldErr: true,
+		},
+		"error patching last run": {
+			Now:      now,
+			Resource: resource.DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "TestResource"),
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("ran", "true")
+				}).
+				DieReleasePtr(),
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"annotations":{"reconciler.io/scheduled-last-run":"2026-10-15T10:00:00Z"},"resourceVersion":"999"}}`),
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		r := &reconcilers.ScheduledReconciler[*resources.TestResource]{
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+					if resource.Status.Fields == nil {
+						resource.Status.Fields = map[string]string{}
+					}
+					resource.Status.Fields["ran"] = "true"
+					var result reconcilers.Result
+					if r, ok := rtc.Metadata["Result"]; ok {
+						result = r.(reconcilers.Result)
+					}
+					var err error
+					if e, ok := rtc.Metadata["Err"]; ok {
+						err = e.(error)
+					}
+					return result, err
+				},
+				// run for deleted resources as well
+				SyncDuringFinalization: true,
+			},
+			Interval: time.Hour,
+		}
+		if schedule, ok := rtc.Metadata["Schedule"]; ok {
+			r.Interval = 0
+			r.Schedule = schedule.(func(context.Context, *resources.TestResource, time.Time) time.Time)
+		}
+		if lastRun, ok := rtc.Metadata["LastRun"]; ok {
+			r.LastRun = lastRun.(func(context.Context, *resources.TestResource) time.Time)
+		}
+		if setLastRun, ok := rtc.Metadata["SetLastRun"]; ok {
+			r.SetLastRun = setLastRun.(func(context.Context, *resources.TestResource, time.Time) error)
+		}
+		return r
+	})
+}
+
+func TestScheduledReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.ScheduledReconciler[*resources.TestResource]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Interval:   time.Hour,
+			},
+		},
+		{
+			name: "valid schedule",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Schedule: func(ctx context.Context, resource *resources.TestResource, lastRun time.Time) time.Time {
+					return lastRun.Add(time.Hour)
+				},
+			},
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:     "missing reconciler",
+				Interval: time.Hour,
+			},
+			shouldErr: `ScheduledReconciler "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "missing interval and schedule",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "missing interval and schedule",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+			shouldErr: `ScheduledReconciler "missing interval and schedule" must define Interval or Schedule`,
+		},
+		{
+			name: "interval and schedule",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "interval and schedule",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Interval:   time.Hour,
+				Schedule: func(ctx context.Context, resource *resources.TestResource, lastRun time.Time) time.Time {
+					return lastRun.Add(time.Hour)
+				},
+			},
+			shouldErr: `ScheduledReconciler "interval and schedule" must not define both Interval and Schedule`,
+		},
+		{
+			name: "negative interval",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "negative interval",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Interval:   -1 * time.Hour,
+			},
+			shouldErr: `ScheduledReconciler "negative interval" must not define a negative Interval`,
+		},
+		{
+			name: "last run without set last run",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "last run without set last run",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Interval:   time.Hour,
+				LastRun: func(ctx context.Context, resource *resources.TestResource) time.Time {
+					return time.Time{}
+				},
+			},
+			shouldErr: `ScheduledReconciler "last run without set last run" must define SetLastRun when LastRun is defined`,
+		},
+		{
+			name: "set last run without last run",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "set last run without last run",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+				Interval:   time.Hour,
+				SetLastRun: func(ctx context.Context, resource *resources.TestResource, lastRun time.Time) error {
+					return nil
+				},
+			},
+			shouldErr: `ScheduledReconciler "set last run without last run" must define LastRun when SetLastRun is defined`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.ScheduledReconciler[*resources.TestResource]{
+				Name:       "invalid reconciler",
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				Interval: time.Hour,
+			},
+			shouldErr: `ScheduledReconciler "invalid reconciler" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
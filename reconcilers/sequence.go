@@ -18,9 +18,11 @@ package reconcilers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"reconciler.io/runtime/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -31,6 +33,12 @@ var _ SubReconciler[client.Object] = (Sequence[client.Object])(nil)
 
 // Sequence is a collection of SubReconcilers called in order. If a
 // reconciler errs, further reconcilers are skipped.
+//
+// When a reconciler returns ErrHaltSubReconcilers, the result aggregated from
+// each reconciler called so far is returned along with the error. Mutations to
+// the resource and values stashed by the reconcilers that ran before the halt
+// are preserved, a ResourceReconciler will reflect them on the resource status
+// as if no error was returned.
 type Sequence[Type client.Object] []SubReconciler[Type]
 
 func (r Sequence[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
@@ -57,6 +65,9 @@ func (r Sequence[T]) Reconcile(ctx context.Context, resource T) (Result, error)
 		result, err := reconciler.Reconcile(ctx, resource)
 		aggregateResult = AggregateResults(result, aggregateResult)
 		if err != nil {
+			if errors.Is(err, ErrHaltSubReconcilers) {
+				return aggregateResult, err
+			}
 			return result, err
 		}
 	}
@@ -65,16 +76,18 @@ func (r Sequence[T]) Reconcile(ctx context.Context, resource T) (Result, error)
 }
 
 func (r *Sequence[T]) Validate(ctx context.Context) error {
+	errs := []error{}
+
 	// validate Sequence
 	if validation.IsRecursive(ctx) {
 		for i, reconciler := range *r {
 			if v, ok := reconciler.(validation.Validator); ok {
 				if err := v.Validate(ctx); err != nil {
-					return fmt.Errorf("Sequence must have a valid Sequence[%d]: %w", i, err)
+					errs = append(errs, fmt.Errorf("Sequence must have a valid Sequence[%d]: %w", i, err))
 				}
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
@@ -18,19 +18,25 @@ package reconcilers_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/internal/resources"
 	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
+	"reconciler.io/runtime/stash"
 	rtesting "reconciler.io/runtime/testing"
 	"reconciler.io/runtime/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestSequence(t *testing.T) {
@@ -39,6 +45,7 @@ func TestSequence(t *testing.T) {
 
 	scheme := runtime.NewScheme()
 	_ = resources.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
 
 	resource := dies.TestResourceBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
@@ -51,6 +58,16 @@ func TestSequence(t *testing.T) {
 			)
 		})
 
+	configMapCreate := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+			d.ControlledBy(resource, scheme)
+		}).
+		AddData("foo", "bar")
+
+	var childKey stash.Key = "child"
+
 	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
 		"sub reconciler erred": {
 			Resource: resource.DieReleasePtr(),
@@ -87,6 +104,134 @@ func TestSequence(t *testing.T) {
 			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Minute},
 			ShouldErr:      true,
 		},
+		"aggregates result, sub reconciler halted": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.TryCatch[*resources.TestResource]{
+						Try: reconcilers.Sequence[*resources.TestResource]{
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+									return reconcilers.Result{RequeueAfter: 1 * time.Minute}, nil
+								},
+							},
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+									return reconcilers.Result{}, reconcilers.ErrHaltSubReconcilers
+								},
+							},
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+									t.Error("should not be called after halt")
+									return reconcilers.Result{RequeueAfter: 1 * time.Second}, nil
+								},
+							},
+						},
+						Catch: func(ctx context.Context, resource *resources.TestResource, result reconcilers.Result, err error) (reconcilers.Result, error) {
+							if !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+								t.Errorf("expected ErrHaltSubReconcilers, got %v", err)
+							}
+							return result, nil
+						},
+					}
+				},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: 1 * time.Minute},
+		},
+		"sub reconciler halted with a cause": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					cause := fmt.Errorf("dependency is not ready")
+					return &reconcilers.TryCatch[*resources.TestResource]{
+						Try: reconcilers.Sequence[*resources.TestResource]{
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									return reconcilers.HaltSubReconcilers(cause)
+								},
+							},
+							&reconcilers.SyncReconciler[*resources.TestResource]{
+								Sync: func(ctx context.Context, resource *resources.TestResource) error {
+									t.Error("should not be called after halt")
+									return nil
+								},
+							},
+						},
+						Catch: func(ctx context.Context, resource *resources.TestResource, result reconcilers.Result, err error) (reconcilers.Result, error) {
+							if !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+								t.Errorf("expected ErrHaltSubReconcilers, got %v", err)
+							}
+							if !errors.Is(err, cause) {
+								t.Errorf("expected cause to be preserved, got %v", err)
+							}
+							return result, nil
+						},
+					}
+				},
+			},
+		},
+		"preserves child status and stash, sub reconciler halted": {
+			Resource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return reconcilers.Sequence[*resources.TestResource]{
+						&reconcilers.ChildReconciler[*resources.TestResource, *corev1.ConfigMap, *corev1.ConfigMapList]{
+							DesiredChild: func(ctx context.Context, parent *resources.TestResource) (*corev1.ConfigMap, error) {
+								return &corev1.ConfigMap{
+									ObjectMeta: metav1.ObjectMeta{
+										Namespace: parent.Namespace,
+										Name:      parent.Name,
+									},
+									Data: reconcilers.MergeMaps(parent.Spec.Fields),
+								}, nil
+							},
+							ChildObjectManager: &rtesting.StubObjectManager[*corev1.ConfigMap]{},
+							ReflectChildStatusOnParent: func(ctx context.Context, parent *resources.TestResource, child *corev1.ConfigMap, err error) {
+								if err != nil {
+									return
+								}
+								stash.StoreValue(ctx, childKey, child)
+								parent.Status.Fields = reconcilers.MergeMaps(child.Data)
+								parent.Status.MarkReady(ctx)
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								return reconcilers.ErrHaltSubReconcilers
+							},
+						},
+						&reconcilers.SyncReconciler[*resources.TestResource]{
+							Sync: func(ctx context.Context, resource *resources.TestResource) error {
+								t.Error("should not be called after halt")
+								return nil
+							},
+						},
+					}
+				},
+			},
+			ExpectResource: resource.
+				SpecDie(func(d *dies.TestResourceSpecDie) {
+					d.AddField("foo", "bar")
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+					)
+					d.AddField("foo", "bar")
+				}).
+				DieReleasePtr(),
+			ExpectStashedValues: map[stash.Key]interface{}{
+				childKey: configMapCreate,
+			},
+			ExpectCreates: []client.Object{
+				configMapCreate,
+			},
+			ShouldErr: true,
+		},
 		"preserves result, Requeue": {
 			Resource: resource.DieReleasePtr(),
 			Metadata: map[string]interface{}{
@@ -339,6 +484,22 @@ func TestSequence_Validate(t *testing.T) {
 			},
 			shouldErr: `Sequence must have a valid Sequence[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
 		},
+		{
+			name: "multiple invalid reconcilers",
+			reconciler: &reconcilers.Sequence[*resources.TestResource]{
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+				&reconcilers.SyncReconciler[*resources.TestResource]{
+					// Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					// 	return nil
+					// },
+				},
+			},
+			shouldErr: `[Sequence must have a valid Sequence[0]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult, Sequence must have a valid Sequence[1]: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -23,9 +23,12 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/runtime/apis"
 )
 
 var _ SubReconciler[client.Object] = (*SyncReconciler[client.Object])(nil)
@@ -82,6 +85,30 @@ type SyncReconciler[Type client.Object] struct {
 	// +optional
 	FinalizeWithResult func(ctx context.Context, resource Type) (Result, error)
 
+	// Finalizer to clear from the reconciled resource after Finalize or FinalizeWithResult
+	// completes without error. When AddFinalizerDuringSync is true, the finalizer is also added
+	// to the reconciled resource before Sync is called.
+	//
+	// The finalizer is added and removed by patching the reconciled resource with the client
+	// that loaded it, see AddFinalizer and ClearFinalizer.
+	//
+	// +optional
+	Finalizer string
+
+	// AddFinalizerDuringSync indicates the Finalizer should be added to the reconciled resource
+	// before Sync is called. Requires Finalizer to be set.
+	//
+	// +optional
+	AddFinalizerDuringSync bool
+
+	// FreezeHappyConditionDuringFinalization indicates that marking dependent conditions from
+	// Finalize or FinalizeWithResult should not recompute the happy condition (typically Ready),
+	// see apis.WithConditionFinalizing. Conditions must be managed with the context passed to the
+	// finalize method.
+	//
+	// +optional
+	FreezeHappyConditionDuringFinalization bool
+
 	lazyInit sync.Once
 }
 
@@ -112,20 +139,27 @@ func (r *SyncReconciler[T]) init() {
 func (r *SyncReconciler[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Sync and SyncWithResult
 	if r.Sync == nil && r.SyncWithResult == nil {
-		return fmt.Errorf("SyncReconciler %q must implement Sync or SyncWithResult", r.Name)
+		errs = append(errs, fmt.Errorf("SyncReconciler %q must implement Sync or SyncWithResult", r.Name))
 	}
 	if r.Sync != nil && r.SyncWithResult != nil {
-		return fmt.Errorf("SyncReconciler %q may not implement both Sync and SyncWithResult", r.Name)
+		errs = append(errs, fmt.Errorf("SyncReconciler %q may not implement both Sync and SyncWithResult", r.Name))
 	}
 
 	// validate Finalize and FinalizeWithResult
 	if r.Finalize != nil && r.FinalizeWithResult != nil {
-		return fmt.Errorf("SyncReconciler %q may not implement both Finalize and FinalizeWithResult", r.Name)
+		errs = append(errs, fmt.Errorf("SyncReconciler %q may not implement both Finalize and FinalizeWithResult", r.Name))
+	}
+
+	// validate Finalizer
+	if r.AddFinalizerDuringSync && r.Finalizer == "" {
+		errs = append(errs, fmt.Errorf("SyncReconciler %q must define Finalizer when AddFinalizerDuringSync is true", r.Name))
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func (r *SyncReconciler[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
@@ -135,6 +169,12 @@ func (r *SyncReconciler[T]) Reconcile(ctx context.Context, resource T) (Result,
 
 	result := Result{}
 
+	if resource.GetDeletionTimestamp() == nil && r.AddFinalizerDuringSync {
+		if err := AddFinalizer(ctx, resource, r.Finalizer); err != nil {
+			return result, err
+		}
+	}
+
 	if resource.GetDeletionTimestamp() == nil || r.SyncDuringFinalization {
 		syncResult, err := r.sync(ctx, resource)
 		result = AggregateResults(result, syncResult)
@@ -155,6 +195,9 @@ func (r *SyncReconciler[T]) Reconcile(ctx context.Context, resource T) (Result,
 			}
 			return result, err
 		}
+		if err := ClearFinalizer(ctx, resource, r.Finalizer); err != nil {
+			return result, err
+		}
 	}
 
 	return result, nil
@@ -169,6 +212,9 @@ func (r *SyncReconciler[T]) sync(ctx context.Context, resource T) (Result, error
 }
 
 func (r *SyncReconciler[T]) finalize(ctx context.Context, resource T) (Result, error) {
+	if r.FreezeHappyConditionDuringFinalization {
+		ctx = apis.WithConditionFinalizing(ctx)
+	}
 	if r.Finalize != nil {
 		err := r.Finalize(ctx, resource)
 		return Result{}, err
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/internal/resources"
@@ -69,6 +70,40 @@ func TestSyncReconciler(t *testing.T) {
 				},
 			},
 		},
+		"sync has request and reconciler name": {
+			Resource:       resource.DieReleasePtr(),
+			ReconcilerName: "MyReconciler",
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if expected, actual := (types.NamespacedName{Namespace: testNamespace, Name: testName}), reconcilers.RetrieveRequest(ctx).NamespacedName; expected != actual {
+								t.Errorf("expected request %v in context, found %v", expected, actual)
+							}
+							if expected, actual := "MyReconciler", reconcilers.RetrieveReconcilerName(ctx); expected != actual {
+								t.Errorf("expected reconciler name %q in context, found %q", expected, actual)
+							}
+							return nil
+						},
+					}
+				},
+			},
+		},
+		"sync defaults reconciler name to the test name": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if expected, actual := "sync defaults reconciler name to the test name", reconcilers.RetrieveReconcilerName(ctx); expected != actual {
+								t.Errorf("expected reconciler name %q in context, found %q", expected, actual)
+							}
+							return nil
+						},
+					}
+				},
+			},
+		},
 		"sync with result halted": {
 			Resource: resource.DieReleasePtr(),
 			Metadata: map[string]interface{}{
@@ -240,6 +275,233 @@ func TestSyncReconciler(t *testing.T) {
 			},
 			ShouldErr: true,
 		},
+		"finalize recomputes the happy condition": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type("Dependent").Status(metav1.ConditionTrue).Reason("Available"),
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+					)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+						Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+							apis.NewLivingConditionSet("Dependent").ManageWithContext(ctx, &resource.Status).MarkFalse("Dependent", "Finalizing", "")
+							return nil
+						},
+					}
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type("Dependent").Status(metav1.ConditionFalse).Reason("Finalizing"),
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionFalse).Reason("Finalizing"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"finalize freezes the happy condition": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type("Dependent").Status(metav1.ConditionTrue).Reason("Available"),
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+					)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+						Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+							apis.NewLivingConditionSet("Dependent").ManageWithContext(ctx, &resource.Status).MarkFalse("Dependent", "Finalizing", "")
+							return nil
+						},
+						FreezeHappyConditionDuringFinalization: true,
+					}
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.ConditionsDie(
+						diemetav1.ConditionBlank.Type("Dependent").Status(metav1.ConditionFalse).Reason("Finalizing"),
+						diemetav1.ConditionBlank.Type(apis.ConditionReady).Status(metav1.ConditionTrue).Reason("Ready"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"add finalizer during sync": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Finalizer:              testFinalizer,
+						AddFinalizerDuringSync: true,
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							if len(resource.Finalizers) != 1 || resource.Finalizers[0] != testFinalizer {
+								t.Errorf("expected finalizer to be added before sync")
+							}
+							return nil
+						},
+					}
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Finalizers(testFinalizer)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test.finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"finalizer is not added during sync unless asked to": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Finalizer: testFinalizer,
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+					}
+				},
+			},
+		},
+		"error adding finalizer during sync": {
+			Resource: resource.DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "TestResource"),
+			},
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Finalizer:              testFinalizer,
+						AddFinalizerDuringSync: true,
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							t.Errorf("reconciler should not call sync when the finalizer cannot be added")
+							return nil
+						},
+					}
+				},
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "FinalizerPatchFailed",
+					`Failed to patch finalizer %q: inducing failure for patch TestResource`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["test.finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"clear finalizer after finalize": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Finalizer:              testFinalizer,
+						AddFinalizerDuringSync: true,
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							t.Errorf("reconciler should not call sync for deleted resources")
+							return nil
+						},
+						Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+					}
+				},
+			},
+			ExpectResource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.ResourceVersion("1000")
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeNormal, "FinalizerPatched",
+					`Patched finalizer %q`, testFinalizer),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "testing.reconciler.runtime",
+					Kind:      "TestResource",
+					Namespace: testNamespace,
+					Name:      testName,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+		},
+		"keep finalizer on finalize error": {
+			Resource: resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers(testFinalizer)
+				}).
+				DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"SubReconciler": func(t *testing.T, c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+					return &reconcilers.SyncReconciler[*resources.TestResource]{
+						Finalizer: testFinalizer,
+						Sync: func(ctx context.Context, resource *resources.TestResource) error {
+							return nil
+						},
+						Finalize: func(ctx context.Context, resource *resources.TestResource) error {
+							return fmt.Errorf("syncreconciler finalize error")
+						},
+					}
+				},
+			},
+			ShouldErr: true,
+		},
 		"context can be augmented in Prepare and accessed in Cleanup": {
 			Resource: resource.DieReleasePtr(),
 			Prepare: func(t *testing.T, ctx context.Context, tc *rtesting.SubReconcilerTestCase[*resources.TestResource]) (context.Context, error) {
@@ -428,6 +690,42 @@ func TestSyncReconciler_Validate(t *testing.T) {
 			},
 			shouldErr: `SyncReconciler "SyncReconciler" may not implement both Finalize and FinalizeWithResult`,
 		},
+		{
+			name:     "valid Finalizer",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.SyncReconciler[*corev1.ConfigMap]{
+				Sync: func(ctx context.Context, resource *corev1.ConfigMap) error {
+					return nil
+				},
+				Finalizer:              "test.finalizer",
+				AddFinalizerDuringSync: true,
+			},
+		},
+		{
+			name:     "invalid AddFinalizerDuringSync without Finalizer",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.SyncReconciler[*corev1.ConfigMap]{
+				Sync: func(ctx context.Context, resource *corev1.ConfigMap) error {
+					return nil
+				},
+				AddFinalizerDuringSync: true,
+			},
+			shouldErr: `SyncReconciler "SyncReconciler" must define Finalizer when AddFinalizerDuringSync is true`,
+		},
+		{
+			name:     "reports all violations",
+			resource: &corev1.ConfigMap{},
+			reconciler: &reconcilers.SyncReconciler[*corev1.ConfigMap]{
+				Sync: func(ctx context.Context, resource *corev1.ConfigMap) error {
+					return nil
+				},
+				SyncWithResult: func(ctx context.Context, resource *corev1.ConfigMap) (reconcilers.Result, error) {
+					return reconcilers.Result{}, nil
+				},
+				AddFinalizerDuringSync: true,
+			},
+			shouldErr: `[SyncReconciler "SyncReconciler" may not implement both Sync and SyncWithResult, SyncReconciler "SyncReconciler" must define Finalizer when AddFinalizerDuringSync is true]`,
+		},
 	}
 
 	for _, c := range tests {
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"reconciler.io/runtime/stash"
+	"reconciler.io/runtime/validation"
+)
+
+var _ SubReconciler[client.Object] = (*TimeoutReconciler[client.Object])(nil)
+
+// TimeoutError is returned by a TimeoutReconciler when the nested reconciler does not complete
+// within the timeout.
+type TimeoutError struct {
+	// Timeout is the duration the nested reconciler was allowed to run
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("reconciler did not complete within %s", e.Timeout)
+}
+
+// Unwrap returns context.DeadlineExceeded
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// TimeoutReconciler enforces a timeout on the nested reconciler. The nested reconciler is called
+// with a context that is canceled once the timeout elapses. When the nested reconciler does not
+// return in time, a Warning event is recorded for the reconciled resource and a TimeoutError is
+// returned, the request is then requeued.
+//
+// The nested reconciler runs on its own goroutine with a copy of the reconciled resource and a
+// copy of the stash. The mutations of the copies are applied to the reconciled resource and the
+// stash only when the nested reconciler returns within the timeout, its result is otherwise
+// ignored. A goroutine cannot be stopped from the outside, the nested reconciler must honor the
+// cancellation of its context, like passing the context to each client request, to return
+// promptly after the timeout. Once the timeout elapses, mutating requests made with the nested
+// reconciler's config are rejected.
+//
+// The time stashed for the request, see time.RetrieveNow, is unchanged for the nested reconciler.
+// The timeout is measured with the config's Clock, see Config.GetClock.
+type TimeoutReconciler[Type client.Object] struct {
+	// Name used to identify this reconciler.  Defaults to `TimeoutReconciler`.  Ideally unique, but
+	// not required to be so.
+	//
+	// +optional
+	Name string
+
+	// Setup performs initialization on the manager and builder this reconciler
+	// will run with. It's common to setup field indexes and watch resources.
+	//
+	// +optional
+	Setup func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error
+
+	// Timeout is the duration the nested reconciler is allowed to run for each request.
+	Timeout time.Duration
+
+	// Reconciler is called for each reconciler request with the reconciled
+	// resource being reconciled. Typically a Sequence is used to compose
+	// multiple SubReconcilers.
+	Reconciler SubReconciler[Type]
+
+	lazyInit sync.Once
+}
+
+func (r *TimeoutReconciler[T]) init() {
+	r.lazyInit.Do(func() {
+		if r.Name == "" {
+			r.Name = "TimeoutReconciler"
+		}
+	})
+}
+
+func (r *TimeoutReconciler[T]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+	if err := r.Reconciler.SetupWithManager(ctx, mgr, bldr); err != nil {
+		return err
+	}
+	if r.Setup == nil {
+		return nil
+	}
+	return r.Setup(ctx, mgr, bldr)
+}
+
+func (r *TimeoutReconciler[T]) Validate(ctx context.Context) error {
+	r.init()
+
+	errs := []error{}
+
+	// validate Timeout value
+	if r.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("TimeoutReconciler %q must define a positive Timeout", r.Name))
+	}
+
+	// validate Reconciler value
+	if r.Reconciler == nil {
+		errs = append(errs, fmt.Errorf("TimeoutReconciler %q must define Reconciler", r.Name))
+	}
+	if validation.IsRecursive(ctx) {
+		if v, ok := r.Reconciler.(validation.Validator); ok {
+			if err := v.Validate(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("TimeoutReconciler %q must have a valid Reconciler: %w", r.Name, err))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// timeoutOutcome holds the return values of the nested reconciler
+type timeoutOutcome struct {
+	result Result
+	err    error
+	panic  interface{}
+}
+
+func (r *TimeoutReconciler[T]) Reconcile(ctx context.Context, resource T) (Result, error) {
+	r.init()
+
+	log := logr.FromContextOrDiscard(ctx).
+		WithName(r.Name)
+	ctx = logr.NewContext(ctx, log)
+
+	c := RetrieveConfigOrDie(ctx)
+
+	nestedCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	rejectWrites := rejectWritesAfter(nestedCtx)
+	nestedCtx = StashConfig(nestedCtx, c.WithClientInterceptors(rejectWrites))
+	if oc, err := RetrieveOriginalConfig(ctx); err == nil {
+		nestedCtx = StashOriginalConfig(nestedCtx, oc.WithClientInterceptors(rejectWrites))
+	}
+	nestedCtx = stash.WithContext(nestedCtx)
+	stash.ReplaceValues(nestedCtx, ctx)
+
+	timer := c.GetClock().NewTimer(r.Timeout)
+	defer timer.Stop()
+
+	working := resource.DeepCopyObject().(T)
+	// buffered so the goroutine completes when its outcome is ignored
+	done := make(chan timeoutOutcome, 1)
+	go func() {
+		outcome := timeoutOutcome{}
+		defer func() {
+			outcome.panic = recover()
+			done <- outcome
+		}()
+		outcome.result, outcome.err = r.Reconciler.Reconcile(nestedCtx, working)
+	}()
+
+	select {
+	case outcome := <-done:
+		if outcome.panic != nil {
+			// continue the panic on the calling goroutine, for example to be recovered by a
+			// RecoverReconciler
+			panic(outcome.panic)
+		}
+		stash.ReplaceValues(ctx, nestedCtx)
+		reflect.ValueOf(resource).Elem().Set(reflect.ValueOf(working).Elem())
+		return outcome.result, outcome.err
+	case <-timer.C():
+		err := &TimeoutError{Timeout: r.Timeout}
+		// the nested reconciler may still be running, reject its writes from now on
+		cancel(err)
+		r.timedOut(ctx, resource, err)
+		return Result{}, err
+	case <-ctx.Done():
+		// the request was canceled before the timeout
+		return Result{}, ctx.Err()
+	}
+}
+
+func (r *TimeoutReconciler[T]) timedOut(ctx context.Context, resource T, err *TimeoutError) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	log.Error(err, "reconciler timed out", "timeout", r.Timeout)
+	pc := RetrieveOriginalConfigOrDie(ctx)
+	pc.RecorderFor(ctx).Eventf(resource, corev1.EventTypeWarning, "ReconcileTimeout",
+		"Reconciler did not complete within %s", r.Timeout)
+}
+
+// rejectWritesAfter returns interceptors that fail each mutating request once the context is
+// canceled, with the cause of the cancellation.
+func rejectWritesAfter(ctx context.Context) interceptor.Funcs {
+	rejected := func(verb string) error {
+		if err := context.Cause(ctx); err != nil {
+			return fmt.Errorf("%s rejected: %w", verb, err)
+		}
+		return nil
+	}
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if err := rejected("create"); err != nil {
+				return err
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if err := rejected("delete"); err != nil {
+				return err
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+		DeleteAllOf: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteAllOfOption) error {
+			if err := rejected("deletecollection"); err != nil {
+				return err
+			}
+			return c.DeleteAllOf(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if err := rejected("update"); err != nil {
+				return err
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if err := rejected("patch"); err != nil {
+				return err
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		Apply: func(ctx context.Context, c client.WithWatch, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+			if err := rejected("apply"); err != nil {
+				return err
+			}
+			return c.Apply(ctx, obj, opts...)
+		},
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			if err := rejected(fmt.Sprintf("create %s", subResourceName)); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if err := rejected(fmt.Sprintf("update %s", subResourceName)); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if err := rejected(fmt.Sprintf("patch %s", subResourceName)); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+		SubResourceApply: func(ctx context.Context, c client.Client, subResourceName string, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+			if err := rejected(fmt.Sprintf("apply %s", subResourceName)); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Apply(ctx, obj, opts...)
+		},
+	}
+}
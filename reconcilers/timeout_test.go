@@ -0,0 +1,323 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+	"reconciler.io/runtime/stash"
+	rtesting "reconciler.io/runtime/testing"
+	"reconciler.io/runtime/validation"
+)
+
+func TestTimeoutReconciler(t *testing.T) {
+	testNamespace := "test-namespace"
+	testName := "test-resource"
+
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(testNamespace)
+			d.Name(testName)
+		})
+
+	release := make(chan struct{})
+	written := make(chan error, 1)
+	stashed := make(chan struct{}, 1)
+
+	rts := rtesting.SubReconcilerTests[*resources.TestResource]{
+		"passes through the result": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Result": reconcilers.Result{RequeueAfter: time.Minute},
+			},
+			ExpectedResult: reconcilers.Result{RequeueAfter: time.Minute},
+		},
+		"passes through the error": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Err": fmt.Errorf("reconcile error"),
+			},
+			ShouldErr: true,
+		},
+		"applies mutations": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Mutate": true,
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Reconciler", "ran")
+				}).
+				DieReleasePtr(),
+		},
+		"times out when the context is honored": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Mutate": true,
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					clk.Step(time.Second)
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcileTimeout", "Reconciler did not complete within 10ms"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				var timeoutErr *reconcilers.TimeoutError
+				if !errors.As(err, &timeoutErr) {
+					t.Fatalf("expected TimeoutError, got %v", err)
+				}
+				if timeoutErr.Timeout != 10*time.Millisecond {
+					t.Errorf("expected timeout %s, got %s", 10*time.Millisecond, timeoutErr.Timeout)
+				}
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("expected error to wrap context.DeadlineExceeded, got %v", err)
+				}
+			},
+		},
+		"times out when the context is ignored": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Mutate": true,
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					clk.Step(time.Second)
+					<-release
+					return nil
+				},
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcileTimeout", "Reconciler did not complete within 10ms"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				// let the abandoned reconciler complete, its mutations must not be applied
+				close(release)
+				var timeoutErr *reconcilers.TimeoutError
+				if !errors.As(err, &timeoutErr) {
+					t.Fatalf("expected TimeoutError, got %v", err)
+				}
+			},
+		},
+		"completes before the timeout": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Mutate": true,
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					clk.Step(5 * time.Millisecond)
+					return nil
+				},
+			},
+			ExpectResource: resource.
+				StatusDie(func(d *dies.TestResourceStatusDie) {
+					d.AddField("Reconciler", "ran")
+				}).
+				DieReleasePtr(),
+		},
+		"rejects writes after the timeout": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					clk.Step(time.Second)
+					<-ctx.Done()
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					err := c.Create(ctx, resource.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("late")
+						}).
+						DieReleasePtr())
+					written <- err
+					return err
+				},
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcileTimeout", "Reconciler did not complete within 10ms"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				var timeoutErr *reconcilers.TimeoutError
+				if writeErr := <-written; !errors.As(writeErr, &timeoutErr) {
+					t.Errorf("expected the write to be rejected with a TimeoutError, got %v", writeErr)
+				}
+			},
+		},
+		"stashes values when completing in time": {
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]interface{}{
+				"given": "value",
+			},
+			Metadata: map[string]interface{}{
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					stash.StoreValue(ctx, "stored", stash.RetrieveValue(ctx, "given"))
+					return nil
+				},
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				"given":  "value",
+				"stored": "value",
+			},
+		},
+		"discards stashed values after the timeout": {
+			Resource: resource.DieReleasePtr(),
+			GivenStashedValues: map[stash.Key]interface{}{
+				"given": "value",
+			},
+			Metadata: map[string]interface{}{
+				"Wait": func(ctx context.Context, clk *clocktesting.FakeClock) error {
+					clk.Step(time.Second)
+					<-ctx.Done()
+					stash.ClearValue(ctx, "given")
+					stash.StoreValue(ctx, "stored", "value")
+					stashed <- struct{}{}
+					return nil
+				},
+			},
+			ShouldErr: true,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(resource, scheme, corev1.EventTypeWarning, "ReconcileTimeout", "Reconciler did not complete within 10ms"),
+			},
+			Verify: func(t *testing.T, result reconcilers.Result, err error) {
+				// wait for the abandoned reconciler to stash its values
+				<-stashed
+			},
+			ExpectStashedValues: map[stash.Key]interface{}{
+				"given":  "value",
+				"stored": nil,
+			},
+		},
+		"continues a panic": {
+			Resource: resource.DieReleasePtr(),
+			Metadata: map[string]interface{}{
+				"Panic": "boom",
+			},
+			ShouldPanic: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		clk := clocktesting.NewFakeClock(time.Now())
+		timeout := &reconcilers.TimeoutReconciler[*resources.TestResource]{
+			Timeout: 10 * time.Millisecond,
+			Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{
+				SyncWithResult: func(ctx context.Context, resource *resources.TestResource) (reconcilers.Result, error) {
+					if p, ok := rtc.Metadata["Panic"]; ok {
+						panic(p)
+					}
+					if _, ok := rtc.Metadata["Mutate"]; ok {
+						resource.Status.Fields = map[string]string{"Reconciler": "ran"}
+					}
+					if wait, ok := rtc.Metadata["Wait"]; ok {
+						if err := wait.(func(context.Context, *clocktesting.FakeClock) error)(ctx, clk); err != nil {
+							return reconcilers.Result{}, err
+						}
+					}
+					var result reconcilers.Result
+					if r, ok := rtc.Metadata["Result"]; ok {
+						result = r.(reconcilers.Result)
+					}
+					var err error
+					if e, ok := rtc.Metadata["Err"]; ok {
+						err = e.(error)
+					}
+					return result, err
+				},
+			},
+		}
+		return &reconcilers.WithConfig[*resources.TestResource]{
+			Config: func(ctx context.Context, c reconcilers.Config) (reconcilers.Config, error) {
+				c.Clock = clk
+				return c, nil
+			},
+			Reconciler: timeout,
+		}
+	})
+}
+
+func TestTimeoutReconciler_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler *reconcilers.TimeoutReconciler[*resources.TestResource]
+		shouldErr  string
+	}{
+		{
+			name: "valid",
+			reconciler: &reconcilers.TimeoutReconciler[*resources.TestResource]{
+				Timeout:    time.Second,
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+		},
+		{
+			name: "missing timeout",
+			reconciler: &reconcilers.TimeoutReconciler[*resources.TestResource]{
+				Name:       "missing timeout",
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+			shouldErr: `TimeoutReconciler "missing timeout" must define a positive Timeout`,
+		},
+		{
+			name: "negative timeout",
+			reconciler: &reconcilers.TimeoutReconciler[*resources.TestResource]{
+				Name:       "negative timeout",
+				Timeout:    -time.Second,
+				Reconciler: reconcilers.Sequence[*resources.TestResource]{},
+			},
+			shouldErr: `TimeoutReconciler "negative timeout" must define a positive Timeout`,
+		},
+		{
+			name: "missing reconciler",
+			reconciler: &reconcilers.TimeoutReconciler[*resources.TestResource]{
+				Name:    "missing reconciler",
+				Timeout: time.Second,
+			},
+			shouldErr: `TimeoutReconciler "missing reconciler" must define Reconciler`,
+		},
+		{
+			name: "invalid reconciler",
+			reconciler: &reconcilers.TimeoutReconciler[*resources.TestResource]{
+				Name:       "invalid reconciler",
+				Timeout:    time.Second,
+				Reconciler: &reconcilers.SyncReconciler[*resources.TestResource]{},
+			},
+			shouldErr: `TimeoutReconciler "invalid reconciler" must have a valid Reconciler: SyncReconciler "SyncReconciler" must implement Sync or SyncWithResult`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := validation.WithRecursive(context.TODO())
+			err := c.reconciler.Validate(ctx)
+			if (err != nil) != (c.shouldErr != "") || (c.shouldErr != "" && c.shouldErr != err.Error()) {
+				t.Errorf("validate() error = %q, shouldErr %q", err, c.shouldErr)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"reconciler.io/runtime/internal"
 	"reconciler.io/runtime/stash"
 	rtime "reconciler.io/runtime/time"
@@ -120,19 +121,21 @@ func (r *AdmissionWebhookAdapter[T]) init() {
 func (r *AdmissionWebhookAdapter[T]) Validate(ctx context.Context) error {
 	r.init()
 
+	errs := []error{}
+
 	// validate Reconciler value
 	if r.Reconciler == nil {
-		return fmt.Errorf("AdmissionWebhookAdapter %q must define Reconciler", r.Name)
+		errs = append(errs, fmt.Errorf("AdmissionWebhookAdapter %q must define Reconciler", r.Name))
 	}
 	if validation.IsRecursive(ctx) {
 		if v, ok := r.Reconciler.(validation.Validator); ok {
 			if err := v.Validate(ctx); err != nil {
-				return fmt.Errorf("AdmissionWebhookAdapter %q must have a valid Reconciler: %w", r.Name, err)
+				errs = append(errs, fmt.Errorf("AdmissionWebhookAdapter %q must have a valid Reconciler: %w", r.Name, err))
 			}
 		}
 	}
 
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 // Deprecated use BuildWithContext
@@ -221,6 +224,7 @@ func (r *AdmissionWebhookAdapter[T]) Handle(ctx context.Context, req admission.R
 			Name:      req.Name,
 		},
 	})
+	ctx = StashReconcilerName(ctx, r.Name)
 
 	if err := r.reconcile(ctx, req, resp); err != nil {
 		if !errors.Is(err, ErrQuiet) {
@@ -250,6 +254,7 @@ func (r *AdmissionWebhookAdapter[T]) reconcile(ctx context.Context, req admissio
 	if err := json.Unmarshal(resourceBytes, resource); err != nil {
 		return err
 	}
+	ctx = StashOriginalResource(ctx, resource.DeepCopyObject().(T))
 
 	if defaulter, ok := client.Object(resource).(validation.Defaulter); ok {
 		// resource.Default(ctx, resource)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 )
 
 const stashNonce string = "controller-stash-nonce"
@@ -63,6 +64,16 @@ func ClearValue(ctx context.Context, key Key) interface{} {
 	return value
 }
 
+// ReplaceValues replaces the values stashed in the dst context with the values stashed in the src
+// context. Values stashed in dst that are not stashed in src are cleared. The values themselves
+// are not copied.
+func ReplaceValues(dst, src context.Context) {
+	values := maps.Clone(retrieveStashMap(src))
+	stash := retrieveStashMap(dst)
+	clear(stash)
+	maps.Copy(stash, values)
+}
+
 // Stasher stores and retrieves values from the stash context. The context which gets passed to its methods must be configured
 // with a stash via WithStash(). The stash is pre-configured for the context within a reconciler.
 type Stasher[T any] interface {
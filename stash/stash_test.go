@@ -92,6 +92,40 @@ func TestStash_RetrieveValue_Undefined(t *testing.T) {
 	}
 }
 
+func TestStash_ReplaceValues(t *testing.T) {
+	src := WithContext(context.Background())
+	dst := WithContext(context.Background())
+
+	StoreValue(src, "replaced", "src")
+	StoreValue(src, "added", "src")
+	StoreValue(dst, "replaced", "dst")
+	StoreValue(dst, "cleared", "dst")
+
+	ReplaceValues(dst, src)
+
+	if expected, actual := "src", RetrieveValue(dst, "replaced"); expected != actual {
+		t.Errorf("unexpected replaced value, actually = %v, expected = %v", actual, expected)
+	}
+	if expected, actual := "src", RetrieveValue(dst, "added"); expected != actual {
+		t.Errorf("unexpected added value, actually = %v, expected = %v", actual, expected)
+	}
+	if HasValue(dst, "cleared") {
+		t.Error("expected value not stashed in src to be cleared")
+	}
+
+	// the stashes remain independent
+	StoreValue(dst, "added", "dst")
+	if expected, actual := "src", RetrieveValue(src, "added"); expected != actual {
+		t.Errorf("unexpected src value, actually = %v, expected = %v", actual, expected)
+	}
+
+	// replacing a stash with itself preserves the values
+	ReplaceValues(src, src)
+	if expected, actual := "src", RetrieveValue(src, "replaced"); expected != actual {
+		t.Errorf("unexpected value after self replace, actually = %v, expected = %v", actual, expected)
+	}
+}
+
 func TestStasher(t *testing.T) {
 	ctx := WithContext(context.Background())
 	stasher := New[string]("my-key")
@@ -101,6 +135,11 @@ func TestStasher(t *testing.T) {
 	}
 
 	t.Run("no value", func(t *testing.T) {
+		t.Run("Has", func(t *testing.T) {
+			if stasher.Has(ctx) {
+				t.Error("expected value to be absent")
+			}
+		})
 		t.Run("RetrieveOrEmpty", func(t *testing.T) {
 			if value := stasher.RetrieveOrEmpty(ctx); value != "" {
 				t.Error("expected value to be empty")
@@ -127,6 +166,11 @@ func TestStasher(t *testing.T) {
 
 	t.Run("has value", func(t *testing.T) {
 		stasher.Store(ctx, "hello world")
+		t.Run("Has", func(t *testing.T) {
+			if !stasher.Has(ctx) {
+				t.Error("expected value to be present")
+			}
+		})
 		t.Run("RetrieveOrEmpty", func(t *testing.T) {
 			if value := stasher.RetrieveOrEmpty(ctx); value != "hello world" {
 				t.Errorf("expected value to be %q got %q", "hello world", value)
@@ -146,6 +190,22 @@ func TestStasher(t *testing.T) {
 		})
 	})
 
+	t.Run("empty value", func(t *testing.T) {
+		stasher.Store(ctx, "")
+		t.Run("Has", func(t *testing.T) {
+			if !stasher.Has(ctx) {
+				t.Error("expected empty value to be present")
+			}
+		})
+		t.Run("RetrieveOrError", func(t *testing.T) {
+			if value, err := stasher.RetrieveOrError(ctx); err != nil {
+				t.Errorf("unexpected err: %s", err)
+			} else if value != "" {
+				t.Errorf("expected value to be empty got %q", value)
+			}
+		})
+	})
+
 	t.Run("context scoped", func(t *testing.T) {
 		stasher.Store(ctx, "hello world")
 		if value := stasher.RetrieveOrEmpty(ctx); value != "hello world" {
@@ -166,5 +226,26 @@ func TestStasher(t *testing.T) {
 		if value := stasher.RetrieveOrEmpty(ctx); value != "" {
 			t.Error("expected value to be empty")
 		}
+		if stasher.Has(ctx) {
+			t.Error("expected value to be absent")
+		}
 	})
 }
+
+func TestStasher_Has_NilValue(t *testing.T) {
+	ctx := WithContext(context.Background())
+	stasher := New[*string]("my-key")
+
+	if stasher.Has(ctx) {
+		t.Error("expected value to be absent")
+	}
+	stasher.Store(ctx, nil)
+	if !stasher.Has(ctx) {
+		t.Error("expected nil value to be present")
+	}
+	if value, err := stasher.RetrieveOrError(ctx); err != nil {
+		t.Errorf("unexpected err: %s", err)
+	} else if value != nil {
+		t.Errorf("expected value to be nil got %v", value)
+	}
+}
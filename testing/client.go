@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"time"
 
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	clientgotesting "k8s.io/client-go/testing"
 	ref "k8s.io/client-go/tools/reference"
 	"reconciler.io/runtime/duck"
@@ -41,50 +43,67 @@ type TestClient interface {
 }
 
 type clientWrapper struct {
-	client                  client.Client
-	tracker                 clientgotesting.ObjectTracker
-	ApplyActions            []ApplyAction
-	CreateActions           []objectAction
-	UpdateActions           []objectAction
-	PatchActions            []PatchAction
-	DeleteActions           []DeleteAction
-	DeleteCollectionActions []DeleteCollectionAction
-	StatusUpdateActions     []objectAction
-	StatusPatchActions      []PatchAction
-	StatusApplyActions      []ApplyAction
-	genCount                int
-	reactionChain           []Reactor
+	client                   client.Client
+	tracker                  clientgotesting.ObjectTracker
+	GetActions               []GetAction
+	ListActions              []ListAction
+	ApplyActions             []ApplyAction
+	CreateActions            []objectAction
+	UpdateActions            []objectAction
+	PatchActions             []PatchAction
+	PatchResults             []client.Object
+	DeleteActions            []DeleteAction
+	DeleteCollectionActions  []DeleteCollectionAction
+	StatusUpdateActions      []objectAction
+	StatusPatchActions       []PatchAction
+	StatusPatchResults       []client.Object
+	StatusApplyActions       []ApplyAction
+	ScaleUpdateActions       []objectAction
+	ScalePatchActions        []PatchAction
+	SubResourceCreateActions []objectAction
+	SubResourceUpdateActions []objectAction
+	SubResourcePatchActions  []PatchAction
+	MutatingActions          []Action
+	genCount                 int
+	nameGenerator            func(obj client.Object) string
+	uidGenerator             func(obj client.Object) types.UID
+	defaulter                func(obj client.Object)
+	reactionChain            []Reactor
+	restrictToNamespace      string
+	namespaceViolations      []Action
 }
 
 var _ TestClient = (*clientWrapper)(nil)
 
 func NewFakeClientWrapper(client client.Client, tracker clientgotesting.ObjectTracker) *clientWrapper {
 	c := &clientWrapper{
-		client:                  client,
-		tracker:                 tracker,
-		ApplyActions:            []ApplyAction{},
-		CreateActions:           []objectAction{},
-		UpdateActions:           []objectAction{},
-		PatchActions:            []PatchAction{},
-		DeleteActions:           []DeleteAction{},
-		DeleteCollectionActions: []DeleteCollectionAction{},
-		StatusUpdateActions:     []objectAction{},
-		StatusPatchActions:      []PatchAction{},
-		StatusApplyActions:      []ApplyAction{},
-		genCount:                0,
-		reactionChain:           []Reactor{},
-	}
-	// generate names on create
+		client:                   client,
+		tracker:                  tracker,
+		GetActions:               []GetAction{},
+		ListActions:              []ListAction{},
+		ApplyActions:             []ApplyAction{},
+		CreateActions:            []objectAction{},
+		UpdateActions:            []objectAction{},
+		PatchActions:             []PatchAction{},
+		DeleteActions:            []DeleteAction{},
+		DeleteCollectionActions:  []DeleteCollectionAction{},
+		StatusUpdateActions:      []objectAction{},
+		StatusPatchActions:       []PatchAction{},
+		StatusApplyActions:       []ApplyAction{},
+		ScaleUpdateActions:       []objectAction{},
+		ScalePatchActions:        []PatchAction{},
+		SubResourceCreateActions: []objectAction{},
+		SubResourceUpdateActions: []objectAction{},
+		SubResourcePatchActions:  []PatchAction{},
+		MutatingActions:          []Action{},
+		genCount:                 0,
+		reactionChain:            []Reactor{},
+	}
+	// generate names and uids on create
 	c.AddReactor("create", "*", func(action Action) (bool, runtime.Object, error) {
 		if createAction, ok := action.(CreateAction); ok && action.GetSubresource() == "" {
-			obj := createAction.GetObject()
-			if objmeta, ok := obj.(metav1.Object); ok {
-				if objmeta.GetName() == "" && objmeta.GetGenerateName() != "" {
-					c.genCount++
-					// mutate the existing obj
-					objmeta.SetName(fmt.Sprintf("%s%03d", objmeta.GetGenerateName(), c.genCount))
-				}
-			}
+			// mutate the existing obj
+			c.generate(createAction.GetObject())
 		}
 		// never handle the action
 		return false, nil, nil
@@ -92,17 +111,52 @@ func NewFakeClientWrapper(client client.Client, tracker clientgotesting.ObjectTr
 	return c
 }
 
+// generate sets the name and uid of an object being created, when not already defined
+func (w *clientWrapper) generate(o runtime.Object) {
+	obj, ok := o.(client.Object)
+	if !ok {
+		return
+	}
+	if obj.GetName() == "" && obj.GetGenerateName() != "" {
+		if w.nameGenerator != nil {
+			obj.SetName(w.nameGenerator(obj))
+		} else {
+			w.genCount++
+			obj.SetName(fmt.Sprintf("%s%03d", obj.GetGenerateName(), w.genCount))
+		}
+	}
+	if obj.GetUID() == "" && w.uidGenerator != nil {
+		obj.SetUID(w.uidGenerator(obj))
+	}
+}
+
+// NewSequentialUIDGenerator returns a UIDGenerator that assigns UIDs from a counter, starting at
+// 1 for each generator. UIDs are formatted as a UUID, like `00000000-0000-0000-0000-000000000001`.
+func NewSequentialUIDGenerator() func(obj client.Object) types.UID {
+	count := 0
+	return func(obj client.Object) types.UID {
+		count++
+		return types.UID(fmt.Sprintf("00000000-0000-0000-0000-%012d", count))
+	}
+}
+
 func prepareObjects(objs []client.Object) []client.Object {
 	o := make([]client.Object, len(objs))
 	for i := range objs {
-		obj := objs[i].DeepCopyObject().(client.Object)
+		o[i] = objs[i].DeepCopyObject().(client.Object)
+	}
+	return defaultObjects(o)
+}
+
+// defaultObjects mutates each object in place, the caller is responsible for copying objects.
+func defaultObjects(objs []client.Object) []client.Object {
+	for _, obj := range objs {
 		// default to a non-zero creation timestamp
 		if obj.GetCreationTimestamp().Time.IsZero() {
 			obj.SetCreationTimestamp(metav1.NewTime(time.UnixMilli(1000)))
 		}
-		o[i] = obj
 	}
-	return o
+	return objs
 }
 
 func (w *clientWrapper) AddGiven(objs ...client.Object) {
@@ -140,6 +194,9 @@ func (w *clientWrapper) objmeta(obj runtime.Object) (schema.GroupVersionResource
 }
 
 func (w *clientWrapper) react(action Action) error {
+	if namespace := action.GetNamespace(); w.restrictToNamespace != "" && namespace != "" && namespace != w.restrictToNamespace {
+		w.namespaceViolations = append(w.namespaceViolations, action)
+	}
 	for _, reactor := range w.reactionChain {
 		if !reactor.Handles(action) {
 			continue
@@ -153,6 +210,11 @@ func (w *clientWrapper) react(action Action) error {
 	return nil
 }
 
+// record captures a mutating action in the order the actions were requested, across verbs
+func (w *clientWrapper) record(action Action) {
+	w.MutatingActions = append(w.MutatingActions, action)
+}
+
 func (w *clientWrapper) Scheme() *runtime.Scheme {
 	return w.client.Scheme()
 }
@@ -170,13 +232,17 @@ func (w *clientWrapper) IsObjectNamespaced(obj runtime.Object) (bool, error) {
 }
 
 func (w *clientWrapper) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
-	gvr, namespace, name, err := w.objmeta(obj)
+	gvr, _, _, err := w.objmeta(obj)
 	if err != nil {
 		return err
 	}
 
+	// capture action, the object being read into is typically empty so the key is authoritative
+	getAction := clientgotesting.NewGetAction(gvr, key.Namespace, key.Name)
+	w.GetActions = append(w.GetActions, getAction)
+
 	// call reactor chain
-	err = w.react(clientgotesting.NewGetAction(gvr, namespace, name))
+	err = w.react(getAction)
 	if err != nil {
 		return err
 	}
@@ -199,8 +265,24 @@ func (w *clientWrapper) List(ctx context.Context, list client.ObjectList, opts .
 		opt.ApplyToList(listopts)
 	}
 
+	labels := ""
+	if s := listopts.LabelSelector; s != nil && !s.Empty() {
+		labels = s.String()
+	}
+	fields := ""
+	if s := listopts.FieldSelector; s != nil && !s.Empty() {
+		fields = s.String()
+	}
+
+	// capture action
+	listAction := clientgotesting.NewListAction(gvr, gvk, listopts.Namespace, metav1.ListOptions{
+		LabelSelector: labels,
+		FieldSelector: fields,
+	})
+	w.ListActions = append(w.ListActions, listAction)
+
 	// call reactor chain
-	err = w.react(clientgotesting.NewListAction(gvr, gvk, listopts.Namespace, metav1.ListOptions{}))
+	err = w.react(listAction)
 	if err != nil {
 		return err
 	}
@@ -211,6 +293,7 @@ func (w *clientWrapper) List(ctx context.Context, list client.ObjectList, opts .
 func (w *clientWrapper) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
 	// capture action
 	w.ApplyActions = append(w.ApplyActions, NewApplyAction(obj))
+	w.record(NewApplyAction(obj))
 
 	// call reactor chain
 	if err := w.react(NewApplyAction(obj)); err != nil {
@@ -228,6 +311,7 @@ func (w *clientWrapper) Create(ctx context.Context, obj client.Object, opts ...c
 
 	// capture action
 	w.CreateActions = append(w.CreateActions, clientgotesting.NewCreateAction(gvr, namespace, obj.DeepCopyObject()))
+	w.record(clientgotesting.NewCreateAction(gvr, namespace, obj.DeepCopyObject()))
 
 	// call reactor chain
 	err = w.react(clientgotesting.NewCreateAction(gvr, namespace, obj))
@@ -235,6 +319,11 @@ func (w *clientWrapper) Create(ctx context.Context, obj client.Object, opts ...c
 		return err
 	}
 
+	// simulate defaulting admission webhooks
+	if w.defaulter != nil {
+		w.defaulter(obj)
+	}
+
 	return w.client.Create(ctx, obj, opts...)
 }
 
@@ -246,6 +335,7 @@ func (w *clientWrapper) Delete(ctx context.Context, obj client.Object, opts ...c
 
 	// capture action
 	w.DeleteActions = append(w.DeleteActions, clientgotesting.NewDeleteAction(gvr, namespace, name))
+	w.record(clientgotesting.NewDeleteAction(gvr, namespace, name))
 
 	// call reactor chain
 	err = w.react(clientgotesting.NewDeleteAction(gvr, namespace, name))
@@ -264,6 +354,7 @@ func (w *clientWrapper) Update(ctx context.Context, obj client.Object, opts ...c
 
 	// capture action
 	w.UpdateActions = append(w.UpdateActions, clientgotesting.NewUpdateAction(gvr, namespace, obj.DeepCopyObject()))
+	w.record(clientgotesting.NewUpdateAction(gvr, namespace, obj.DeepCopyObject()))
 
 	// call reactor chain
 	err = w.react(clientgotesting.NewUpdateAction(gvr, namespace, obj))
@@ -286,6 +377,7 @@ func (w *clientWrapper) Patch(ctx context.Context, obj client.Object, patch clie
 
 	// capture action
 	w.PatchActions = append(w.PatchActions, clientgotesting.NewPatchAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b))
+	w.record(clientgotesting.NewPatchAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b))
 
 	// call reactor chain
 	err = w.react(clientgotesting.NewPatchAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b))
@@ -293,7 +385,14 @@ func (w *clientWrapper) Patch(ctx context.Context, obj client.Object, patch clie
 		return err
 	}
 
-	return w.client.Patch(ctx, obj, patch, opts...)
+	if err := w.client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+
+	// capture the resource as persisted after the patch is applied
+	w.PatchResults = append(w.PatchResults, obj.DeepCopyObject().(client.Object))
+
+	return nil
 }
 
 func (w *clientWrapper) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
@@ -315,10 +414,12 @@ func (w *clientWrapper) DeleteAllOf(ctx context.Context, obj client.Object, opts
 	}
 
 	// capture action
-	w.DeleteCollectionActions = append(w.DeleteCollectionActions, clientgotesting.NewDeleteCollectionAction(gvr, deleteopts.Namespace, metav1.ListOptions{
+	deleteCollectionAction := clientgotesting.NewDeleteCollectionAction(gvr, deleteopts.Namespace, metav1.ListOptions{
 		LabelSelector: labels,
 		FieldSelector: fields,
-	}))
+	})
+	w.DeleteCollectionActions = append(w.DeleteCollectionActions, deleteCollectionAction)
+	w.record(deleteCollectionAction)
 
 	// call reactor chain
 	err = w.react(clientgotesting.NewDeleteCollectionAction(gvr, deleteopts.Namespace, metav1.ListOptions{
@@ -371,6 +472,7 @@ func (w *statusWriterWrapper) Update(ctx context.Context, obj client.Object, opt
 
 	// capture action
 	w.clientWrapper.StatusUpdateActions = append(w.clientWrapper.StatusUpdateActions, clientgotesting.NewUpdateSubresourceAction(gvr, "status", namespace, obj.DeepCopyObject()))
+	w.clientWrapper.record(clientgotesting.NewUpdateSubresourceAction(gvr, "status", namespace, obj.DeepCopyObject()))
 
 	// call reactor chain
 	err = w.clientWrapper.react(clientgotesting.NewUpdateSubresourceAction(gvr, "status", namespace, obj))
@@ -393,6 +495,7 @@ func (w *statusWriterWrapper) Patch(ctx context.Context, obj client.Object, patc
 
 	// capture action
 	w.clientWrapper.StatusPatchActions = append(w.clientWrapper.StatusPatchActions, clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, "status"))
+	w.clientWrapper.record(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, "status"))
 
 	// call reactor chain
 	err = w.clientWrapper.react(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, "status"))
@@ -400,12 +503,20 @@ func (w *statusWriterWrapper) Patch(ctx context.Context, obj client.Object, patc
 		return err
 	}
 
-	return w.statusWriter.Patch(ctx, obj, patch, opts...)
+	if err := w.statusWriter.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+
+	// capture the resource as persisted after the status patch is applied
+	w.clientWrapper.StatusPatchResults = append(w.clientWrapper.StatusPatchResults, obj.DeepCopyObject().(client.Object))
+
+	return nil
 }
 
 func (w *statusWriterWrapper) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
 	// capture action
 	w.clientWrapper.StatusApplyActions = append(w.clientWrapper.StatusApplyActions, NewApplySubresourceAction(obj, "status"))
+	w.clientWrapper.record(NewApplySubresourceAction(obj, "status"))
 
 	// call reactor chain
 	if err := w.clientWrapper.react(NewApplySubresourceAction(obj, "status")); err != nil {
@@ -429,7 +540,12 @@ func (w *subResourceClientWrapper) Get(ctx context.Context, obj client.Object, s
 	}
 
 	// call reactor chain
-	return w.clientWrapper.react(clientgotesting.NewGetSubresourceAction(gvr, namespace, w.subResource, name))
+	err = w.clientWrapper.react(clientgotesting.NewGetSubresourceAction(gvr, namespace, w.subResource, name))
+	if err != nil || w.subResource != "scale" {
+		return err
+	}
+
+	return w.clientWrapper.client.SubResource(w.subResource).Get(ctx, obj, subResource, opts...)
 }
 
 func (w *subResourceClientWrapper) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
@@ -442,6 +558,10 @@ func (w *subResourceClientWrapper) Create(ctx context.Context, obj client.Object
 		return err
 	}
 
+	// capture action
+	w.clientWrapper.SubResourceCreateActions = append(w.clientWrapper.SubResourceCreateActions, clientgotesting.NewCreateSubresourceAction(gvr, name, w.subResource, namespace, subResource.DeepCopyObject()))
+	w.clientWrapper.record(clientgotesting.NewCreateSubresourceAction(gvr, name, w.subResource, namespace, subResource.DeepCopyObject()))
+
 	// call reactor chain
 	return w.clientWrapper.react(clientgotesting.NewCreateSubresourceAction(gvr, name, w.subResource, namespace, subResource))
 }
@@ -456,8 +576,33 @@ func (w *subResourceClientWrapper) Update(ctx context.Context, obj client.Object
 		return err
 	}
 
+	updateOpts := &client.SubResourceUpdateOptions{}
+	updateOpts.ApplyOptions(opts)
+	var body runtime.Object = obj
+	if updateOpts.SubResourceBody != nil {
+		body = updateOpts.SubResourceBody
+	}
+
+	if w.subResource != "scale" {
+		// capture action
+		w.clientWrapper.SubResourceUpdateActions = append(w.clientWrapper.SubResourceUpdateActions, clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body.DeepCopyObject()))
+		w.clientWrapper.record(clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body.DeepCopyObject()))
+
+		// call reactor chain
+		return w.clientWrapper.react(clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body))
+	}
+
+	// capture action
+	w.clientWrapper.ScaleUpdateActions = append(w.clientWrapper.ScaleUpdateActions, clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body.DeepCopyObject()))
+	w.clientWrapper.record(clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body.DeepCopyObject()))
+
 	// call reactor chain
-	return w.clientWrapper.react(clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, obj))
+	err = w.clientWrapper.react(clientgotesting.NewUpdateSubresourceAction(gvr, w.subResource, namespace, body))
+	if err != nil {
+		return err
+	}
+
+	return w.clientWrapper.client.SubResource(w.subResource).Update(ctx, obj, opts...)
 }
 
 func (w *subResourceClientWrapper) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
@@ -474,8 +619,26 @@ func (w *subResourceClientWrapper) Patch(ctx context.Context, obj client.Object,
 		return err
 	}
 
+	if w.subResource != "scale" {
+		// capture action
+		w.clientWrapper.SubResourcePatchActions = append(w.clientWrapper.SubResourcePatchActions, clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+		w.clientWrapper.record(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+
+		// call reactor chain
+		return w.clientWrapper.react(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+	}
+
+	// capture action
+	w.clientWrapper.ScalePatchActions = append(w.clientWrapper.ScalePatchActions, clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+	w.clientWrapper.record(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+
 	// call reactor chain
-	return w.clientWrapper.react(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+	err = w.clientWrapper.react(clientgotesting.NewPatchSubresourceAction(gvr, obj.GetNamespace(), obj.GetName(), patch.Type(), b, w.subResource))
+	if err != nil {
+		return err
+	}
+
+	return w.clientWrapper.client.SubResource(w.subResource).Patch(ctx, obj, patch, opts...)
 }
 
 func (w *subResourceClientWrapper) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
@@ -487,6 +650,68 @@ func (w *subResourceClientWrapper) Apply(ctx context.Context, obj runtime.ApplyC
 	return w.clientWrapper.react(NewApplySubresourceAction(obj, w.subResource))
 }
 
+// ScopedReactor is a ReactionFunc that is only called for requests matching the verb and resource.
+// Used in conjunction with reconciler test's WithReactorsFor field.
+//
+//	WithReactorsFor: []rtesting.ScopedReactor{
+//	   // Makes calls to delete a stream return a conflict error.
+//	   {Verb: "delete", Resource: "Stream", Reactor: func(action rtesting.Action) (bool, runtime.Object, error) {
+//	      return true, nil, apierrs.NewConflict(schema.GroupResource{}, "", fmt.Errorf("test conflict"))
+//	   }},
+//	},
+//
+// Naming a reactor enables asserting the number of times it is called with the test's
+// ExpectReactorCalls field.
+type ScopedReactor struct {
+	// Name identifies the reactor for ExpectReactorCalls. Calls to unnamed reactors are not
+	// counted.
+	//
+	// +optional
+	Name string
+	// Verb of the request to match, like "get", "create" or "delete". Use "*" to match any verb.
+	Verb string
+	// Resource of the request to match, identified by its kind, like "Stream". Use "*" to match
+	// any resource.
+	Resource string
+	// Reactor is called for each matching request.
+	Reactor ReactionFunc
+}
+
+// FieldIndex registers an index for a field of a resource with the fake client, enabling the
+// reconciler to list the resource with a field selector matching the field. Used in conjunction
+// with reconciler test's GivenFieldIndexes field.
+//
+//	GivenFieldIndexes: []rtesting.FieldIndex{
+//	   {Type: &corev1.Pod{}, Field: "spec.nodeName", Extract: func(obj client.Object) []string {
+//	      return []string{obj.(*corev1.Pod).Spec.NodeName}
+//	   }},
+//	},
+type FieldIndex struct {
+	// Type of the resource to index
+	Type client.Object
+	// Field is the name of the index, as used in a field selector
+	Field string
+	// Extract returns the values of the field for a resource
+	Extract client.IndexerFunc
+}
+
+// FieldIndexes collects the indexes registered with it as a client.FieldIndexer. The same
+// function a reconciler uses to register indexes with the manager's field indexer during setup
+// can register the indexes for a test:
+//
+//	indexes := rtesting.FieldIndexes{}
+//	_ = controllers.IndexFields(ctx, &indexes)
+//	...
+//	GivenFieldIndexes: indexes,
+type FieldIndexes []FieldIndex
+
+var _ client.FieldIndexer = (*FieldIndexes)(nil)
+
+func (f *FieldIndexes) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	*f = append(*f, FieldIndex{Type: obj, Field: field, Extract: extractValue})
+	return nil
+}
+
 // InduceFailure is used in conjunction with reconciler test's WithReactors field.
 // Tests that want to induce a failure in a testcase of a reconciler test would add:
 //
@@ -550,6 +775,57 @@ func CalledAtMostTimes(verb, kind string, maxCalls int) ReactionFunc {
 	}
 }
 
+// FailNTimes induces a failure for the first n calls to the client matching the verb and kind.
+// Subsequent calls pass through to the next reactor. The options are the same as InduceFailure.
+//
+//	WithReactors: []rtesting.ReactionFunc{
+//	   // Makes the first two calls to update a stream return an error.
+//	   rtesting.FailNTimes("update", "Stream", 2),
+//	},
+func FailNTimes(verb, kind string, n int, o ...InduceFailureOpts) ReactionFunc {
+	induceFailure := InduceFailure(verb, kind, o...)
+	callCount := 0
+	return func(action Action) (handled bool, ret runtime.Object, err error) {
+		if callCount >= n {
+			return false, nil, nil
+		}
+		handled, ret, err = induceFailure(action)
+		if handled {
+			callCount++
+		}
+		return handled, ret, err
+	}
+}
+
+// ConflictOnce returns a conflict error for the first call to the client matching the verb and
+// kind, as if the resource was modified concurrently. Subsequent calls pass through to the next
+// reactor. Useful for testing optimistic concurrency retries.
+//
+//	WithReactors: []rtesting.ReactionFunc{
+//	   // Makes the first call to update a stream return a conflict.
+//	   rtesting.ConflictOnce("update", "Stream"),
+//	},
+func ConflictOnce(verb, kind string) ReactionFunc {
+	called := false
+	return func(action Action) (handled bool, ret runtime.Object, err error) {
+		if called || !action.Matches(verb, kind) {
+			return false, nil, nil
+		}
+		called = true
+		name := ""
+		switch a := action.(type) {
+		case namedAction: // matches GetAction, PatchAction, DeleteAction
+			name = a.GetName()
+		case objectAction: // matches CreateAction, UpdateAction
+			if obj, ok := a.GetObject().(client.Object); ok {
+				name = obj.GetName()
+			}
+		}
+		gr := schema.GroupResource{Group: action.GetResource().Group, Resource: action.GetResource().Resource}
+		return true, nil, apierrs.NewConflict(gr, name, fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+}
+
 type namedAction interface {
 	Action
 	GetName() string
@@ -23,26 +23,52 @@ import (
 	"github.com/fatih/color"
 )
 
-func init() {
-	if _, ok := os.LookupEnv("COLOR_DIFF"); ok {
-		DiffAddedColor.EnableColor()
-		DiffRemovedColor.EnableColor()
-	}
-}
-
 var (
 	DiffAddedColor   = color.New(color.FgGreen)
 	DiffRemovedColor = color.New(color.FgRed)
 )
 
-func ColorizeDiff(diff string) string {
+// DisableColorDiff disables colorized diffs in assertion messages for all test cases. Individual
+// test cases may disable colorized diffs with the DisableColorDiff field.
+//
+// Diffs are only colorized when stdout is a terminal or the COLOR_DIFF environment variable is
+// set, and the NO_COLOR environment variable is not set.
+var DisableColorDiff = false
+
+// ColorizeDiff colors each added and removed line of a diff. Color is forced when the COLOR_DIFF
+// environment variable is set.
+func ColorizeDiff(d string) string {
+	added, removed := diffColors(false)
+	return colorize(d, added, removed)
+}
+
+// colorDiffEnv returns true when the COLOR_DIFF environment variable forces colorized diffs. The
+// NO_COLOR environment variable takes precedence, see https://no-color.org
+func colorDiffEnv() bool {
+	_, ok := os.LookupEnv("COLOR_DIFF")
+	return ok && os.Getenv("NO_COLOR") == ""
+}
+
+// diffColors returns the colors for added and removed lines. When color is forced, copies of
+// DiffAddedColor and DiffRemovedColor are enabled so the shared colors are not modified.
+func diffColors(colorDiff bool) (*color.Color, *color.Color) {
+	if !colorDiff && !colorDiffEnv() {
+		return DiffAddedColor, DiffRemovedColor
+	}
+	added, removed := *DiffAddedColor, *DiffRemovedColor
+	added.EnableColor()
+	removed.EnableColor()
+	return &added, &removed
+}
+
+func colorize(d string, added, removed *color.Color) string {
 	var b strings.Builder
-	for _, line := range strings.Split(diff, "\n") {
+	for _, line := range strings.Split(d, "\n") {
 		switch {
 		case strings.HasPrefix(line, "+"):
-			b.WriteString(DiffAddedColor.Sprint(line))
+			b.WriteString(added.Sprint(line))
 		case strings.HasPrefix(line, "-"):
-			b.WriteString(DiffRemovedColor.Sprint(line))
+			b.WriteString(removed.Sprint(line))
 		default:
 			b.WriteString(line)
 		}
@@ -50,3 +76,22 @@ func ColorizeDiff(diff string) string {
 	}
 	return b.String()
 }
+
+// diffLegend returns the legend for a diff in an assertion message
+func diffLegend(disableColor, colorDiff bool) string {
+	if disableColor || DisableColorDiff {
+		return "(-expected, +actual)"
+	}
+	added, removed := diffColors(colorDiff)
+	return "(" + removed.Sprint("-expected") + ", " + added.Sprint("+actual") + ")"
+}
+
+// colorizeDiff formats a diff for an assertion message, terminated with a newline consistent with
+// ColorizeDiff.
+func colorizeDiff(d string, disableColor, colorDiff bool) string {
+	if disableColor || DisableColorDiff {
+		return d + "\n"
+	}
+	added, removed := diffColors(colorDiff)
+	return colorize(d, added, removed)
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package testing
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -32,15 +36,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/version"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	clientgotesting "k8s.io/client-go/testing"
-	"k8s.io/utils/ptr"
+	rcmpopts "reconciler.io/runtime/cmpopts"
 	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/reconcilers"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // ExpectConfig encompasses the creation of a config object using given state, captures observed
@@ -66,20 +73,93 @@ type ExpectConfig struct {
 	// Interacting with a status sub-resource for a type not enumerated as having a status
 	// sub-resource will return a not found error.
 	StatusSubResourceTypes []client.Object
+	// NoStatusSubResourceTypes is a set of object types that do not support the status
+	// sub-resource, like a CustomResourceDefinition that does not enable it. For these types, the
+	// status is modified by updating or patching the main resource, which is captured by
+	// ExpectUpdates and ExpectPatches rather than ExpectStatusUpdates and ExpectStatusPatches.
+	// Interacting with the status sub-resource returns a not found error, as returned by the API
+	// Server.
+	//
+	// Types listed here are excluded from StatusSubResourceTypes, allowing a shared set of types
+	// to be narrowed for a specific test. Built-in Kubernetes types with a status sub-resource
+	// can not be listed.
+	//
+	// +optional
+	NoStatusSubResourceTypes []client.Object
 	// Differ methods to use to compare expected and actual values
 	Differ Differ
-
-	// GivenObjects build the kubernetes objects which are present at the onset of reconciliation
+	// DisableColorDiff prints diffs in assertion messages without color, regardless of the
+	// terminal and environment. See the package level DisableColorDiff.
+	DisableColorDiff bool
+	// ColorDiff prints diffs in assertion messages with color, regardless of the terminal. Color
+	// is also forced for every config by the COLOR_DIFF environment variable, unless NO_COLOR is
+	// set. DisableColorDiff takes precedence.
+	ColorDiff bool
+
+	// GivenObjects build the kubernetes objects which are present at the onset of reconciliation.
+	//
+	// The resourceVersion of a given object is preserved, objects without a resourceVersion are
+	// assigned "999" by the fake client's object tracker. Each update or patch increments the
+	// stored resourceVersion, which must therefore be numeric. A request made with a different
+	// resourceVersion than is stored fails with a conflict, as it would for a stale read.
 	GivenObjects []client.Object
-	// APIGivenObjects contains objects that are only available via an API reader instead of the normal cache
+	// APIGivenObjects contains objects that are only available via an API reader instead of the normal cache.
+	// Like GivenObjects, the resourceVersion of each object is preserved. Giving an older resourceVersion of
+	// an object than in GivenObjects simulates a stale read from the API reader.
 	APIGivenObjects []client.Object
+	// ShareGivenObjects skips copying given objects that are ready to be loaded into the fake
+	// client as is. An object is shared when its type is registered with the scheme (dies and other
+	// factories are always copied) and it defines both a creation timestamp and resource version.
+	// The fake client stores and returns its own copies, so the reconciler is never handed a shared
+	// object.
+	//
+	// Warning: shared objects are referenced by the config for its lifetime. Mutating a given object
+	// from a test, reactor or another test case while the config is in use can leak state between
+	// test cases. Only enable for suites whose given objects are treated as immutable.
+	ShareGivenObjects bool
+	// Defaulter simulates a defaulting admission webhook by mutating objects before they are
+	// persisted by the fake client. Given objects are defaulted before ducks are normalized.
+	// Created objects are defaulted after the create is recorded and the reactors are called, so
+	// ExpectCreates reflect the object as sent by the reconciler while the object returned to the
+	// reconciler, and stored by the fake client, is defaulted. Given objects are not shared when a
+	// Defaulter is defined.
+	Defaulter func(obj client.Object)
+	// GivenFieldIndexes registers each index with the fake client. A list with a field selector
+	// is resolved using the registered indexes, listing with a field selector for a field that is
+	// not indexed fails.
+	GivenFieldIndexes []FieldIndex
 	// WithClientBuilder allows a test to modify the fake client initialization.
 	WithClientBuilder func(*fake.ClientBuilder) *fake.ClientBuilder
+	// NameGenerator returns the name for a created object that defines a generateName without a
+	// name. Defaults to appending a three digit counter to the generateName, like `prefix-001`.
+	NameGenerator func(obj client.Object) string
+	// UIDGenerator returns the UID for a created object that does not define a UID. When not
+	// defined, created objects are not assigned a UID. NewSequentialUIDGenerator returns a
+	// deterministic counter based generator.
+	UIDGenerator func(obj client.Object) types.UID
 	// WithReactors installs each ReactionFunc into each fake clientset. ReactionFuncs intercept
 	// each call to the clientset providing the ability to mutate the resource or inject an error.
 	WithReactors []ReactionFunc
+	// WithReactorsFor installs each ScopedReactor into each fake clientset. The ReactionFunc is
+	// only called for requests matching the reactor's verb and resource. Reactors from
+	// WithReactors are called before reactors from WithReactorsFor.
+	WithReactorsFor []ScopedReactor
+	// WithClientInterceptors wraps the config's client with each interceptor, see
+	// reconcilers.Config.WithClientInterceptors. Interceptors are called before requests are
+	// recorded and before reactors, a request that an interceptor does not delegate is neither
+	// recorded nor reacted to.
+	WithClientInterceptors []interceptor.Funcs
 	// GivenAPIResources populates the fake discovery client and RESTMapper
 	GivenAPIResources []*metav1.APIResourceList
+	// ServerVersion is the version reported by the fake discovery client. Defaults to an empty
+	// version.
+	ServerVersion *version.Info
+	// WithDiscoveryReactors installs each ReactionFunc into the fake discovery client. Discovery
+	// requests are made with the "get" verb for the "version", "group" and "resource" resources,
+	// a discovery failure is induced with:
+	//
+	//	rtesting.InduceFailure("get", "version")
+	WithDiscoveryReactors []ReactionFunc
 	// GivenTracks provide a set of tracked resources to seed the tracker with
 	GivenTracks []TrackRequest
 
@@ -89,6 +169,18 @@ type ExpectConfig struct {
 	ExpectTracks []TrackRequest
 	// ExpectEvents holds the ordered list of events recorded during the reconciliation
 	ExpectEvents []Event
+	// ExpectEventsMatch holds the ordered list of matchers for events recorded during the
+	// reconciliation. Each matcher is compared with the event at the same index, and the number of
+	// recorded events must equal the number of matchers. When ExpectEvents is also defined, both
+	// expectations are asserted.
+	ExpectEventsMatch []EventMatcher
+	// ExpectEventsSource restricts ExpectEvents and ExpectEventsMatch to the events recorded by the
+	// named reconciler or the reconcilers nested within it, rather than all events recorded during
+	// the reconciliation. Events are named by the logger in the context of the recording
+	// reconciler, see Event.Source.
+	//
+	// +optional
+	ExpectEventsSource string
 	// ExpectApplies builds the ordered list of objects expected to be applied during reconciliation
 	ExpectApplies []ApplyRef
 	// ExpectCreates builds the ordered list of objects expected to be created during reconciliation
@@ -97,37 +189,108 @@ type ExpectConfig struct {
 	ExpectUpdates []client.Object
 	// ExpectPatches builds the ordered list of objects expected to be patched during reconciliation
 	ExpectPatches []PatchRef
+	// ExpectPatchResults builds the ordered list of objects expected to result from applying each
+	// patch during reconciliation to the stored resource. This allows asserting a patch by its
+	// effect rather than by its bytes. When ExpectPatches is not defined, the raw patches are not
+	// asserted. Only successful patches are recorded.
+	ExpectPatchResults []client.Object
 	// ExpectDeletes holds the ordered list of objects expected to be deleted during reconciliation
 	ExpectDeletes []DeleteRef
 	// ExpectDeleteCollections holds the ordered list of collections expected to be deleted during reconciliation
 	ExpectDeleteCollections []DeleteCollectionRef
+	// ExpectLists holds the ordered list of list requests expected to be made with the client
+	// during reconciliation, including the namespace, label selector and field selector of each
+	// request. The requests are not asserted when nil, use an empty slice to assert the client does
+	// not list resources. List requests made with the APIReader are not included.
+	ExpectLists []ListRef
 	// ExpectStatusUpdates builds the ordered list of objects whose status is updated during reconciliation
 	ExpectStatusUpdates []client.Object
 	// ExpectStatusPatches builds the ordered list of objects whose status is patched during reconciliation
 	ExpectStatusPatches []PatchRef
+	// ExpectStatusPatchResults builds the ordered list of objects expected to result from applying
+	// each status patch during reconciliation to the stored resource. When ExpectStatusPatches is
+	// not defined, the raw status patches are not asserted. Only successful patches are recorded.
+	ExpectStatusPatchResults []client.Object
+	// ExpectScaleUpdates builds the ordered list of scale sub-resources updated during
+	// reconciliation. The scale sub-resource is modeled for built-in types (e.g. Deployment,
+	// StatefulSet, etc).
+	ExpectScaleUpdates []client.Object
+	// ExpectScalePatches builds the ordered list of objects whose scale sub-resource is patched
+	// during reconciliation
+	ExpectScalePatches []PatchRef
 	// ExpectStatusApplies builds the ordered list of objects whose status is applied during reconciliation
 	ExpectStatusApplies []ApplyRef
+	// ExpectSubResourceCreates builds the ordered list of objects created for a sub-resource other
+	// than status and scale during reconciliation, like a Pod's eviction
+	ExpectSubResourceCreates []SubResourceRef
+	// ExpectSubResourceUpdates builds the ordered list of objects updated for a sub-resource other
+	// than status and scale during reconciliation
+	ExpectSubResourceUpdates []SubResourceRef
+	// ExpectSubResourcePatches builds the ordered list of objects whose sub-resource, other than
+	// status and scale, is patched during reconciliation. The sub-resource is identified by the
+	// PatchRef's SubResource field.
+	ExpectSubResourcePatches []PatchRef
+	// ExpectFinalizers holds the finalizers expected on objects after reconciliation. The
+	// finalizers are read from the object as persisted by the observed updates and patches, an
+	// object that no longer exists has no finalizers.
+	ExpectFinalizers []FinalizersRef
+	// ExpectActions holds the ordered timeline of mutating requests (apply, create, update, patch,
+	// delete and deletecollection, including for sub-resources) expected during reconciliation,
+	// across all verbs and resource types. Unlike the verb specific expectations, the timeline
+	// asserts the order of requests relative to each other, for example that a child is created
+	// before the status of the parent is updated. The timeline is not asserted when nil, use an
+	// empty slice to assert no mutating requests are made.
+	ExpectActions []ActionRef
+	// ExpectDiscoveryRequests holds the ordered list of calls expected to the discovery client
+	// during reconciliation. The calls are not asserted when nil, use an empty slice to assert the
+	// discovery client is not called.
+	ExpectDiscoveryRequests []DiscoveryRequest
+	// ExpectResources holds objects expected to be stored by the fake client after
+	// reconciliation, regardless of the requests that produced the stored state. Each object is
+	// fetched by its type, namespace and name and compared with the ResourceUpdate method of the
+	// Differ, server managed fields are ignored. An object that is not stored is compared with
+	// nil. Stored objects that are not listed are not asserted.
+	ExpectResources []client.Object
+	// ExpectNoAPIReaderAccess asserts that no objects are read with the APIReader. The APIReader
+	// bypasses the informer cache and is intended as a fallback for cache misses, reliance on it
+	// while reconciling is often unintentional.
+	ExpectNoAPIReaderAccess bool
+	// ExpectAPIReaderReads is the exact number of get and list requests expected against the
+	// APIReader. The number of reads is not asserted when nil.
+	ExpectAPIReaderReads *int
+	// RestrictToNamespace when defined asserts that each request made with the client or
+	// APIReader targets this namespace. Requests to another namespace are reported as failures,
+	// catching reconcilers that unintentionally read or write across namespaces. Requests
+	// without a namespace, like requests for cluster scoped resources, are not restricted. Use an
+	// additional config for a reconciler that intentionally works across namespaces.
+	//
+	// +optional
+	RestrictToNamespace string
+	// ExpectReactorCalls is the exact number of times each named ScopedReactor from
+	// WithReactorsFor is expected to be called, keyed by the reactor's name. A reactor is called
+	// for each request matching its verb and resource that is not handled by an earlier reactor.
+	// Named reactors that are not listed are not asserted, use zero to assert a reactor is not
+	// called.
+	//
+	// +optional
+	ExpectReactorCalls map[string]int
 
 	once           sync.Once
 	client         *clientWrapper
 	apiReader      *clientWrapper
-	discovery      *fakediscovery.FakeDiscovery
+	discovery      *discoveryWrapper
 	recorder       *eventRecorder
 	tracker        *mockTracker
 	observedErrors []string
+	reactorCallsMu sync.Mutex
+	reactorCalls   map[string]int
 }
 
 func (c *ExpectConfig) init() {
 	c.once.Do(func() {
 		// copy given objects to unwrap factories and prevent accidental mutations leaking between test cases
-		givenObjects := make([]client.Object, len(c.GivenObjects))
-		for i := range c.GivenObjects {
-			givenObjects[i] = c.GivenObjects[i].DeepCopyObject().(client.Object)
-		}
-		apiGivenObjects := make([]client.Object, len(c.APIGivenObjects))
-		for i := range c.APIGivenObjects {
-			apiGivenObjects[i] = c.APIGivenObjects[i].DeepCopyObject().(client.Object)
-		}
+		givenObjects := c.copyGivenObjects(c.GivenObjects)
+		apiGivenObjects := c.copyGivenObjects(c.APIGivenObjects)
 		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
 		for _, resources := range c.GivenAPIResources {
 			if resources == nil {
@@ -145,19 +308,36 @@ func (c *ExpectConfig) init() {
 			}
 		}
 
-		c.client = c.createClient(givenObjects, c.StatusSubResourceTypes, restMapper)
+		statusSubResourceTypes := c.statusSubResourceTypes()
+		c.client = c.createClient(givenObjects, statusSubResourceTypes, restMapper)
+		for i := range c.WithReactorsFor {
+			// in reverse order since we prepend
+			reactor := c.WithReactorsFor[len(c.WithReactorsFor)-1-i]
+			c.client.PrependReactor(reactor.Verb, reactor.Resource, c.countReactorCalls(reactor))
+		}
 		for i := range c.WithReactors {
 			// in reverse order since we prepend
 			reactor := c.WithReactors[len(c.WithReactors)-1-i]
 			c.client.PrependReactor("*", "*", reactor)
 		}
-		c.apiReader = c.createClient(apiGivenObjects, c.StatusSubResourceTypes, restMapper)
-		c.discovery = &fakediscovery.FakeDiscovery{
-			FakedServerVersion: &version.Info{},
-			Fake: &clientgotesting.Fake{
-				Resources: c.GivenAPIResources,
+		c.apiReader = c.createClient(apiGivenObjects, statusSubResourceTypes, restMapper)
+		serverVersion := version.Info{}
+		if c.ServerVersion != nil {
+			serverVersion = *c.ServerVersion
+		}
+		c.discovery = &discoveryWrapper{
+			FakeDiscovery: &fakediscovery.FakeDiscovery{
+				FakedServerVersion: &serverVersion,
+				Fake: &clientgotesting.Fake{
+					Resources: c.GivenAPIResources,
+				},
 			},
 		}
+		for i := range c.WithDiscoveryReactors {
+			// in reverse order since we prepend
+			reactor := c.WithDiscoveryReactors[len(c.WithDiscoveryReactors)-1-i]
+			c.discovery.PrependReactor("*", "*", reactor)
+		}
 		c.recorder = &eventRecorder{
 			events: []Event{},
 			scheme: c.Scheme,
@@ -177,6 +357,14 @@ func (c *ExpectConfig) configNameMsg() string {
 	return fmt.Sprintf(" for config %q", c.Name)
 }
 
+func (c *ExpectConfig) diffLegend() string {
+	return diffLegend(c.DisableColorDiff, c.ColorDiff)
+}
+
+func (c *ExpectConfig) colorizeDiff(d string) string {
+	return colorizeDiff(d, c.DisableColorDiff, c.ColorDiff)
+}
+
 func (c *ExpectConfig) createClient(objs []client.Object, statusSubResourceTypes []client.Object, restMapper meta.RESTMapper) *clientWrapper {
 	tracker := clientgotesting.NewObjectTracker(c.Scheme, scheme.Codecs.UniversalDecoder())
 
@@ -184,13 +372,80 @@ func (c *ExpectConfig) createClient(objs []client.Object, statusSubResourceTypes
 	builder.WithObjectTracker(tracker)
 	builder.WithScheme(c.Scheme)
 	builder.WithStatusSubresource(c.normalizeDucks(statusSubResourceTypes)...)
-	builder.WithObjects(prepareObjects(c.normalizeDucks(objs))...)
+	// objs are already copied by init
+	builder.WithObjects(defaultObjects(c.normalizeDucks(objs))...)
+	for _, index := range c.GivenFieldIndexes {
+		builder.WithIndex(c.normalizeDucks([]client.Object{index.Type})[0], index.Field, index.Extract)
+	}
 	if c.WithClientBuilder != nil {
 		builder = c.WithClientBuilder(builder)
 	}
 	builder.WithRESTMapper(restMapper)
 
-	return NewFakeClientWrapper(duck.NewDuckAwareClientWrapper(builder.Build()), tracker)
+	w := NewFakeClientWrapper(duck.NewDuckAwareClientWrapper(builder.Build()), tracker)
+	w.restrictToNamespace = c.RestrictToNamespace
+	w.nameGenerator = c.NameGenerator
+	w.uidGenerator = c.UIDGenerator
+	w.defaulter = c.Defaulter
+	return w
+}
+
+// statusSubResourceTypes returns the StatusSubResourceTypes that are not also listed as
+// NoStatusSubResourceTypes
+func (c *ExpectConfig) statusSubResourceTypes() []client.Object {
+	if len(c.NoStatusSubResourceTypes) == 0 {
+		return c.StatusSubResourceTypes
+	}
+	disabled := sets.New[schema.GroupVersionKind]()
+	for _, obj := range c.normalizeDucks(c.NoStatusSubResourceTypes) {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme)
+		if err != nil {
+			panic(err)
+		}
+		disabled.Insert(gvk)
+	}
+	enabled := []client.Object{}
+	for _, obj := range c.StatusSubResourceTypes {
+		gvk, err := apiutil.GVKForObject(c.normalizeDucks([]client.Object{obj})[0], c.Scheme)
+		if err != nil {
+			panic(err)
+		}
+		if disabled.Has(gvk) {
+			continue
+		}
+		enabled = append(enabled, obj)
+	}
+	return enabled
+}
+
+func (c *ExpectConfig) copyGivenObjects(objs []client.Object) []client.Object {
+	copies := make([]client.Object, len(objs))
+	for i := range objs {
+		if c.ShareGivenObjects && c.isShareable(objs[i]) {
+			copies[i] = objs[i]
+			continue
+		}
+		copies[i] = objs[i].DeepCopyObject().(client.Object)
+		if c.Defaulter != nil {
+			c.Defaulter(copies[i])
+		}
+	}
+	return copies
+}
+
+// isShareable returns true when the object is not a factory and will not be defaulted before it is
+// added to the fake client.
+func (c *ExpectConfig) isShareable(obj client.Object) bool {
+	if c.Defaulter != nil {
+		return false
+	}
+	if obj.GetCreationTimestamp().Time.IsZero() || obj.GetResourceVersion() == "" {
+		return false
+	}
+	if _, _, err := c.Scheme.ObjectKinds(obj); err != nil {
+		return false
+	}
+	return true
 }
 
 func (c *ExpectConfig) normalizeDucks(objs []client.Object) []client.Object {
@@ -222,14 +477,31 @@ func (c *ExpectConfig) Config() reconcilers.Config {
 		},
 		EventRecorder: c.recorder,
 		Tracker:       c.tracker,
-	}
+	}.WithClientInterceptors(c.WithClientInterceptors...)
 }
 
 func (c *ExpectConfig) errorf(t *testing.T, message string, args ...interface{}) {
 	if t != nil {
 		t.Errorf(message, args...)
 	}
-	c.observedErrors = append(c.observedErrors, fmt.Sprintf(message, args...))
+	name := c.Name
+	if name == "" {
+		name = "default"
+	}
+	// prefix with the config name to identify the config when multiple configs are asserted
+	c.observedErrors = append(c.observedErrors, fmt.Sprintf("[%s] %s", name, fmt.Sprintf(message, args...)))
+}
+
+// ObservedErrors returns the assertion failures observed by this config, in the order they were
+// observed. Each error is prefixed with the config's name in square brackets, like
+// `[default] ExpectEvents[0] not observed: ...`, to identify the config that observed the error
+// when multiple configs are asserted.
+func (c *ExpectConfig) ObservedErrors() []string {
+	c.init()
+
+	errs := make([]string, len(c.observedErrors))
+	copy(errs, c.observedErrors)
+	return errs
 }
 
 // AssertExpectations asserts all observed reconciler behavior matches the expected behavior
@@ -240,8 +512,78 @@ func (c *ExpectConfig) AssertExpectations(t *testing.T) {
 	c.init()
 
 	c.AssertClientExpectations(t)
+	c.AssertAPIReaderExpectations(t)
+	c.AssertNamespaceExpectations(t)
 	c.AssertRecorderExpectations(t)
 	c.AssertTrackerExpectations(t)
+	c.AssertDiscoveryExpectations(t)
+	c.AssertResourceExpectations(t)
+	c.AssertReactorExpectations(t)
+}
+
+// AssertAPIReaderExpectations asserts observed reads against the APIReader match the expected
+// reads
+func (c *ExpectConfig) AssertAPIReaderExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	reads := make([]Action, 0, len(c.apiReader.GetActions)+len(c.apiReader.ListActions))
+	for _, action := range c.apiReader.GetActions {
+		reads = append(reads, action)
+	}
+	for _, action := range c.apiReader.ListActions {
+		reads = append(reads, action)
+	}
+
+	if c.ExpectNoAPIReaderAccess {
+		for _, read := range reads {
+			c.errorf(t, "Unexpected APIReader access observed%s: %s", c.configNameMsg(), describeAction(read))
+		}
+	}
+	if c.ExpectAPIReaderReads != nil {
+		if expected, actual := *c.ExpectAPIReaderReads, len(reads); expected != actual {
+			c.errorf(t, "ExpectAPIReaderReads differs%s: expected %d, observed %d", c.configNameMsg(), expected, actual)
+		}
+	}
+}
+
+// AssertNamespaceExpectations asserts that each request made with the client and APIReader
+// targets the RestrictToNamespace namespace
+func (c *ExpectConfig) AssertNamespaceExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.RestrictToNamespace == "" {
+		return
+	}
+	for _, action := range c.client.namespaceViolations {
+		c.errorf(t, "Unexpected access outside of namespace %q observed%s: %s", c.RestrictToNamespace, c.configNameMsg(), describeAction(action))
+	}
+	for _, action := range c.apiReader.namespaceViolations {
+		c.errorf(t, "Unexpected APIReader access outside of namespace %q observed%s: %s", c.RestrictToNamespace, c.configNameMsg(), describeAction(action))
+	}
+}
+
+// describeAction returns a short description of an action
+func describeAction(action Action) string {
+	gvr := action.GetResource()
+	key := action.GetNamespace()
+	switch a := action.(type) {
+	case namedAction: // matches GetAction, PatchAction, DeleteAction
+		key = types.NamespacedName{Namespace: action.GetNamespace(), Name: a.GetName()}.String()
+	case objectAction: // matches CreateAction, UpdateAction
+		if obj, ok := a.GetObject().(client.Object); ok {
+			key = types.NamespacedName{Namespace: action.GetNamespace(), Name: obj.GetName()}.String()
+		}
+	}
+	if subresource := action.GetSubresource(); subresource != "" {
+		return fmt.Sprintf("%s %s/%s %s", action.GetVerb(), gvr.GroupResource().String(), subresource, key)
+	}
+	return fmt.Sprintf("%s %s %s", action.GetVerb(), gvr.GroupResource().String(), key)
 }
 
 // AssertClientExpectations asserts observed reconciler client behavior matches the expected client behavior
@@ -255,11 +597,21 @@ func (c *ExpectConfig) AssertClientExpectations(t *testing.T) {
 	c.AssertClientCreateExpectations(t)
 	c.AssertClientUpdateExpectations(t)
 	c.AssertClientPatchExpectations(t)
+	c.AssertClientPatchResultExpectations(t)
 	c.AssertClientDeleteExpectations(t)
 	c.AssertClientDeleteCollectionExpectations(t)
+	c.AssertClientListExpectations(t)
 	c.AssertClientStatusUpdateExpectations(t)
 	c.AssertClientStatusPatchExpectations(t)
+	c.AssertClientStatusPatchResultExpectations(t)
 	c.AssertClientStatusApplyExpectations(t)
+	c.AssertClientScaleUpdateExpectations(t)
+	c.AssertClientScalePatchExpectations(t)
+	c.AssertClientSubResourceCreateExpectations(t)
+	c.AssertClientSubResourceUpdateExpectations(t)
+	c.AssertClientSubResourcePatchExpectations(t)
+	c.AssertClientFinalizerExpectations(t)
+	c.AssertClientActionExpectations(t)
 }
 
 // AssertClientApplyExpectations asserts observed reconciler client create behavior matches the expected client create behavior
@@ -277,7 +629,7 @@ func (c *ExpectConfig) AssertClientApplyExpectations(t *testing.T) {
 		actual := NewApplyRef(c.client.ApplyActions[i])
 
 		if diff := c.Differ.ApplyRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectApplies[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectApplies[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.ApplyActions), len(c.ExpectApplies); actual > expected {
@@ -314,6 +666,11 @@ func (c *ExpectConfig) AssertClientPatchExpectations(t *testing.T) {
 	}
 	c.init()
 
+	if c.ExpectPatches == nil && c.ExpectPatchResults != nil {
+		// patches are asserted by their result
+		return
+	}
+
 	for i, exp := range c.ExpectPatches {
 		if i >= len(c.client.PatchActions) {
 			c.errorf(t, "ExpectPatches[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
@@ -322,7 +679,7 @@ func (c *ExpectConfig) AssertClientPatchExpectations(t *testing.T) {
 		actual := NewPatchRef(c.client.PatchActions[i])
 
 		if diff := c.Differ.PatchRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectPatches[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectPatches[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.PatchActions), len(c.ExpectPatches); actual > expected {
@@ -332,6 +689,37 @@ func (c *ExpectConfig) AssertClientPatchExpectations(t *testing.T) {
 	}
 }
 
+// AssertClientPatchResultExpectations asserts the resources resulting from observed reconciler client patches match
+// the expected patch results
+func (c *ExpectConfig) AssertClientPatchResultExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.ExpectPatchResults == nil {
+		// patch results are not asserted
+		return
+	}
+
+	for i, exp := range c.ExpectPatchResults {
+		if i >= len(c.client.PatchResults) {
+			c.errorf(t, "ExpectPatchResults[%d] not observed%s: %#v", i, c.configNameMsg(), exp.DeepCopyObject())
+			continue
+		}
+		actual := c.client.PatchResults[i]
+
+		if diff := c.Differ.ResourceUpdate(exp.DeepCopyObject().(client.Object), actual); diff != "" {
+			c.errorf(t, "ExpectPatchResults[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.PatchResults), len(c.ExpectPatchResults); actual > expected {
+		for _, extra := range c.client.PatchResults[expected:] {
+			c.errorf(t, "Unexpected PatchResult observed%s: %#v", c.configNameMsg(), extra)
+		}
+	}
+}
+
 // AssertClientDeleteExpectations asserts observed reconciler client delete behavior matches the expected client delete behavior
 func (c *ExpectConfig) AssertClientDeleteExpectations(t *testing.T) {
 	if t != nil {
@@ -347,7 +735,7 @@ func (c *ExpectConfig) AssertClientDeleteExpectations(t *testing.T) {
 		actual := NewDeleteRef(c.client.DeleteActions[i])
 
 		if diff := c.Differ.DeleteRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectDeletes[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectDeletes[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.DeleteActions), len(c.ExpectDeletes); actual > expected {
@@ -372,7 +760,7 @@ func (c *ExpectConfig) AssertClientDeleteCollectionExpectations(t *testing.T) {
 		actual := NewDeleteCollectionRef(c.client.DeleteCollectionActions[i])
 
 		if diff := c.Differ.DeleteCollectionRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectDeleteCollections[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectDeleteCollections[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.DeleteCollectionActions), len(c.ExpectDeleteCollections); actual > expected {
@@ -382,6 +770,35 @@ func (c *ExpectConfig) AssertClientDeleteCollectionExpectations(t *testing.T) {
 	}
 }
 
+// AssertClientListExpectations asserts observed reconciler client list behavior matches the expected client list behavior
+func (c *ExpectConfig) AssertClientListExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.ExpectLists == nil {
+		return
+	}
+
+	for i, exp := range c.ExpectLists {
+		if i >= len(c.client.ListActions) {
+			c.errorf(t, "ExpectLists[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
+			continue
+		}
+		actual := NewListRef(c.client.ListActions[i])
+
+		if diff := ExtendDiffer(c.Differ).ListRef(exp, actual); diff != "" {
+			c.errorf(t, "ExpectLists[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.ListActions), len(c.ExpectLists); actual > expected {
+		for _, extra := range c.client.ListActions[expected:] {
+			c.errorf(t, "Unexpected List observed%s: %#v", c.configNameMsg(), NewListRef(extra))
+		}
+	}
+}
+
 // AssertClientStatusUpdateExpectations asserts observed reconciler client status update behavior matches the expected client status update behavior
 func (c *ExpectConfig) AssertClientStatusUpdateExpectations(t *testing.T) {
 	if t != nil {
@@ -399,6 +816,11 @@ func (c *ExpectConfig) AssertClientStatusPatchExpectations(t *testing.T) {
 	}
 	c.init()
 
+	if c.ExpectStatusPatches == nil && c.ExpectStatusPatchResults != nil {
+		// status patches are asserted by their result
+		return
+	}
+
 	for i, exp := range c.ExpectStatusPatches {
 		if i >= len(c.client.StatusPatchActions) {
 			c.errorf(t, "ExpectStatusPatches[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
@@ -407,7 +829,7 @@ func (c *ExpectConfig) AssertClientStatusPatchExpectations(t *testing.T) {
 		actual := NewPatchRef(c.client.StatusPatchActions[i])
 
 		if diff := c.Differ.PatchRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectStatusPatches[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectStatusPatches[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.StatusPatchActions), len(c.ExpectStatusPatches); actual > expected {
@@ -417,6 +839,37 @@ func (c *ExpectConfig) AssertClientStatusPatchExpectations(t *testing.T) {
 	}
 }
 
+// AssertClientStatusPatchResultExpectations asserts the resources resulting from observed reconciler client status
+// patches match the expected status patch results
+func (c *ExpectConfig) AssertClientStatusPatchResultExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.ExpectStatusPatchResults == nil {
+		// status patch results are not asserted
+		return
+	}
+
+	for i, exp := range c.ExpectStatusPatchResults {
+		if i >= len(c.client.StatusPatchResults) {
+			c.errorf(t, "ExpectStatusPatchResults[%d] not observed%s: %#v", i, c.configNameMsg(), exp.DeepCopyObject())
+			continue
+		}
+		actual := c.client.StatusPatchResults[i]
+
+		if diff := c.Differ.ResourceStatusUpdate(exp.DeepCopyObject().(client.Object), actual); diff != "" {
+			c.errorf(t, "ExpectStatusPatchResults[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.StatusPatchResults), len(c.ExpectStatusPatchResults); actual > expected {
+		for _, extra := range c.client.StatusPatchResults[expected:] {
+			c.errorf(t, "Unexpected StatusPatchResult observed%s: %#v", c.configNameMsg(), extra)
+		}
+	}
+}
+
 // AssertClientStatusApplyExpectations asserts observed reconciler client status apply behavior matches the expected client status apply behavior
 func (c *ExpectConfig) AssertClientStatusApplyExpectations(t *testing.T) {
 	if t != nil {
@@ -432,7 +885,7 @@ func (c *ExpectConfig) AssertClientStatusApplyExpectations(t *testing.T) {
 		actual := NewApplyRef(c.client.StatusApplyActions[i])
 
 		if diff := c.Differ.ApplyRef(exp, actual); diff != "" {
-			c.errorf(t, "ExpectStatusApplies[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectStatusApplies[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(c.client.StatusApplyActions), len(c.ExpectStatusApplies); actual > expected {
@@ -442,6 +895,182 @@ func (c *ExpectConfig) AssertClientStatusApplyExpectations(t *testing.T) {
 	}
 }
 
+// AssertClientScaleUpdateExpectations asserts observed reconciler client scale update behavior matches the expected client scale update behavior
+func (c *ExpectConfig) AssertClientScaleUpdateExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	c.compareActions(t, "ScaleUpdate", c.ExpectScaleUpdates, c.client.ScaleUpdateActions, c.Differ.ResourceUpdate)
+}
+
+// AssertClientScalePatchExpectations asserts observed reconciler client scale patch behavior matches the expected client scale patch behavior
+func (c *ExpectConfig) AssertClientScalePatchExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	for i, exp := range c.ExpectScalePatches {
+		if i >= len(c.client.ScalePatchActions) {
+			c.errorf(t, "ExpectScalePatches[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
+			continue
+		}
+		actual := NewPatchRef(c.client.ScalePatchActions[i])
+
+		if diff := c.Differ.PatchRef(exp, actual); diff != "" {
+			c.errorf(t, "ExpectScalePatches[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.ScalePatchActions), len(c.ExpectScalePatches); actual > expected {
+		for _, extra := range c.client.ScalePatchActions[expected:] {
+			c.errorf(t, "Unexpected ScalePatch observed%s: %#v", c.configNameMsg(), extra)
+		}
+	}
+}
+
+// AssertClientSubResourceCreateExpectations asserts observed reconciler client sub-resource create behavior matches the expected client sub-resource create behavior
+func (c *ExpectConfig) AssertClientSubResourceCreateExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	c.compareSubResourceActions(t, "SubResourceCreate", c.ExpectSubResourceCreates, c.client.SubResourceCreateActions, c.Differ.ResourceCreate)
+}
+
+// AssertClientSubResourceUpdateExpectations asserts observed reconciler client sub-resource update behavior matches the expected client sub-resource update behavior
+func (c *ExpectConfig) AssertClientSubResourceUpdateExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	c.compareSubResourceActions(t, "SubResourceUpdate", c.ExpectSubResourceUpdates, c.client.SubResourceUpdateActions, c.Differ.ResourceUpdate)
+}
+
+// AssertClientSubResourcePatchExpectations asserts observed reconciler client sub-resource patch behavior matches the expected client sub-resource patch behavior
+func (c *ExpectConfig) AssertClientSubResourcePatchExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	for i, exp := range c.ExpectSubResourcePatches {
+		if i >= len(c.client.SubResourcePatchActions) {
+			c.errorf(t, "ExpectSubResourcePatches[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
+			continue
+		}
+		actual := NewPatchRef(c.client.SubResourcePatchActions[i])
+
+		if diff := c.Differ.PatchRef(exp, actual); diff != "" {
+			c.errorf(t, "ExpectSubResourcePatches[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.SubResourcePatchActions), len(c.ExpectSubResourcePatches); actual > expected {
+		for _, extra := range c.client.SubResourcePatchActions[expected:] {
+			c.errorf(t, "Unexpected SubResourcePatch observed%s: %#v", c.configNameMsg(), extra)
+		}
+	}
+}
+
+// AssertClientFinalizerExpectations asserts the finalizers of objects after reconciliation match the expected finalizers
+func (c *ExpectConfig) AssertClientFinalizerExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	for i, exp := range c.ExpectFinalizers {
+		actual, err := c.observedFinalizers(exp)
+		if err != nil {
+			c.errorf(t, "ExpectFinalizers[%d] unable to get object%s: %s", i, c.configNameMsg(), err)
+			continue
+		}
+
+		if diff := ExtendDiffer(c.Differ).FinalizersRef(exp, actual); diff != "" {
+			c.errorf(t, "ExpectFinalizers[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+}
+
+// AssertClientActionExpectations asserts the observed timeline of mutating reconciler client
+// requests matches the expected timeline. The timeline is only asserted when ExpectActions is
+// defined.
+func (c *ExpectConfig) AssertClientActionExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.ExpectActions == nil {
+		return
+	}
+	for i, exp := range c.ExpectActions {
+		if i >= len(c.client.MutatingActions) {
+			c.errorf(t, "ExpectActions[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
+			continue
+		}
+		actual := NewActionRef(c.client.MutatingActions[i])
+
+		if diff := ExtendDiffer(c.Differ).ActionRef(exp, actual); diff != "" {
+			c.errorf(t, "ExpectActions[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(c.client.MutatingActions), len(c.ExpectActions); actual > expected {
+		for _, extra := range c.client.MutatingActions[expected:] {
+			c.errorf(t, "Unexpected Action observed%s: %s", c.configNameMsg(), describeAction(extra))
+		}
+	}
+}
+
+// observedFinalizers returns the finalizers persisted for the referenced object
+func (c *ExpectConfig) observedFinalizers(ref FinalizersRef) (FinalizersRef, error) {
+	actual := ref
+	actual.Finalizers = nil
+
+	gk := schema.GroupKind{Group: ref.Group, Kind: ref.Kind}
+	versions := c.Scheme.VersionsForGroupKind(gk)
+	if len(versions) == 0 {
+		return actual, fmt.Errorf("kind %q is not registered with the scheme", gk)
+	}
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(gk.WithVersion(versions[0].Version))
+	if err := c.client.client.Get(context.TODO(), types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+		if apierrs.IsNotFound(err) {
+			return actual, nil
+		}
+		return actual, err
+	}
+	actual.Finalizers = obj.GetFinalizers()
+	return actual, nil
+}
+
+// observedResourceMetadata returns the metadata persisted for the object. An object that no
+// longer exists has empty metadata.
+func (c *ExpectConfig) observedResourceMetadata(obj client.Object) (ResourceMetadata, error) {
+	c.init()
+
+	gvk, err := apiutil.GVKForObject(c.normalizeDucks([]client.Object{obj})[0], c.Scheme)
+	if err != nil {
+		return ResourceMetadata{}, err
+	}
+	actual := &metav1.PartialObjectMetadata{}
+	actual.SetGroupVersionKind(gvk)
+	if err := c.client.client.Get(context.TODO(), types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, actual); err != nil {
+		if apierrs.IsNotFound(err) {
+			return ResourceMetadata{}, nil
+		}
+		return ResourceMetadata{}, err
+	}
+	return ResourceMetadata{
+		Labels:      actual.GetLabels(),
+		Annotations: actual.GetAnnotations(),
+		Finalizers:  actual.GetFinalizers(),
+	}, nil
+}
+
 // AssertRecorderExpectations asserts observed event recorder behavior matches the expected event recorder behavior
 func (c *ExpectConfig) AssertRecorderExpectations(t *testing.T) {
 	if t != nil {
@@ -449,18 +1078,47 @@ func (c *ExpectConfig) AssertRecorderExpectations(t *testing.T) {
 	}
 	c.init()
 
-	actualEvents := c.recorder.events
+	// events are asserted in the order they were recorded
+	actualEvents := c.recorder.recorded()
+	if c.ExpectEventsSource != "" {
+		sourced := []Event{}
+		for _, event := range actualEvents {
+			if matchesSource(event, c.ExpectEventsSource) {
+				sourced = append(sourced, event)
+			}
+		}
+		actualEvents = sourced
+	}
 	for i, exp := range c.ExpectEvents {
 		if i >= len(actualEvents) {
 			c.errorf(t, "ExpectEvents[%d] not observed%s: %s", i, c.configNameMsg(), exp)
 			continue
 		}
 
-		if diff := c.Differ.Event(exp, actualEvents[i]); diff != "" {
-			c.errorf(t, "ExpectEvents[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+		actual := actualEvents[i]
+		if exp.Source == "" {
+			actual.Source = ""
+		}
+		if diff := c.Differ.Event(exp, actual); diff != "" {
+			c.errorf(t, "ExpectEvents[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	for i, exp := range c.ExpectEventsMatch {
+		if i >= len(actualEvents) {
+			c.errorf(t, "ExpectEventsMatch[%d] not observed%s: %+v", i, c.configNameMsg(), exp)
+			continue
+		}
+
+		matched, err := exp.Matches(actualEvents[i])
+		if err != nil {
+			c.errorf(t, "ExpectEventsMatch[%d] has an invalid MessagePattern%s: %s", i, c.configNameMsg(), err)
+			continue
+		}
+		if !matched {
+			c.errorf(t, "ExpectEventsMatch[%d] does not match%s: expected %+v, actual %s", i, c.configNameMsg(), exp, actualEvents[i])
 		}
 	}
-	if actual, exp := len(actualEvents), len(c.ExpectEvents); actual > exp {
+	if actual, exp := len(actualEvents), max(len(c.ExpectEvents), len(c.ExpectEventsMatch)); actual > exp {
 		for _, extra := range actualEvents[exp:] {
 			c.errorf(t, "Unexpected Event observed%s: %s", c.configNameMsg(), extra)
 		}
@@ -484,7 +1142,7 @@ func (c *ExpectConfig) AssertTrackerExpectations(t *testing.T) {
 		}
 
 		if diff := c.Differ.TrackRequest(exp, actualTracks[i]); diff != "" {
-			c.errorf(t, "ExpectTracks[%d] differs%s (%s, %s):\n%s", i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "ExpectTracks[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, exp := len(actualTracks), len(c.ExpectTracks); actual > exp {
@@ -494,6 +1152,122 @@ func (c *ExpectConfig) AssertTrackerExpectations(t *testing.T) {
 	}
 }
 
+// AssertDiscoveryExpectations asserts observed calls to the discovery client match the expected
+// calls
+func (c *ExpectConfig) AssertDiscoveryExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	if c.ExpectDiscoveryRequests == nil {
+		return
+	}
+	actualRequests := c.discovery.getRequests()
+	for i, exp := range c.ExpectDiscoveryRequests {
+		if i >= len(actualRequests) {
+			c.errorf(t, "ExpectDiscoveryRequests[%d] not observed%s: %#v", i, c.configNameMsg(), exp)
+			continue
+		}
+
+		if diff := ExtendDiffer(c.Differ).DiscoveryRequest(exp, actualRequests[i]); diff != "" {
+			c.errorf(t, "ExpectDiscoveryRequests[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, exp := len(actualRequests), len(c.ExpectDiscoveryRequests); actual > exp {
+		for _, extra := range actualRequests[exp:] {
+			c.errorf(t, "Unexpected DiscoveryRequest observed%s: %#v", c.configNameMsg(), extra)
+		}
+	}
+}
+
+// AssertResourceExpectations asserts the objects stored by the fake client match the expected
+// resources
+func (c *ExpectConfig) AssertResourceExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	for i, exp := range c.ExpectResources {
+		actual, err := c.storedResource(exp)
+		if err != nil && !apierrs.IsNotFound(err) {
+			c.errorf(t, "ExpectResources[%d] unable to get the stored resource%s: %s", i, c.configNameMsg(), err)
+			continue
+		}
+		if diff := c.Differ.ResourceUpdate(exp, actual); diff != "" {
+			c.errorf(t, "ExpectResources[%d] differs%s %s:\n%s", i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+}
+
+// AssertReactorExpectations asserts the named reactors from WithReactorsFor are called the
+// expected number of times
+func (c *ExpectConfig) AssertReactorExpectations(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	names := make([]string, 0, len(c.ExpectReactorCalls))
+	for name := range c.ExpectReactorCalls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c.reactorCallsMu.Lock()
+	defer c.reactorCallsMu.Unlock()
+	for _, name := range names {
+		if !c.hasNamedReactor(name) {
+			c.errorf(t, "ExpectReactorCalls[%q] does not match a named reactor in WithReactorsFor%s", name, c.configNameMsg())
+			continue
+		}
+		if expected, actual := c.ExpectReactorCalls[name], c.reactorCalls[name]; expected != actual {
+			c.errorf(t, "ExpectReactorCalls[%q] differs%s: expected %d, observed %d", name, c.configNameMsg(), expected, actual)
+		}
+	}
+}
+
+// countReactorCalls wraps the reactor's ReactionFunc to count each call to a named reactor.
+// Unnamed reactors are returned as is.
+func (c *ExpectConfig) countReactorCalls(reactor ScopedReactor) ReactionFunc {
+	if reactor.Name == "" {
+		return reactor.Reactor
+	}
+	return func(action Action) (bool, runtime.Object, error) {
+		c.reactorCallsMu.Lock()
+		if c.reactorCalls == nil {
+			c.reactorCalls = map[string]int{}
+		}
+		c.reactorCalls[reactor.Name]++
+		c.reactorCallsMu.Unlock()
+		return reactor.Reactor(action)
+	}
+}
+
+func (c *ExpectConfig) hasNamedReactor(name string) bool {
+	for _, reactor := range c.WithReactorsFor {
+		if reactor.Name != "" && reactor.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// storedResource returns the object stored by the fake client with the type, namespace and name
+// of the given object. A nil object of the same type is returned with the error when the object
+// is not stored.
+func (c *ExpectConfig) storedResource(obj client.Object) (client.Object, error) {
+	actual := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	if u, ok := actual.(*unstructured.Unstructured); ok {
+		u.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	}
+	if err := c.client.client.Get(context.TODO(), types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, actual); err != nil {
+		return reflect.Zero(reflect.TypeOf(obj)).Interface().(client.Object), err
+	}
+	return actual, nil
+}
+
 func (c *ExpectConfig) compareActions(t *testing.T, actionName string, expectedActionFactories []client.Object, actualActions []objectAction, differ func(client.Object, client.Object) string) {
 	if t != nil {
 		t.Helper()
@@ -508,7 +1282,7 @@ func (c *ExpectConfig) compareActions(t *testing.T, actionName string, expectedA
 		actual := actualActions[i].GetObject()
 
 		if diff := differ(exp.DeepCopyObject().(client.Object), actual.(client.Object)); diff != "" {
-			c.errorf(t, "Expect%ss[%d] differs%s (%s, %s):\n%s", actionName, i, c.configNameMsg(), DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			c.errorf(t, "Expect%ss[%d] differs%s %s:\n%s", actionName, i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
 		}
 	}
 	if actual, expected := len(actualActions), len(expectedActionFactories); actual > expected {
@@ -518,55 +1292,49 @@ func (c *ExpectConfig) compareActions(t *testing.T, actionName string, expectedA
 	}
 }
 
+func (c *ExpectConfig) compareSubResourceActions(t *testing.T, actionName string, expectedActions []SubResourceRef, actualActions []objectAction, differ func(client.Object, client.Object) string) {
+	if t != nil {
+		t.Helper()
+	}
+	c.init()
+
+	for i, exp := range expectedActions {
+		if i >= len(actualActions) {
+			c.errorf(t, "Expect%ss[%d] not observed%s: %#v", actionName, i, c.configNameMsg(), exp)
+			continue
+		}
+		actual := actualActions[i]
+
+		diff := differ(exp.Object.DeepCopyObject().(client.Object), actual.GetObject().(client.Object))
+		if exp.SubResource != actual.GetSubresource() {
+			diff = fmt.Sprintf("SubResource: %s", cmp.Diff(exp.SubResource, actual.GetSubresource())) + diff
+		}
+		if diff != "" {
+			c.errorf(t, "Expect%ss[%d] differs%s %s:\n%s", actionName, i, c.configNameMsg(), c.diffLegend(), c.colorizeDiff(diff))
+		}
+	}
+	if actual, expected := len(actualActions), len(expectedActions); actual > expected {
+		for _, extra := range actualActions[expected:] {
+			c.errorf(t, "Unexpected %s observed%s: %#v", actionName, c.configNameMsg(), extra)
+		}
+	}
+}
+
 var (
-	IgnoreLastTransitionTime = cmp.FilterPath(func(p cmp.Path) bool {
-		str := p.String()
-		gostr := p.GoString()
-		return strings.HasSuffix(str, "LastTransitionTime") ||
-			strings.HasSuffix(gostr, `["lastTransitionTime"]`)
-	}, cmp.Ignore())
-	IgnoreTypeMeta = cmp.FilterPath(func(p cmp.Path) bool {
-		str := p.String()
-		// only ignore for typed resources, compare TypeMeta values for unstructured
-		return strings.HasSuffix(str, "TypeMeta.APIVersion") ||
-			strings.HasSuffix(str, "TypeMeta.Kind")
-	}, cmp.Ignore())
-	IgnoreCreationTimestamp = cmp.FilterPath(func(p cmp.Path) bool {
-		str := p.String()
-		gostr := p.GoString()
-		return strings.HasSuffix(str, "ObjectMeta.CreationTimestamp") ||
-			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]any)["creationTimestamp"]`) ||
-			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]any)["creationTimestamp"]`) ||
-			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]interface {})["creationTimestamp"]`) ||
-			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]interface {})["creationTimestamp"]`)
-	}, cmp.Ignore())
-	IgnoreResourceVersion = cmp.FilterPath(func(p cmp.Path) bool {
-		str := p.String()
-		gostr := p.GoString()
-		return strings.HasSuffix(str, "ObjectMeta.ResourceVersion") ||
-			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]any)["resourceVersion"]`) ||
-			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]any)["resourceVersion"]`) ||
-			strings.HasSuffix(gostr, `(*unstructured.Unstructured).Object["metadata"].(map[string]interface {})["resourceVersion"]`) ||
-			strings.HasSuffix(gostr, `{*unstructured.Unstructured}.Object["metadata"].(map[string]interface {})["resourceVersion"]`)
-	}, cmp.Ignore())
+	IgnoreLastTransitionTime  = rcmpopts.IgnoreLastTransitionTime
+	IgnoreTypeMeta            = rcmpopts.IgnoreTypeMeta
+	IgnoreCreationTimestamp   = rcmpopts.IgnoreCreationTimestamp
+	IgnoreResourceVersion     = rcmpopts.IgnoreResourceVersion
+	IgnoreServerManagedFields = rcmpopts.IgnoreServerManagedFields
+	EquateApproxTime          = rcmpopts.EquateApproxTime
 
 	statusSubresourceOnly = cmp.FilterPath(func(p cmp.Path) bool {
 		str := p.String()
 		return str != "" && !strings.HasPrefix(str, "Status")
 	}, cmp.Ignore())
 
-	NormalizeLabelSelector = cmp.Transformer("labels.Selector", func(s labels.Selector) *string {
-		if s == nil || s.Empty() {
-			return nil
-		}
-		return ptr.To[string](s.String())
-	})
-	NormalizeFieldSelector = cmp.Transformer("fields.Selector", func(s fields.Selector) *string {
-		if s == nil || s.Empty() {
-			return nil
-		}
-		return ptr.To[string](s.String())
-	})
+	NormalizeLabelSelector      = rcmpopts.NormalizeLabelSelector
+	NormalizeFieldSelector      = rcmpopts.NormalizeFieldSelector
 	NormalizeApplyConfiguration = cmp.Transformer("runtime.ApplyConfiguration", func(ac runtime.ApplyConfiguration) map[string]interface{} {
 		data, err := json.Marshal(ac)
 		if err != nil {
@@ -579,8 +1347,40 @@ var (
 		}
 		return obj.UnstructuredContent()
 	})
+	// NormalizeJSONPatch compares RFC 6902 JSON Patches by their decoded operations rather than
+	// the raw bytes. Patches that are not valid JSON are compared as strings.
+	NormalizeJSONPatch = cmp.FilterValues(func(x, y PatchRef) bool {
+		return x.PatchType == types.JSONPatchType && y.PatchType == types.JSONPatchType
+	}, cmp.Transformer("testing.JSONPatch", func(ref PatchRef) decodedPatchRef {
+		decoded := decodedPatchRef{
+			Group:       ref.Group,
+			Kind:        ref.Kind,
+			Namespace:   ref.Namespace,
+			Name:        ref.Name,
+			SubResource: ref.SubResource,
+			PatchType:   ref.PatchType,
+		}
+		var ops []map[string]interface{}
+		if err := json.Unmarshal(ref.Patch, &ops); err != nil {
+			decoded.Patch = string(ref.Patch)
+		} else {
+			decoded.Patch = ops
+		}
+		return decoded
+	}))
 )
 
+// decodedPatchRef is a PatchRef with the patch decoded for comparison
+type decodedPatchRef struct {
+	Group       string
+	Kind        string
+	Namespace   string
+	Name        string
+	SubResource string
+	PatchType   types.PatchType
+	Patch       interface{}
+}
+
 type PatchRef struct {
 	Group       string
 	Kind        string
@@ -603,6 +1403,13 @@ func NewPatchRef(action PatchAction) PatchRef {
 	}
 }
 
+// SubResourceRef is the object sent to a named sub-resource, like the Eviction created for a
+// Pod's `eviction` sub-resource
+type SubResourceRef struct {
+	SubResource string
+	Object      client.Object
+}
+
 type DeleteRef struct {
 	Group     string
 	Kind      string
@@ -637,6 +1444,34 @@ func NewDeleteRefFromObject(obj client.Object, scheme *runtime.Scheme) DeleteRef
 	}
 }
 
+type FinalizersRef struct {
+	Group      string
+	Kind       string
+	Namespace  string
+	Name       string
+	Finalizers []string
+}
+
+func NewFinalizersRef(obj client.Object, scheme *runtime.Scheme, finalizers ...string) FinalizersRef {
+	ref := NewDeleteRefFromObject(obj, scheme)
+
+	return FinalizersRef{
+		Group:      ref.Group,
+		Kind:       ref.Kind,
+		Namespace:  ref.Namespace,
+		Name:       ref.Name,
+		Finalizers: finalizers,
+	}
+}
+
+// ResourceMetadata is the metadata of a resource that is commonly mutated by a reconciler, see
+// ReconcilerTestCase.ExpectResourceMetadata and SubReconcilerTestCase.ExpectResourceMetadata
+type ResourceMetadata struct {
+	Labels      map[string]string
+	Annotations map[string]string
+	Finalizers  []string
+}
+
 type DeleteCollectionRef struct {
 	Group     string
 	Kind      string
@@ -655,6 +1490,98 @@ func NewDeleteCollectionRef(action DeleteCollectionAction) DeleteCollectionRef {
 	}
 }
 
+// ListRef identifies a list request made with the client, see ExpectConfig.ExpectLists. The
+// Kind is the kind of the list, like ConfigMapList.
+type ListRef struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Labels    labels.Selector
+	Fields    fields.Selector
+}
+
+func NewListRef(action ListAction) ListRef {
+	return ListRef{
+		Group:     action.GetResource().Group,
+		Kind:      action.GetResource().Resource,
+		Namespace: action.GetNamespace(),
+		Labels:    action.GetListRestrictions().Labels,
+		Fields:    action.GetListRestrictions().Fields,
+	}
+}
+
+// NewListRefFromList creates a ListRef for a request listing the type with the options. Pass the
+// same options the reconciler is expected to use, like the options returned from a
+// ChildSetReconciler's ListOptions, to assert the selectors of the request.
+func NewListRefFromList(list client.ObjectList, scheme *runtime.Scheme, opts ...client.ListOption) ListRef {
+	gvks, _, err := scheme.ObjectKinds(list.DeepCopyObject())
+	if err != nil {
+		panic(err)
+	}
+	listopts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listopts)
+	}
+
+	return ListRef{
+		Group:     gvks[0].Group,
+		Kind:      gvks[0].Kind,
+		Namespace: listopts.Namespace,
+		Labels:    listopts.LabelSelector,
+		Fields:    listopts.FieldSelector,
+	}
+}
+
+// ActionRef identifies a mutating request within the timeline of requests, see
+// ExpectConfig.ExpectActions
+type ActionRef struct {
+	Verb        string
+	Group       string
+	Kind        string
+	Namespace   string
+	Name        string
+	SubResource string
+}
+
+func NewActionRef(action Action) ActionRef {
+	name := ""
+	switch a := action.(type) {
+	case namedAction: // matches PatchAction, DeleteAction, ApplyAction
+		name = a.GetName()
+	case clientgotesting.CreateActionImpl: // sub-resource creates reference the parent object by name
+		name = a.Name
+		if obj, ok := a.GetObject().(client.Object); ok && name == "" {
+			name = obj.GetName()
+		}
+	case objectAction: // matches UpdateAction
+		if obj, ok := a.GetObject().(client.Object); ok {
+			name = obj.GetName()
+		}
+	}
+	return ActionRef{
+		Verb:        action.GetVerb(),
+		Group:       action.GetResource().Group,
+		Kind:        action.GetResource().Resource,
+		Namespace:   action.GetNamespace(),
+		Name:        name,
+		SubResource: action.GetSubresource(),
+	}
+}
+
+// NewActionRefFromObject creates an ActionRef for a request with the verb against the object. Set
+// the SubResource of the returned ref for a request against a sub-resource, like status.
+func NewActionRefFromObject(verb string, obj client.Object, scheme *runtime.Scheme) ActionRef {
+	ref := NewDeleteRefFromObject(obj, scheme)
+
+	return ActionRef{
+		Verb:      verb,
+		Group:     ref.Group,
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}
+}
+
 type ApplyRef struct {
 	Group              string
 	Kind               string
@@ -18,12 +18,21 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/fatih/color"
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
@@ -31,13 +40,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
 	applyconfigurationsappsv1 "k8s.io/client-go/applyconfigurations/apps/v1"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
 	"reconciler.io/runtime/internal/resources"
 	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestExpectConfig(t *testing.T) {
@@ -59,6 +71,13 @@ func TestExpectConfig(t *testing.T) {
 			},
 		},
 	}
+	r1finalizers := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  ns,
+			Name:       "resource-1",
+			Finalizers: []string{"test.finalizer", "other.finalizer"},
+		},
+	}
 	r2 := &resources.TestResource{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: ns,
@@ -87,6 +106,33 @@ func TestExpectConfig(t *testing.T) {
 		},
 	}
 
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      "deployment-1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      "pod-1",
+		},
+	}
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      "pod-1",
+		},
+	}
+	podEphemeral := pod.DeepCopy()
+	podEphemeral.Spec.EphemeralContainers = []corev1.EphemeralContainer{
+		{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}},
+	}
+
 	scheme := runtime.NewScheme()
 	_ = resources.AddToScheme(scheme)
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -140,6 +186,270 @@ func TestExpectConfig(t *testing.T) {
 			},
 			failedAssertions: []string{},
 		},
+		"list with indexed field selector": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+					r2.DeepCopy(),
+				},
+				GivenFieldIndexes: []FieldIndex{
+					{Type: &resources.TestResource{}, Field: "metadata.name", Extract: func(obj client.Object) []string {
+						return []string{obj.GetName()}
+					}},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				list := &resources.TestResourceList{}
+				err := c.List(ctx, list, client.InNamespace(ns), client.MatchingFields{"metadata.name": "resource-2"})
+				if err != nil {
+					t.Errorf("unexpected list error: %s", err)
+				}
+				if len(list.Items) != 1 || list.Items[0].Name != "resource-2" {
+					t.Errorf("got unexpected objects: %v", list.Items)
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"list with field selector registered with field indexer": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+					r2.DeepCopy(),
+				},
+				GivenFieldIndexes: func() FieldIndexes {
+					indexes := FieldIndexes{}
+					_ = indexes.IndexField(context.Background(), &resources.TestResource{}, "metadata.name", func(obj client.Object) []string {
+						return []string{obj.GetName()}
+					})
+					return indexes
+				}(),
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				list := &resources.TestResourceList{}
+				err := c.List(ctx, list, client.MatchingFields{"metadata.name": "resource-1"})
+				if err != nil {
+					t.Errorf("unexpected list error: %s", err)
+				}
+				if len(list.Items) != 1 || list.Items[0].Name != "resource-1" {
+					t.Errorf("got unexpected objects: %v", list.Items)
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"list with unindexed field selector": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+					r2.DeepCopy(),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				list := &resources.TestResourceList{}
+				err := c.List(ctx, list, client.MatchingFields{"metadata.name": "resource-1"})
+				if err == nil {
+					t.Errorf("expected list error")
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"scoped client reactor": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+					r2.DeepCopy(),
+				},
+				WithReactorsFor: []ScopedReactor{
+					{
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return true, nil, fmt.Errorf("inducing failure for %s %s", action.GetVerb(), action.GetResource().Resource)
+						},
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r)
+				if err == nil {
+					t.Errorf("expected get error")
+				}
+				l := &resources.TestResourceList{}
+				if err := c.List(ctx, l); err != nil {
+					t.Errorf("unexpected list error: %s", err)
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"reactor calls": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				WithReactorsFor: []ScopedReactor{
+					{
+						Name:     "get-resource",
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return false, nil, nil
+						},
+					},
+					{
+						Name:     "delete-resource",
+						Verb:     "delete",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return false, nil, nil
+						},
+					},
+				},
+				ExpectReactorCalls: map[string]int{
+					"get-resource":    2,
+					"delete-resource": 0,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				for range 2 {
+					if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); err != nil {
+						t.Errorf("unexpected get error: %s", err)
+					}
+				}
+				l := &resources.TestResourceList{}
+				if err := c.List(ctx, l); err != nil {
+					t.Errorf("unexpected list error: %s", err)
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"reactor calls, unexpected count": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				WithReactorsFor: []ScopedReactor{
+					{
+						Name:     "get-resource",
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return false, nil, nil
+						},
+					},
+				},
+				ExpectReactorCalls: map[string]int{
+					"get-resource": 1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				l := &resources.TestResourceList{}
+				if err := c.List(ctx, l); err != nil {
+					t.Errorf("unexpected list error: %s", err)
+				}
+			},
+			failedAssertions: []string{
+				`ExpectReactorCalls["get-resource"] differs for config "test": expected 1, observed 0`,
+			},
+		},
+		"reactor calls, unknown reactor": {
+			config: ExpectConfig{
+				WithReactorsFor: []ScopedReactor{
+					{
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return false, nil, nil
+						},
+					},
+				},
+				ExpectReactorCalls: map[string]int{
+					"get-resource": 0,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectReactorCalls["get-resource"] does not match a named reactor in WithReactorsFor`,
+			},
+		},
+		"reactor calls, skipped by an earlier reactor": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				WithReactorsFor: []ScopedReactor{
+					{
+						Name:     "first",
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return true, nil, fmt.Errorf("inducing failure")
+						},
+					},
+					{
+						Name:     "second",
+						Verb:     "get",
+						Resource: "TestResource",
+						Reactor: func(action Action) (handled bool, ret runtime.Object, err error) {
+							return false, nil, nil
+						},
+					},
+				},
+				ExpectReactorCalls: map[string]int{
+					"first":  1,
+					"second": 0,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); err == nil {
+					t.Errorf("expected get error")
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"conflict once reactor": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				WithReactors: []ReactionFunc{
+					ConflictOnce("get", "TestResource"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); !apierrs.IsConflict(err) {
+					t.Errorf("expected conflict error, got %v", err)
+				}
+				if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); err != nil {
+					t.Errorf("unexpected get error: %s", err)
+				}
+			},
+			failedAssertions: []string{},
+		},
+		"fail n times reactor": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				WithReactors: []ReactionFunc{
+					FailNTimes("get", "TestResource", 2),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				for i := 0; i < 2; i++ {
+					if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); err == nil {
+						t.Errorf("expected get error for call %d", i+1)
+					}
+				}
+				if err := c.Get(ctx, client.ObjectKey{Namespace: ns, Name: "resource-1"}, r); err != nil {
+					t.Errorf("unexpected get error: %s", err)
+				}
+			},
+			failedAssertions: []string{},
+		},
 		"list given object": {
 			config: ExpectConfig{
 				GivenObjects: []client.Object{
@@ -268,6 +578,17 @@ func TestExpectConfig(t *testing.T) {
 			},
 			failedAssertions: []string{},
 		},
+		"expected track by name": {
+			config: ExpectConfig{
+				ExpectTracks: []TrackRequest{
+					NewTrackRequestBy(r2, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, scheme),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Tracker.TrackObject(r2, r1)
+			},
+			failedAssertions: []string{},
+		},
 		"unexpected track": {
 			config: ExpectConfig{
 				ExpectTracks: []TrackRequest{
@@ -303,542 +624,1466 @@ func TestExpectConfig(t *testing.T) {
 				`ExpectTracks[0] not observed for config "test": {my-namespace/resource-1 { /} {testing.reconciler.runtime TestResource my-namespace resource-2 <nil>}}`,
 			},
 		},
-
-		"expected event": {
+		"expected discovery requests": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{
-					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				ExpectDiscoveryRequests: []DiscoveryRequest{
+					{Method: "ServerVersion"},
+					{Method: "ServerResourcesForGroupVersion", GroupVersion: "testing.reconciler.runtime/v1"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.Discovery.ServerVersion()
+				c.Discovery.ServerResourcesForGroupVersion("testing.reconciler.runtime/v1")
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected event": {
+		"discovery requests not asserted": {
+			config: ExpectConfig{},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Discovery.ServerGroups()
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected discovery request": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{
-					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				ExpectDiscoveryRequests: []DiscoveryRequest{
+					{Method: "ServerResourcesForGroupVersion", GroupVersion: "v1"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Eventf(r2, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.Discovery.ServerResourcesForGroupVersion("apps/v1")
 			},
 			failedAssertions: []string{
-				`ExpectEvents[0] differs for config "test" (-expected, +actual):`,
+				`ExpectDiscoveryRequests[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra event": {
+		"extra discovery request": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{},
+				ExpectDiscoveryRequests: []DiscoveryRequest{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.Discovery.ServerGroupsAndResources()
 			},
 			failedAssertions: []string{
-				`Unexpected Event observed for config "test": `,
+				`Unexpected DiscoveryRequest observed for config "test": testing.DiscoveryRequest{Method:"ServerGroupsAndResources", GroupVersion:""}`,
 			},
 		},
-		"missing event": {
+		"missing discovery request": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{
-					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				ExpectDiscoveryRequests: []DiscoveryRequest{
+					{Method: "ServerGroups"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectEvents[0] not observed for config "test": `,
+				`ExpectDiscoveryRequests[0] not observed for config "test": testing.DiscoveryRequest{Method:"ServerGroups", GroupVersion:""}`,
 			},
 		},
-		"legacy event intermixing": {
+
+		"expected resources": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{
-					NewEvent(r1, scheme, corev1.EventTypeNormal, "TheReason", "the message"),
-					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
-					NewEvent(r1, scheme, corev1.EventTypeNormal, "TheReason", "the message 2"),
-					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note 2"),
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+					r2.DeepCopy(),
+				},
+				ExpectUpdates: []client.Object{
+					r1finalizers.DeepCopy(),
+				},
+				ExpectResources: []client.Object{
+					r1finalizers.DeepCopy(),
+					r2.DeepCopy(),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Recorder.Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message")
-				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
-				c.Recorder.Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message 2")
-				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note 2")
+				r := &resources.TestResource{}
+				if err := c.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, r); err != nil {
+					t.Errorf("unexpected get error: %s", err)
+				}
+				r.Finalizers = r1finalizers.Finalizers
+				if err := c.Update(ctx, r); err != nil {
+					t.Errorf("unexpected update error: %s", err)
+				}
 			},
 			failedAssertions: []string{},
 		},
-		"event related objects": {
+		"expected resource differs": {
 			config: ExpectConfig{
-				ExpectEvents: []Event{
-					NewEventf(r1, cm, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				ExpectResources: []client.Object{
+					r1finalizers.DeepCopy(),
 				},
 			},
-			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Eventf(r1, cm, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectResources[0] differs for config "test" (-expected, +actual):`,
 			},
-			failedAssertions: []string{},
 		},
-
-		"expected create": {
+		"expected resource deleted": {
 			config: ExpectConfig{
-				ExpectCreates: []client.Object{
-					r1,
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				ExpectDeletes: []DeleteRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: r1.Namespace, Name: r1.Name},
+				},
+				ExpectResources: []client.Object{
+					r1.DeepCopy(),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Create(ctx, r1.DeepCopy())
+				if err := c.Delete(ctx, r1.DeepCopy()); err != nil {
+					t.Errorf("unexpected delete error: %s", err)
+				}
+			},
+			failedAssertions: []string{
+				`ExpectResources[0] differs for config "test" (-expected, +actual):`,
 			},
-			failedAssertions: []string{},
 		},
-		"unexpected create": {
+		"expected resource not stored": {
 			config: ExpectConfig{
-				ExpectCreates: []client.Object{
+				ExpectResources: []client.Object{
+					r2.DeepCopy(),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectResources[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+
+		"no api reader access": {
+			config: ExpectConfig{
+				ExpectNoAPIReaderAccess: true,
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+				c.List(ctx, &resources.TestResourceList{})
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected api reader access": {
+			config: ExpectConfig{
+				ExpectNoAPIReaderAccess: true,
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.APIReader.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+				c.APIReader.List(ctx, &resources.TestResourceList{}, client.InNamespace(r1.Namespace))
+			},
+			failedAssertions: []string{
+				`Unexpected APIReader access observed for config "test": get TestResource.testing.reconciler.runtime my-namespace/resource-1`,
+				`Unexpected APIReader access observed for config "test": list `,
+			},
+		},
+		"expected api reader reads": {
+			config: ExpectConfig{
+				ExpectAPIReaderReads: ptr.To(2),
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.APIReader.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+				c.APIReader.List(ctx, &resources.TestResourceList{})
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected api reader reads": {
+			config: ExpectConfig{
+				ExpectAPIReaderReads: ptr.To(1),
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.APIReader.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+				c.APIReader.Get(ctx, types.NamespacedName{Namespace: r2.Namespace, Name: r2.Name}, &resources.TestResource{})
+			},
+			failedAssertions: []string{
+				`ExpectAPIReaderReads differs for config "test": expected 1, observed 2`,
+			},
+		},
+		"restricted to namespace": {
+			config: ExpectConfig{
+				RestrictToNamespace: ns,
+				GivenObjects: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+				c.List(ctx, &resources.TestResourceList{}, client.InNamespace(ns))
+				c.APIReader.Get(ctx, types.NamespacedName{Namespace: r1.Namespace, Name: r1.Name}, &resources.TestResource{})
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected access outside of restricted namespace": {
+			config: ExpectConfig{
+				RestrictToNamespace: ns,
+				ExpectCreates: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Get(ctx, types.NamespacedName{Namespace: "other-namespace", Name: r1.Name}, &resources.TestResource{})
+				c.Create(ctx, r1.DeepCopy())
+				c.Delete(ctx, &resources.TestResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "other-namespace",
+						Name:      "resource-1",
+					},
+				})
+				c.APIReader.List(ctx, &resources.TestResourceList{}, client.InNamespace("other-namespace"))
+			},
+			failedAssertions: []string{
+				`Unexpected Delete observed for config "test": `,
+				`Unexpected access outside of namespace "my-namespace" observed for config "test": get TestResource.testing.reconciler.runtime other-namespace/resource-1`,
+				`Unexpected access outside of namespace "my-namespace" observed for config "test": delete TestResource.testing.reconciler.runtime other-namespace/resource-1`,
+				`Unexpected APIReader access outside of namespace "my-namespace" observed for config "test": list `,
+			},
+		},
+
+		"expected event": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected event": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r2, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEvents[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra event": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`Unexpected Event observed for config "test": `,
+			},
+		},
+		"missing event": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectEvents[0] not observed for config "test": `,
+			},
+		},
+		"legacy event intermixing": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEvent(r1, scheme, corev1.EventTypeNormal, "TheReason", "the message"),
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+					NewEvent(r1, scheme, corev1.EventTypeNormal, "TheReason", "the message 2"),
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note 2"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Recorder.Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message")
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.Recorder.Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message 2")
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note 2")
+			},
+			failedAssertions: []string{},
+		},
+		"event related objects": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, cm, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, cm, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"matched event": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Type: corev1.EventTypeNormal, Reason: "TheReason", MessagePattern: "note [0-9a-f]+$"},
+					{Type: corev1.EventTypeWarning},
+					{MessagePattern: "message"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note %s", "8d3f1a")
+				c.Eventf(r1, nil, corev1.EventTypeWarning, "OtherReason", "the action", "the note")
+				c.Recorder.Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message")
+			},
+			failedAssertions: []string{},
+		},
+		"matched event with exact events": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "TheReason", MessagePattern: "note"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"unmatched event": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Type: corev1.EventTypeNormal, Reason: "TheReason", MessagePattern: "^note"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEventsMatch[0] does not match for config "test": `,
+			},
+		},
+		"unmatched event reason": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "OtherReason"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEventsMatch[0] does not match for config "test": `,
+			},
+		},
+		"invalid event message pattern": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{MessagePattern: "note("},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEventsMatch[0] has an invalid MessagePattern for config "test": `,
+			},
+		},
+		"extra matched event": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "TheReason"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note 2")
+			},
+			failedAssertions: []string{
+				`Unexpected Event observed for config "test": `,
+			},
+		},
+		"missing matched event": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "TheReason"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectEventsMatch[0] not observed for config "test": `,
+			},
+		},
+		"event source": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					sourcedEvent(NewEvent(r1, scheme, corev1.EventTypeNormal, "TheReason", "the message"), "Parent/Child"),
+					sourcedEvent(NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"), "Parent/Child"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent", "Child")
+				c.RecorderFor(ctx).Eventf(r1, corev1.EventTypeNormal, "TheReason", "the message")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"event source is ignored unless expected": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent", "Child")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected event source": {
+			config: ExpectConfig{
+				ExpectEvents: []Event{
+					sourcedEvent(NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"), "Parent/Other"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent", "Child")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEvents[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"matched event source": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "TheReason", Source: "Parent"},
+					{Reason: "TheReason", Source: "Child"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent", "Child")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{},
+		},
+		"unmatched event source": {
+			config: ExpectConfig{
+				ExpectEventsMatch: []EventMatcher{
+					{Reason: "TheReason", Source: "Other"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent", "Child")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEventsMatch[0] does not match for config "test": `,
+			},
+		},
+		"events by source": {
+			config: ExpectConfig{
+				ExpectEventsSource: "Child",
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the child note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				parentCtx := withTestLogger(t, ctx, "Parent")
+				childCtx := withTestLogger(t, ctx, "Parent", "Child")
+				c.EventRecorderFor(parentCtx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the parent note")
+				c.EventRecorderFor(childCtx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the child note")
+				c.Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the unattributed note")
+			},
+			failedAssertions: []string{},
+		},
+		"missing event by source": {
+			config: ExpectConfig{
+				ExpectEventsSource: "Child",
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				ctx = withTestLogger(t, ctx, "Parent")
+				c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			},
+			failedAssertions: []string{
+				`ExpectEvents[0] not observed for config "test": `,
+			},
+		},
+
+		"expected create": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Create(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected create": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{
+					r2,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Create(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{
+				`ExpectCreates[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra create": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Create(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{
+				`Unexpected Create observed for config "test": `,
+			},
+		},
+		"missing create": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectCreates[0] not observed for config "test": `,
+			},
+		},
+		"generate name": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{
+					&resources.TestResource{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:    ns,
+							GenerateName: "resource-",
+						},
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:    ns,
+						GenerateName: "resource-",
+					},
+				}
+
+				c.Create(ctx, r)
+			},
+		},
+		"generate name unstructured": {
+			config: ExpectConfig{
+				ExpectCreates: []client.Object{
+					&unstructured.Unstructured{
+						Object: map[string]interface{}{
+							"apiVersion": "testing.reconciler.runtime/v1",
+							"kind":       "TestResource",
+							"metadata": map[string]interface{}{
+								"namespace":    ns,
+								"generateName": "resource-",
+							},
+						},
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				u := &unstructured.Unstructured{}
+				u.SetAPIVersion("testing.reconciler.runtime/v1")
+				u.SetKind("TestResource")
+				u.SetNamespace(ns)
+				u.SetGenerateName("resource-")
+
+				c.Create(ctx, u)
+			},
+		},
+
+		"expected apply": {
+			config: ExpectConfig{
+				ExpectApplies: []ApplyRef{
+					{
+						Group:              "apps",
+						Kind:               "Deployment",
+						Namespace:          ns,
+						Name:               "resource",
+						ApplyConfiguration: ac1,
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Apply(ctx, ac1)
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected apply": {
+			config: ExpectConfig{
+				ExpectApplies: []ApplyRef{
+					{
+						Group:              "apps",
+						Kind:               "Deployment",
+						Namespace:          ns,
+						Name:               "resource",
+						ApplyConfiguration: ac2,
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Apply(ctx, ac1)
+			},
+			failedAssertions: []string{
+				`ExpectApplies[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra apply": {
+			config: ExpectConfig{
+				ExpectApplies: []ApplyRef{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Apply(ctx, ac1)
+			},
+			failedAssertions: []string{
+				`Unexpected Apply observed for config "test": `,
+			},
+		},
+		"missing apply": {
+			config: ExpectConfig{
+				ExpectApplies: []ApplyRef{
+					{
+						Group:              "apps",
+						Kind:               "Deployment",
+						Namespace:          ns,
+						Name:               "resource",
+						ApplyConfiguration: ac1,
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectApplies[0] not observed for config "test": `,
+			},
+		},
+
+		"expected update": {
+			config: ExpectConfig{
+				ExpectUpdates: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Update(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected update": {
+			config: ExpectConfig{
+				ExpectUpdates: []client.Object{
 					r2,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Create(ctx, r1.DeepCopy())
+				c.Update(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{
+				`ExpectUpdates[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra update": {
+			config: ExpectConfig{
+				ExpectUpdates: []client.Object{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Update(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{
+				`Unexpected Update observed for config "test": `,
+			},
+		},
+		"missing update": {
+			config: ExpectConfig{
+				ExpectUpdates: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectUpdates[0] not observed for config "test": `,
+			},
+		},
+
+		"expected patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`ExpectPatches[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`Unexpected Patch observed for config "test": `,
+			},
+		},
+		"missing patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectPatches[0] not observed for config "test": `,
+			},
+		},
+		"expected json patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.JSONPatchType, Patch: []byte(`[
+						{"path": "/status/fields", "op": "add", "value": {"foo": "bar"}}
+					]`)},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1.DeepCopy(), client.RawPatch(types.JSONPatchType, []byte(`[{"op":"add","path":"/status/fields","value":{"foo":"bar"}}]`)))
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected json patch": {
+			config: ExpectConfig{
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.JSONPatchType, Patch: []byte(`[{"op":"remove","path":"/status/fields"}]`)},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1.DeepCopy(), client.RawPatch(types.JSONPatchType, []byte(`[{"op":"add","path":"/status/fields","value":{"foo":"bar"}}]`)))
+			},
+			failedAssertions: []string{
+				`ExpectPatches[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"expected patch result": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1,
+				},
+				ExpectPatchResults: []client.Object{
+					r1patch,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected patch result": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1,
+				},
+				ExpectPatchResults: []client.Object{
+					r1,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`ExpectPatchResults[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra patch result": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1,
+				},
+				ExpectPatchResults: []client.Object{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`Unexpected PatchResult observed for config "test": `,
+			},
+		},
+		"missing patch result": {
+			config: ExpectConfig{
+				ExpectPatchResults: []client.Object{
+					r1patch,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectPatchResults[0] not observed for config "test": `,
+			},
+		},
+		"expected patch and patch result": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1,
+				},
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
+				},
+				ExpectPatchResults: []client.Object{
+					r1patch,
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`ExpectPatches[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+
+		"expected delete": {
+			config: ExpectConfig{
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r1, scheme),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Delete(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected delete": {
+			config: ExpectConfig{
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r2, scheme),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Delete(ctx, r1.DeepCopy())
 			},
 			failedAssertions: []string{
-				`ExpectCreates[0] differs for config "test" (-expected, +actual):`,
+				`ExpectDeletes[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra create": {
+		"extra delete": {
 			config: ExpectConfig{
-				ExpectCreates: []client.Object{},
+				ExpectDeletes: []DeleteRef{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Create(ctx, r1.DeepCopy())
+				c.Delete(ctx, r1.DeepCopy())
 			},
 			failedAssertions: []string{
-				`Unexpected Create observed for config "test": `,
+				`Unexpected Delete observed for config "test": `,
 			},
 		},
-		"missing create": {
+		"missing delete": {
 			config: ExpectConfig{
-				ExpectCreates: []client.Object{
-					r1,
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r1, scheme),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectCreates[0] not observed for config "test": `,
+				`ExpectDeletes[0] not observed for config "test": `,
 			},
 		},
-		"generate name": {
+
+		"expected actions": {
 			config: ExpectConfig{
 				ExpectCreates: []client.Object{
-					&resources.TestResource{
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace:    ns,
-							GenerateName: "resource-",
-						},
-					},
+					r2,
+				},
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r1, scheme),
+				},
+				ExpectActions: []ActionRef{
+					NewActionRefFromObject("create", r2, scheme),
+					NewActionRefFromObject("delete", r1, scheme),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				r := &resources.TestResource{
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace:    ns,
-						GenerateName: "resource-",
-					},
-				}
-
-				c.Create(ctx, r)
+				c.Create(ctx, r2.DeepCopy())
+				c.Delete(ctx, r1.DeepCopy())
 			},
+			failedAssertions: []string{},
 		},
-		"generate name unstructured": {
+		"unexpected action order": {
 			config: ExpectConfig{
 				ExpectCreates: []client.Object{
-					&unstructured.Unstructured{
-						Object: map[string]interface{}{
-							"apiVersion": "testing.reconciler.runtime/v1",
-							"kind":       "TestResource",
-							"metadata": map[string]interface{}{
-								"namespace":    ns,
-								"generateName": "resource-",
-							},
-						},
-					},
+					r2,
+				},
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r1, scheme),
+				},
+				ExpectActions: []ActionRef{
+					NewActionRefFromObject("create", r2, scheme),
+					NewActionRefFromObject("delete", r1, scheme),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				u := &unstructured.Unstructured{}
-				u.SetAPIVersion("testing.reconciler.runtime/v1")
-				u.SetKind("TestResource")
-				u.SetNamespace(ns)
-				u.SetGenerateName("resource-")
+				c.Delete(ctx, r1.DeepCopy())
+				c.Create(ctx, r2.DeepCopy())
+			},
+			failedAssertions: []string{
+				`ExpectActions[0] differs for config "test" (-expected, +actual):`,
+				`ExpectActions[1] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"expected sub-resource action": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1,
+				},
+				ExpectStatusUpdates: []client.Object{
+					r1,
+				},
+				ExpectActions: []ActionRef{
+					{Verb: "update", Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Status().Update(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{},
+		},
+		"extra action": {
+			config: ExpectConfig{
+				ExpectDeletes: []DeleteRef{
+					NewDeleteRefFromObject(r1, scheme),
+				},
+				ExpectActions: []ActionRef{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.Delete(ctx, r1.DeepCopy())
+			},
+			failedAssertions: []string{
+				`Unexpected Action observed for config "test": delete TestResource.testing.reconciler.runtime my-namespace/resource-1`,
+			},
+		},
+		"missing action": {
+			config: ExpectConfig{
+				ExpectActions: []ActionRef{
+					NewActionRefFromObject("delete", r1, scheme),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectActions[0] not observed for config "test": `,
+			},
+		},
 
-				c.Create(ctx, u)
+		"expected delete collection": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.DeleteAllOf(ctx, &resources.TestResource{})
+			},
+			failedAssertions: []string{},
+		},
+		"expected delete collection with label selector": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Labels: labels.SelectorFromSet(labels.Set{"foo": "bar"})},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.DeleteAllOf(ctx, &resources.TestResource{}, client.MatchingLabels{"foo": "bar"})
+			},
+			failedAssertions: []string{},
+		},
+		"expected delete collection with field selector": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Fields: fields.SelectorFromSet(fields.Set{".metadata.name": "bar"})},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.DeleteAllOf(ctx, &resources.TestResource{}, client.MatchingFields{".metadata.name": "bar"})
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected delete collection": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.DeleteAllOf(ctx, &resources.TestResourceNoStatus{})
+			},
+			failedAssertions: []string{
+				`ExpectDeleteCollections[0] differs for config "test" (-expected, +actual):`,
+			},
+		},
+		"extra delete collection": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.DeleteAllOf(ctx, &resources.TestResource{})
+			},
+			failedAssertions: []string{
+				`Unexpected DeleteCollection observed for config "test": `,
+			},
+		},
+		"missing delete collection": {
+			config: ExpectConfig{
+				ExpectDeleteCollections: []DeleteCollectionRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectDeleteCollections[0] not observed for config "test": `,
 			},
 		},
 
-		"expected apply": {
+		"expected list": {
 			config: ExpectConfig{
-				ExpectApplies: []ApplyRef{
-					{
-						Group:              "apps",
-						Kind:               "Deployment",
-						Namespace:          ns,
-						Name:               "resource",
-						ApplyConfiguration: ac1,
-					},
+				ExpectLists: []ListRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResourceList"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Apply(ctx, ac1)
+				c.List(ctx, &resources.TestResourceList{})
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected apply": {
+		"expected list with selectors": {
 			config: ExpectConfig{
-				ExpectApplies: []ApplyRef{
-					{
-						Group:              "apps",
-						Kind:               "Deployment",
-						Namespace:          ns,
-						Name:               "resource",
-						ApplyConfiguration: ac2,
-					},
+				ExpectLists: []ListRef{
+					NewListRefFromList(&resources.TestResourceList{}, scheme,
+						client.InNamespace("my-namespace"),
+						client.MatchingLabels{"foo": "bar"},
+						client.MatchingFields{"metadata.name": "resource-1"},
+					),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Apply(ctx, ac1)
+				c.List(ctx, &resources.TestResourceList{}, client.InNamespace("my-namespace"), client.MatchingLabels{"foo": "bar"}, client.MatchingFields{"metadata.name": "resource-1"})
+			},
+			failedAssertions: []string{},
+		},
+		"list with unexpected selector": {
+			config: ExpectConfig{
+				ExpectLists: []ListRef{
+					NewListRefFromList(&resources.TestResourceList{}, scheme, client.InNamespace("my-namespace"), client.MatchingLabels{"foo": "bar"}),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.List(ctx, &resources.TestResourceList{}, client.InNamespace("my-namespace"))
 			},
 			failedAssertions: []string{
-				`ExpectApplies[0] differs for config "test" (-expected, +actual):`,
+				`ExpectLists[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra apply": {
+		"extra list": {
 			config: ExpectConfig{
-				ExpectApplies: []ApplyRef{},
+				ExpectLists: []ListRef{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Apply(ctx, ac1)
+				c.List(ctx, &resources.TestResourceList{})
 			},
 			failedAssertions: []string{
-				`Unexpected Apply observed for config "test": `,
+				`Unexpected List observed for config "test": `,
 			},
 		},
-		"missing apply": {
+		"missing list": {
 			config: ExpectConfig{
-				ExpectApplies: []ApplyRef{
-					{
-						Group:              "apps",
-						Kind:               "Deployment",
-						Namespace:          ns,
-						Name:               "resource",
-						ApplyConfiguration: ac1,
-					},
+				ExpectLists: []ListRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResourceList"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectApplies[0] not observed for config "test": `,
+				`ExpectLists[0] not observed for config "test": `,
+			},
+		},
+		"lists not asserted": {
+			config: ExpectConfig{},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.List(ctx, &resources.TestResourceList{})
+			},
+			failedAssertions: []string{},
+		},
+		"api reader lists are not included": {
+			config: ExpectConfig{
+				ExpectLists: []ListRef{},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.APIReader.List(ctx, &resources.TestResourceList{})
 			},
+			failedAssertions: []string{},
 		},
 
-		"expected update": {
+		"expected status update": {
 			config: ExpectConfig{
-				ExpectUpdates: []client.Object{
+				ExpectStatusUpdates: []client.Object{
 					r1,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Update(ctx, r1.DeepCopy())
+				c.Status().Update(ctx, r1.DeepCopy())
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected update": {
+		"unexpected status update": {
 			config: ExpectConfig{
-				ExpectUpdates: []client.Object{
-					r2,
+				ExpectStatusUpdates: []client.Object{
+					r1patch,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Update(ctx, r1.DeepCopy())
+				c.Status().Update(ctx, r1.DeepCopy())
 			},
 			failedAssertions: []string{
-				`ExpectUpdates[0] differs for config "test" (-expected, +actual):`,
+				`ExpectStatusUpdates[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra update": {
+		"extra status update": {
 			config: ExpectConfig{
-				ExpectUpdates: []client.Object{},
+				ExpectStatusUpdates: []client.Object{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Update(ctx, r1.DeepCopy())
+				c.Status().Update(ctx, r1.DeepCopy())
 			},
 			failedAssertions: []string{
-				`Unexpected Update observed for config "test": `,
+				`Unexpected StatusUpdate observed for config "test": `,
 			},
 		},
-		"missing update": {
+		"missing status update": {
 			config: ExpectConfig{
-				ExpectUpdates: []client.Object{
+				ExpectStatusUpdates: []client.Object{
 					r1,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectUpdates[0] not observed for config "test": `,
+				`ExpectStatusUpdates[0] not observed for config "test": `,
 			},
 		},
 
-		"expected patch": {
+		"expected status patch": {
 			config: ExpectConfig{
-				ExpectPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
+				ExpectStatusPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected patch": {
+		"unexpected status patch": {
 			config: ExpectConfig{
-				ExpectPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
+				ExpectStatusPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{
-				`ExpectPatches[0] differs for config "test" (-expected, +actual):`,
+				`ExpectStatusPatches[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra patch": {
+		"extra status patch": {
 			config: ExpectConfig{
-				ExpectPatches: []PatchRef{},
+				ExpectStatusPatches: []PatchRef{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{
-				`Unexpected Patch observed for config "test": `,
+				`Unexpected StatusPatch observed for config "test": `,
 			},
 		},
-		"missing patch": {
+		"missing status patch": {
 			config: ExpectConfig{
-				ExpectPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
+				ExpectStatusPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectPatches[0] not observed for config "test": `,
+				`ExpectStatusPatches[0] not observed for config "test": `,
 			},
 		},
-
-		"expected delete": {
+		"expected status patch result": {
 			config: ExpectConfig{
-				ExpectDeletes: []DeleteRef{
-					NewDeleteRefFromObject(r1, scheme),
+				GivenObjects: []client.Object{
+					r1,
+				},
+				StatusSubResourceTypes: []client.Object{
+					&resources.TestResource{},
+				},
+				ExpectStatusPatchResults: []client.Object{
+					r1patch,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Delete(ctx, r1.DeepCopy())
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected delete": {
+		"unexpected status patch result": {
 			config: ExpectConfig{
-				ExpectDeletes: []DeleteRef{
-					NewDeleteRefFromObject(r2, scheme),
+				GivenObjects: []client.Object{
+					r1,
+				},
+				StatusSubResourceTypes: []client.Object{
+					&resources.TestResource{},
+				},
+				ExpectStatusPatchResults: []client.Object{
+					r1,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Delete(ctx, r1.DeepCopy())
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{
-				`ExpectDeletes[0] differs for config "test" (-expected, +actual):`,
+				`ExpectStatusPatchResults[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra delete": {
+		"extra status patch result": {
 			config: ExpectConfig{
-				ExpectDeletes: []DeleteRef{},
+				GivenObjects: []client.Object{
+					r1,
+				},
+				StatusSubResourceTypes: []client.Object{
+					&resources.TestResource{},
+				},
+				ExpectStatusPatchResults: []client.Object{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Delete(ctx, r1.DeepCopy())
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
 			},
 			failedAssertions: []string{
-				`Unexpected Delete observed for config "test": `,
+				`Unexpected StatusPatchResult observed for config "test": `,
 			},
 		},
-		"missing delete": {
+		"missing status patch result": {
 			config: ExpectConfig{
-				ExpectDeletes: []DeleteRef{
-					NewDeleteRefFromObject(r1, scheme),
+				ExpectStatusPatchResults: []client.Object{
+					r1patch,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectDeletes[0] not observed for config "test": `,
+				`ExpectStatusPatchResults[0] not observed for config "test": `,
 			},
 		},
-
-		"expected delete collection": {
+		"expected status patch and status patch result": {
 			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				GivenObjects: []client.Object{
+					r1,
 				},
-			},
-			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.DeleteAllOf(ctx, &resources.TestResource{})
-			},
-			failedAssertions: []string{},
-		},
-		"expected delete collection with label selector": {
-			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Labels: labels.SelectorFromSet(labels.Set{"foo": "bar"})},
+				StatusSubResourceTypes: []client.Object{
+					&resources.TestResource{},
+				},
+				ExpectStatusPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
+				},
+				ExpectStatusPatchResults: []client.Object{
+					r1patch,
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.DeleteAllOf(ctx, &resources.TestResource{}, client.MatchingLabels{"foo": "bar"})
+				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			},
+			failedAssertions: []string{
+				`ExpectStatusPatches[0] differs for config "test" (-expected, +actual):`,
 			},
-			failedAssertions: []string{},
 		},
-		"expected delete collection with field selector": {
+
+		"expected scale update": {
 			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Fields: fields.SelectorFromSet(fields.Set{".metadata.name": "bar"})},
+				GivenObjects: []client.Object{
+					deployment.DeepCopy(),
+				},
+				ExpectScaleUpdates: []client.Object{
+					&autoscalingv1.Scale{
+						ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "deployment-1"},
+						Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+					},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.DeleteAllOf(ctx, &resources.TestResource{}, client.MatchingFields{".metadata.name": "bar"})
+				d := deployment.DeepCopy()
+				scale := &autoscalingv1.Scale{}
+				if err := c.SubResource("scale").Get(ctx, d, scale); err != nil {
+					t.Errorf("unexpected scale get error: %s", err)
+				}
+				if scale.Spec.Replicas != 1 {
+					t.Errorf("expected 1 replica, got %d", scale.Spec.Replicas)
+				}
+				scale = &autoscalingv1.Scale{
+					ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "deployment-1"},
+					Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+				}
+				if err := c.SubResource("scale").Update(ctx, d, client.WithSubResourceBody(scale)); err != nil {
+					t.Errorf("unexpected scale update error: %s", err)
+				}
+				if err := c.Get(ctx, client.ObjectKeyFromObject(d), d); err != nil {
+					t.Errorf("unexpected get error: %s", err)
+				}
+				if replicas := ptr.Deref(d.Spec.Replicas, 0); replicas != 3 {
+					t.Errorf("expected 3 replicas, got %d", replicas)
+				}
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected delete collection": {
+		"extra scale update": {
 			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				GivenObjects: []client.Object{
+					deployment.DeepCopy(),
 				},
+				ExpectScaleUpdates: []client.Object{},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.DeleteAllOf(ctx, &resources.TestResourceNoStatus{})
+				scale := &autoscalingv1.Scale{
+					ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "deployment-1"},
+					Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+				}
+				c.SubResource("scale").Update(ctx, deployment.DeepCopy(), client.WithSubResourceBody(scale))
 			},
 			failedAssertions: []string{
-				`ExpectDeleteCollections[0] differs for config "test" (-expected, +actual):`,
+				`Unexpected ScaleUpdate observed for config "test": `,
 			},
 		},
-		"extra delete collection": {
+		"expected scale patch": {
 			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{},
+				GivenObjects: []client.Object{
+					deployment.DeepCopy(),
+				},
+				ExpectScalePatches: []PatchRef{
+					{Group: "apps", Kind: "Deployment", Namespace: ns, Name: "deployment-1", SubResource: "scale", PatchType: types.MergePatchType, Patch: []byte(`{"spec":{"replicas":3}}`)},
+				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.DeleteAllOf(ctx, &resources.TestResource{})
-			},
-			failedAssertions: []string{
-				`Unexpected DeleteCollection observed for config "test": `,
+				c.SubResource("scale").Patch(ctx, deployment.DeepCopy(), client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":3}}`)))
 			},
+			failedAssertions: []string{},
 		},
-		"missing delete collection": {
+		"missing scale patch": {
 			config: ExpectConfig{
-				ExpectDeleteCollections: []DeleteCollectionRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource"},
+				ExpectScalePatches: []PatchRef{
+					{Group: "apps", Kind: "Deployment", Namespace: ns, Name: "deployment-1", SubResource: "scale", PatchType: types.MergePatchType, Patch: []byte(`{"spec":{"replicas":3}}`)},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectDeleteCollections[0] not observed for config "test": `,
+				`ExpectScalePatches[0] not observed for config "test": `,
 			},
 		},
 
-		"expected status update": {
+		"expected sub-resource create": {
 			config: ExpectConfig{
-				ExpectStatusUpdates: []client.Object{
-					r1,
+				ExpectSubResourceCreates: []SubResourceRef{
+					{SubResource: "eviction", Object: eviction},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Update(ctx, r1.DeepCopy())
+				c.SubResource("eviction").Create(ctx, pod.DeepCopy(), eviction.DeepCopy())
 			},
 			failedAssertions: []string{},
 		},
-		"unexpected status update": {
+		"unexpected sub-resource create": {
 			config: ExpectConfig{
-				ExpectStatusUpdates: []client.Object{
-					r1patch,
+				ExpectSubResourceCreates: []SubResourceRef{
+					{SubResource: "binding", Object: eviction},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Update(ctx, r1.DeepCopy())
+				c.SubResource("eviction").Create(ctx, pod.DeepCopy(), eviction.DeepCopy())
 			},
 			failedAssertions: []string{
-				`ExpectStatusUpdates[0] differs for config "test" (-expected, +actual):`,
+				`ExpectSubResourceCreates[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"extra status update": {
-			config: ExpectConfig{
-				ExpectStatusUpdates: []client.Object{},
-			},
+		"extra sub-resource create": {
+			config: ExpectConfig{},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Update(ctx, r1.DeepCopy())
+				c.SubResource("eviction").Create(ctx, pod.DeepCopy(), eviction.DeepCopy())
 			},
 			failedAssertions: []string{
-				`Unexpected StatusUpdate observed for config "test": `,
+				`Unexpected SubResourceCreate observed for config "test": `,
 			},
 		},
-		"missing status update": {
+		"expected sub-resource update": {
 			config: ExpectConfig{
-				ExpectStatusUpdates: []client.Object{
-					r1,
+				ExpectSubResourceUpdates: []SubResourceRef{
+					{SubResource: "ephemeralcontainers", Object: podEphemeral},
 				},
 			},
-			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
-			failedAssertions: []string{
-				`ExpectStatusUpdates[0] not observed for config "test": `,
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.SubResource("ephemeralcontainers").Update(ctx, podEphemeral.DeepCopy())
 			},
+			failedAssertions: []string{},
 		},
-
-		"expected status patch": {
+		"missing sub-resource update": {
 			config: ExpectConfig{
-				ExpectStatusPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
+				ExpectSubResourceUpdates: []SubResourceRef{
+					{SubResource: "ephemeralcontainers", Object: podEphemeral},
 				},
 			},
-			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectSubResourceUpdates[0] not observed for config "test": `,
 			},
-			failedAssertions: []string{},
 		},
-		"unexpected status patch": {
+		"status update is not a sub-resource update": {
 			config: ExpectConfig{
-				ExpectStatusPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{}`)},
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				ExpectStatusUpdates: []client.Object{
+					r1patch.DeepCopy(),
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
-			},
-			failedAssertions: []string{
-				`ExpectStatusPatches[0] differs for config "test" (-expected, +actual):`,
+				c.SubResource("status").Update(ctx, r1patch.DeepCopy())
 			},
+			failedAssertions: []string{},
 		},
-		"extra status patch": {
+		"expected sub-resource patch": {
 			config: ExpectConfig{
-				ExpectStatusPatches: []PatchRef{},
+				ExpectSubResourcePatches: []PatchRef{
+					{Group: "", Kind: "Pod", Namespace: ns, Name: "pod-1", SubResource: "ephemeralcontainers", PatchType: types.StrategicMergePatchType, Patch: []byte(`{"spec":{"ephemeralContainers":[{"name":"debugger"}]}}`)},
+				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
-				c.Status().Patch(ctx, r1patch.DeepCopy(), client.MergeFrom(r1))
-			},
-			failedAssertions: []string{
-				`Unexpected StatusPatch observed for config "test": `,
+				c.SubResource("ephemeralcontainers").Patch(ctx, pod.DeepCopy(), client.RawPatch(types.StrategicMergePatchType, []byte(`{"spec":{"ephemeralContainers":[{"name":"debugger"}]}}`)))
 			},
+			failedAssertions: []string{},
 		},
-		"missing status patch": {
-			config: ExpectConfig{
-				ExpectStatusPatches: []PatchRef{
-					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", SubResource: "status", PatchType: types.MergePatchType, Patch: []byte(`{"status":{"fields":{"foo":"bar"}}}`)},
-				},
+		"extra sub-resource patch": {
+			config: ExpectConfig{},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				c.SubResource("ephemeralcontainers").Patch(ctx, pod.DeepCopy(), client.RawPatch(types.StrategicMergePatchType, []byte(`{"spec":{"ephemeralContainers":[{"name":"debugger"}]}}`)))
 			},
-			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectStatusPatches[0] not observed for config "test": `,
+				`Unexpected SubResourcePatch observed for config "test": `,
 			},
 		},
 
@@ -886,24 +2131,100 @@ func TestExpectConfig(t *testing.T) {
 				c.Status().Apply(ctx, acs1)
 			},
 			failedAssertions: []string{
-				`Unexpected StatusApply observed for config "test": `,
+				`Unexpected StatusApply observed for config "test": `,
+			},
+		},
+		"missing status apply": {
+			config: ExpectConfig{
+				ExpectStatusApplies: []ApplyRef{
+					{
+						Group:              "apps",
+						Kind:               "Deployment",
+						Namespace:          ns,
+						Name:               "resource",
+						ApplyConfiguration: acs1,
+					},
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectStatusApplies[0] not observed for config "test": `,
+			},
+		},
+
+		"expected finalizers after patch": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1.DeepCopy(),
+				},
+				ExpectPatches: []PatchRef{
+					{Group: "testing.reconciler.runtime", Kind: "TestResource", Namespace: ns, Name: "resource-1", PatchType: types.MergePatchType, Patch: []byte(`{"metadata":{"finalizers":["test.finalizer"],"resourceVersion":"999"}}`)},
+				},
+				ExpectFinalizers: []FinalizersRef{
+					NewFinalizersRef(r1, scheme, "test.finalizer"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				_ = c.Get(ctx, client.ObjectKeyFromObject(r1), r)
+				desired := r.DeepCopy()
+				desired.Finalizers = []string{"test.finalizer"}
+				c.Patch(ctx, desired, client.MergeFromWithOptions(r, client.MergeFromWithOptimisticLock{}))
+			},
+			failedAssertions: []string{},
+		},
+		"expected finalizers after update": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1finalizers.DeepCopy(),
+				},
+				ExpectUpdates: []client.Object{
+					r1.DeepCopy(),
+				},
+				ExpectFinalizers: []FinalizersRef{
+					NewFinalizersRef(r1, scheme),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {
+				r := &resources.TestResource{}
+				_ = c.Get(ctx, client.ObjectKeyFromObject(r1), r)
+				r.Finalizers = nil
+				c.Update(ctx, r)
+			},
+			failedAssertions: []string{},
+		},
+		"unexpected finalizers": {
+			config: ExpectConfig{
+				GivenObjects: []client.Object{
+					r1finalizers.DeepCopy(),
+				},
+				ExpectFinalizers: []FinalizersRef{
+					NewFinalizersRef(r1, scheme, "test.finalizer"),
+				},
+			},
+			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{
+				`ExpectFinalizers[0] differs for config "test" (-expected, +actual):`,
 			},
 		},
-		"missing status apply": {
+		"expected finalizers for missing object": {
 			config: ExpectConfig{
-				ExpectStatusApplies: []ApplyRef{
-					{
-						Group:              "apps",
-						Kind:               "Deployment",
-						Namespace:          ns,
-						Name:               "resource",
-						ApplyConfiguration: acs1,
-					},
+				ExpectFinalizers: []FinalizersRef{
+					NewFinalizersRef(r1, scheme),
+				},
+			},
+			operation:        func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
+			failedAssertions: []string{},
+		},
+		"expected finalizers for unknown kind": {
+			config: ExpectConfig{
+				ExpectFinalizers: []FinalizersRef{
+					{Group: "example.com", Kind: "Unknown", Namespace: ns, Name: "resource-1"},
 				},
 			},
 			operation: func(t *testing.T, ctx context.Context, c reconcilers.Config) {},
 			failedAssertions: []string{
-				`ExpectStatusApplies[0] not observed for config "test": `,
+				`ExpectFinalizers[0] unable to get object for config "test": `,
 			},
 		},
 
@@ -1033,7 +2354,7 @@ func TestExpectConfig(t *testing.T) {
 				t.Errorf("unexpected config assertions, wanted %d, got %d: %#v", expected, actual, c.observedErrors)
 			}
 			for i := range tc.failedAssertions {
-				expected, actual := tc.failedAssertions[i], c.observedErrors[i]
+				expected, actual := "[test] "+tc.failedAssertions[i], c.observedErrors[i]
 				if !strings.HasPrefix(actual, expected) {
 					t.Errorf("unexpected config assertions: expected prefix %q, actual %q", expected, actual)
 				}
@@ -1042,6 +2363,480 @@ func TestExpectConfig(t *testing.T) {
 	}
 }
 
+// withTestLogger returns a context with a test logger of the names
+func withTestLogger(t *testing.T, ctx context.Context, names ...string) context.Context {
+	log, _ := newLogRecorder(t)
+	for _, name := range names {
+		log = log.WithName(name)
+	}
+	return logr.NewContext(ctx, log)
+}
+
+func sourcedEvent(event Event, source string) Event {
+	event.Source = source
+	return event
+}
+
+func TestExpectConfig_ConcurrentEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	r1 := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "resource-1",
+		},
+	}
+
+	ec := &ExpectConfig{
+		Name:   "test",
+		Scheme: scheme,
+	}
+	c := ec.Config()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := withTestLogger(t, context.Background(), fmt.Sprintf("Reconciler%d", i))
+			c.EventRecorderFor(ctx).Eventf(r1, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		ec.ExpectEventsSource = fmt.Sprintf("Reconciler%d", i)
+		ec.ExpectEvents = []Event{
+			NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+		}
+		ec.AssertRecorderExpectations(nil)
+	}
+	if len(ec.observedErrors) != 0 {
+		t.Errorf("unexpected config assertions: %#v", ec.observedErrors)
+	}
+}
+
+func TestExpectConfig_DisableColorDiff(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	r1 := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "resource-1",
+		},
+	}
+	r2 := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "resource-2",
+		},
+	}
+
+	// force color regardless of the terminal running the tests
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = false
+
+	tests := map[string]struct {
+		disableColorDiff        bool
+		disableColorDiffPackage bool
+		expectColor             bool
+	}{
+		"colorized": {
+			expectColor: true,
+		},
+		"disabled for config": {
+			disableColorDiff: true,
+		},
+		"disabled for package": {
+			disableColorDiffPackage: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func(d bool) { DisableColorDiff = d }(DisableColorDiff)
+			DisableColorDiff = tc.disableColorDiffPackage
+
+			c := &ExpectConfig{
+				Name:             "test",
+				Scheme:           scheme,
+				DisableColorDiff: tc.disableColorDiff,
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			}
+			c.Config().Eventf(r2, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			c.AssertExpectations(nil)
+
+			if expected, actual := 1, len(c.observedErrors); expected != actual {
+				t.Fatalf("unexpected config assertions, wanted %d, got %d: %#v", expected, actual, c.observedErrors)
+			}
+			msg := c.observedErrors[0]
+			if expected, actual := tc.expectColor, strings.Contains(msg, "\x1b["); expected != actual {
+				t.Errorf("unexpected color in assertion, expected %v: %q", expected, msg)
+			}
+			if !tc.expectColor && !strings.HasPrefix(msg, `[test] ExpectEvents[0] differs for config "test" (-expected, +actual):`) {
+				t.Errorf("unexpected assertion: %q", msg)
+			}
+		})
+	}
+}
+
+func TestExpectConfig_ColorDiff(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	r1 := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "resource-1",
+		},
+	}
+	r2 := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "resource-2",
+		},
+	}
+
+	// disable color as if the tests are not running in a terminal
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = true
+	t.Setenv("COLOR_DIFF", "")
+	os.Unsetenv("COLOR_DIFF")
+
+	tests := map[string]struct {
+		colorDiff        bool
+		colorDiffEnv     bool
+		disableColorDiff bool
+		expectColor      bool
+	}{
+		"not colorized": {},
+		"forced for config": {
+			colorDiff:   true,
+			expectColor: true,
+		},
+		"forced by environment": {
+			colorDiffEnv: true,
+			expectColor:  true,
+		},
+		"disabled for config": {
+			colorDiff:        true,
+			disableColorDiff: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.colorDiffEnv {
+				t.Setenv("COLOR_DIFF", "true")
+			}
+
+			c := &ExpectConfig{
+				Name:             "test",
+				Scheme:           scheme,
+				ColorDiff:        tc.colorDiff,
+				DisableColorDiff: tc.disableColorDiff,
+				ExpectEvents: []Event{
+					NewEventf(r1, nil, scheme, corev1.EventTypeNormal, "TheReason", "the action", "the note"),
+				},
+			}
+			c.Config().Eventf(r2, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+			c.AssertExpectations(nil)
+
+			if expected, actual := 1, len(c.observedErrors); expected != actual {
+				t.Fatalf("unexpected config assertions, wanted %d, got %d: %#v", expected, actual, c.observedErrors)
+			}
+			msg := c.observedErrors[0]
+			if expected, actual := tc.expectColor, strings.Contains(msg, "\x1b["); expected != actual {
+				t.Errorf("unexpected color in assertion, expected %v: %q", expected, msg)
+			}
+			// forcing color for a config must not leak into the shared colors
+			if actual := DiffAddedColor.Sprint("+actual"); actual != "+actual" {
+				t.Errorf("unexpected color for DiffAddedColor: %q", actual)
+			}
+		})
+	}
+}
+
+func TestExpectConfig_ObservedErrors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	resource := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "test-resource",
+		},
+	}
+
+	defaultConfig := &ExpectConfig{
+		Scheme: scheme,
+	}
+	otherConfig := &ExpectConfig{
+		Name:   "other",
+		Scheme: scheme,
+	}
+	for _, c := range []*ExpectConfig{defaultConfig, otherConfig} {
+		c.Config().Eventf(resource, nil, corev1.EventTypeNormal, "TheReason", "the action", "the note")
+		c.AssertExpectations(nil)
+	}
+
+	if expected, actual := 0, len((&ExpectConfig{Scheme: scheme}).ObservedErrors()); expected != actual {
+		t.Errorf("unexpected errors for a new config, wanted %d, got %d", expected, actual)
+	}
+	for _, tc := range []struct {
+		config *ExpectConfig
+		prefix string
+	}{
+		{config: defaultConfig, prefix: "[default] Unexpected Event observed: "},
+		{config: otherConfig, prefix: `[other] Unexpected Event observed for config "other": `},
+	} {
+		errs := tc.config.ObservedErrors()
+		if expected, actual := 1, len(errs); expected != actual {
+			t.Fatalf("unexpected config assertions, wanted %d, got %d: %#v", expected, actual, errs)
+		}
+		if !strings.HasPrefix(errs[0], tc.prefix) {
+			t.Errorf("unexpected config assertion: expected prefix %q, actual %q", tc.prefix, errs[0])
+		}
+		// mutations to the returned errors must not leak into the config
+		errs[0] = ""
+		if tc.config.ObservedErrors()[0] == "" {
+			t.Errorf("observed errors mutated")
+		}
+	}
+}
+
+func TestExpectConfig_ShareGivenObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	shareable := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test-namespace",
+			Name:              "shareable",
+			ResourceVersion:   "1",
+			CreationTimestamp: metav1.NewTime(time.UnixMilli(2000)),
+		},
+		Data: map[string]string{
+			"hello": "world",
+		},
+	}
+	defaulted := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "defaulted",
+		},
+	}
+	givenObjects := []client.Object{
+		shareable.DeepCopy(),
+		defaulted.DeepCopy(),
+	}
+
+	c := &ExpectConfig{
+		Scheme:            scheme,
+		GivenObjects:      givenObjects,
+		ShareGivenObjects: true,
+	}
+	config := c.Config()
+
+	for _, expected := range []*corev1.ConfigMap{shareable, defaulted} {
+		actual := &corev1.ConfigMap{}
+		if err := config.Get(context.TODO(), client.ObjectKeyFromObject(expected), actual); err != nil {
+			t.Fatalf("unexpected error getting %q: %v", expected.Name, err)
+		}
+		// mutations to the returned object must not leak into the given object
+		actual.Data = map[string]string{"mutated": "true"}
+	}
+
+	if diff := cmp.Diff(shareable, givenObjects[0]); diff != "" {
+		t.Errorf("shared given object mutated (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(defaulted, givenObjects[1]); diff != "" {
+		t.Errorf("copied given object mutated (-expected, +actual): %s", diff)
+	}
+}
+
+func TestExpectConfig_Discovery(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	t.Run("default server version", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+		}
+		serverVersion, err := c.Config().Discovery.ServerVersion()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(&version.Info{}, serverVersion); diff != "" {
+			t.Errorf("unexpected server version (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("given server version", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			ServerVersion: &version.Info{
+				Major:      "1",
+				Minor:      "30",
+				GitVersion: "v1.30.0",
+			},
+		}
+		serverVersion, err := c.Config().Discovery.ServerVersion()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(&version.Info{Major: "1", Minor: "30", GitVersion: "v1.30.0"}, serverVersion); diff != "" {
+			t.Errorf("unexpected server version (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("discovery reactors", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			GivenAPIResources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "configmaps", SingularName: "configmap", Namespaced: true, Kind: "ConfigMap", Version: "v1"},
+					},
+				},
+			},
+			WithDiscoveryReactors: []ReactionFunc{
+				InduceFailure("get", "version"),
+			},
+		}
+		discovery := c.Config().Discovery
+		if _, err := discovery.ServerVersion(); err == nil || err.Error() != "inducing failure for get version" {
+			t.Errorf("expected induced server version error, got %v", err)
+		}
+		if _, err := discovery.ServerResourcesForGroupVersion("v1"); err != nil {
+			t.Errorf("unexpected server resources error: %v", err)
+		}
+	})
+}
+
+func TestExpectConfig_Defaulter(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	defaulter := func(obj client.Object) {
+		if cm, ok := obj.(*corev1.ConfigMap); ok && cm.Data == nil {
+			cm.Data = map[string]string{"defaulted": "true"}
+		}
+	}
+
+	given := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "given",
+		},
+	}
+	created := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "created",
+		},
+	}
+
+	c := &ExpectConfig{
+		Scheme:       scheme,
+		GivenObjects: []client.Object{given},
+		Defaulter:    defaulter,
+		ExpectCreates: []client.Object{
+			// recorded as sent by the reconciler
+			created,
+		},
+	}
+	config := c.Config()
+
+	actual := &corev1.ConfigMap{}
+	if err := config.Get(context.TODO(), client.ObjectKeyFromObject(given), actual); err != nil {
+		t.Fatalf("unexpected error getting given object: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"defaulted": "true"}, actual.Data); diff != "" {
+		t.Errorf("given object not defaulted (-expected, +actual): %s", diff)
+	}
+	if given.Data != nil {
+		t.Errorf("given object must not be mutated")
+	}
+
+	obj := created.DeepCopy()
+	if err := config.Create(context.TODO(), obj); err != nil {
+		t.Fatalf("unexpected error creating object: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"defaulted": "true"}, obj.Data); diff != "" {
+		t.Errorf("created object not defaulted (-expected, +actual): %s", diff)
+	}
+
+	c.AssertExpectations(t)
+}
+
+func TestExpectConfig_WithClientInterceptors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	given := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "hidden",
+		},
+	}
+	created := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "created",
+		},
+	}
+
+	c := &ExpectConfig{
+		Scheme:       scheme,
+		GivenObjects: []client.Object{given},
+		WithClientInterceptors: []interceptor.Funcs{
+			{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if key.Name == "hidden" {
+						return apierrs.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					obj.SetLabels(map[string]string{"intercepted": "true"})
+					return c.Create(ctx, obj, opts...)
+				},
+			},
+		},
+		ExpectCreates: []client.Object{
+			// interceptors are called before the request is recorded
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace",
+					Name:      "created",
+					Labels:    map[string]string{"intercepted": "true"},
+				},
+			},
+		},
+	}
+	config := c.Config()
+
+	if err := config.Get(context.TODO(), client.ObjectKeyFromObject(given), &corev1.ConfigMap{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expected intercepted get to return not found, got %v", err)
+	}
+	if err := config.Create(context.TODO(), created.DeepCopy()); err != nil {
+		t.Fatalf("unexpected error creating object: %v", err)
+	}
+	if err := config.Get(context.TODO(), client.ObjectKeyFromObject(created), &corev1.ConfigMap{}); err != nil {
+		t.Errorf("unexpected error getting created object: %v", err)
+	}
+
+	c.AssertExpectations(t)
+}
+
 func TestIgnoreLastTransitionTime(t *testing.T) {
 	a := diemetav1.ConditionBlank.
 		Type("Ready").
@@ -1290,3 +3085,213 @@ func TestNormalizeApplyConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkExpectConfig_GivenObjects(b *testing.B) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	data := map[string]string{}
+	for i := 0; i < 100; i++ {
+		data[fmt.Sprintf("key-%d", i)] = strings.Repeat("x", 1024)
+	}
+	givenObjects := make([]client.Object, 100)
+	for i := range givenObjects {
+		givenObjects[i] = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test-namespace",
+				Name:              fmt.Sprintf("config-%d", i),
+				ResourceVersion:   "1",
+				CreationTimestamp: metav1.NewTime(time.UnixMilli(1000)),
+			},
+			Data: data,
+		}
+	}
+
+	b.Run("copy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c := &ExpectConfig{
+				Scheme:       scheme,
+				GivenObjects: givenObjects,
+			}
+			c.Config()
+		}
+	})
+	b.Run("shared", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c := &ExpectConfig{
+				Scheme:            scheme,
+				GivenObjects:      givenObjects,
+				ShareGivenObjects: true,
+			}
+			c.Config()
+		}
+	})
+}
+
+func TestExpectConfig_GivenResourceVersion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	configMap := func(resourceVersion string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "my-namespace",
+				Name:            "my-config",
+				ResourceVersion: resourceVersion,
+			},
+		}
+	}
+	key := types.NamespacedName{Namespace: "my-namespace", Name: "my-config"}
+
+	t.Run("defaulted resource version", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			GivenObjects: []client.Object{
+				configMap(""),
+			},
+		}
+		actual := &corev1.ConfigMap{}
+		if err := c.Config().Get(context.TODO(), key, actual); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := "999"; actual.ResourceVersion != expected {
+			t.Errorf("expected resource version %q, got %q", expected, actual.ResourceVersion)
+		}
+	})
+
+	t.Run("given resource version", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			GivenObjects: []client.Object{
+				configMap("5"),
+			},
+		}
+		actual := &corev1.ConfigMap{}
+		if err := c.Config().Get(context.TODO(), key, actual); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := "5"; actual.ResourceVersion != expected {
+			t.Errorf("expected resource version %q, got %q", expected, actual.ResourceVersion)
+		}
+		if err := c.Config().Update(context.TODO(), actual); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := "6"; actual.ResourceVersion != expected {
+			t.Errorf("expected resource version %q, got %q", expected, actual.ResourceVersion)
+		}
+	})
+
+	t.Run("stale resource version conflicts", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			GivenObjects: []client.Object{
+				configMap("5"),
+			},
+		}
+		if err := c.Config().Update(context.TODO(), configMap("4")); !apierrs.IsConflict(err) {
+			t.Errorf("expected conflict error, got %v", err)
+		}
+	})
+
+	t.Run("stale read from the api reader", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			GivenObjects: []client.Object{
+				configMap("5"),
+			},
+			APIGivenObjects: []client.Object{
+				configMap("4"),
+			},
+		}
+		stale := &corev1.ConfigMap{}
+		if err := c.Config().APIReader.Get(context.TODO(), key, stale); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := c.Config().Update(context.TODO(), stale); !apierrs.IsConflict(err) {
+			t.Errorf("expected conflict error, got %v", err)
+		}
+	})
+}
+
+func TestExpectConfig_NoStatusSubResourceTypes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-resource")
+		})
+	key := types.NamespacedName{Namespace: "my-namespace", Name: "my-resource"}
+
+	t.Run("status sub-resource", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				resource.DieReleasePtr(),
+			},
+		}
+		updated := resource.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.ResourceVersion("999")
+			}).
+			StatusDie(func(d *dies.TestResourceStatusDie) {
+				d.AddField("foo", "bar")
+			}).
+			DieReleasePtr()
+		if err := c.Config().Update(context.TODO(), updated.DeepCopy()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actual := &resources.TestResource{}
+		if err := c.Config().Get(context.TODO(), key, actual); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual.Status.Fields != nil {
+			t.Errorf("expected status to be ignored when updating the resource, got %v", actual.Status.Fields)
+		}
+		if err := c.Config().Status().Update(context.TODO(), actual); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("no status sub-resource", func(t *testing.T) {
+		c := &ExpectConfig{
+			Scheme: scheme,
+			StatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			NoStatusSubResourceTypes: []client.Object{
+				&resources.TestResource{},
+			},
+			GivenObjects: []client.Object{
+				resource.DieReleasePtr(),
+			},
+		}
+		updated := resource.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.ResourceVersion("999")
+			}).
+			StatusDie(func(d *dies.TestResourceStatusDie) {
+				d.AddField("foo", "bar")
+			}).
+			DieReleasePtr()
+		if err := c.Config().Update(context.TODO(), updated.DeepCopy()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actual := &resources.TestResource{}
+		if err := c.Config().Get(context.TODO(), key, actual); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := map[string]string{"foo": "bar"}; !cmp.Equal(expected, actual.Status.Fields) {
+			t.Errorf("expected status to be updated with the resource, got %v", actual.Status.Fields)
+		}
+		if err := c.Config().Status().Update(context.TODO(), actual); !apierrs.IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
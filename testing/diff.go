@@ -17,14 +17,29 @@ limitations under the License.
 package testing
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"reconciler.io/runtime/diff"
+	"reconciler.io/runtime/internal"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/stash"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
 )
 
+// Differ compares expected and actual values for each kind of assertion. The Resource method is
+// compatible with diff.Differ.
+//
+// Comparisons for assertions added after this interface was defined are in ExtendedDiffer, which
+// a Differ may optionally implement.
 type Differ interface {
 	Result(expected, actual reconcilers.Result) string
 	TrackRequest(expected, actual TrackRequest) string
@@ -41,11 +56,89 @@ type Differ interface {
 	WebhookResponse(expected, actual admission.Response) string
 }
 
+// ExtendedDiffer is an optional extension of Differ with the comparisons for assertions added
+// after the Differ interface was defined. A Differ that does not implement ExtendedDiffer uses the
+// DefaultDiffer for these comparisons, see ExtendDiffer.
+type ExtendedDiffer interface {
+	Differ
+	ListRef(expected, actual ListRef) string
+	FinalizersRef(expected, actual FinalizersRef) string
+	ActionRef(expected, actual ActionRef) string
+	ResourceMetadata(expected, actual ResourceMetadata) string
+	StatusConditions(expected, actual []metav1.Condition) string
+	DiscoveryRequest(expected, actual DiscoveryRequest) string
+}
+
+// ExtendDiffer returns the Differ as an ExtendedDiffer. When the Differ does not implement
+// ExtendedDiffer, the comparisons it is missing are delegated to the DefaultDiffer.
+func ExtendDiffer(d Differ) ExtendedDiffer {
+	if ed, ok := d.(ExtendedDiffer); ok {
+		return ed
+	}
+	return &extendedDiffer{Differ: d}
+}
+
+type extendedDiffer struct {
+	Differ
+}
+
+// defaults returns the DefaultDiffer, or a basic differ when the DefaultDiffer is overridden with
+// a Differ that is not an ExtendedDiffer
+func (*extendedDiffer) defaults() ExtendedDiffer {
+	if ed, ok := DefaultDiffer.(ExtendedDiffer); ok {
+		return ed
+	}
+	return &differ{}
+}
+
+func (d *extendedDiffer) ListRef(expected, actual ListRef) string {
+	return d.defaults().ListRef(expected, actual)
+}
+
+func (d *extendedDiffer) FinalizersRef(expected, actual FinalizersRef) string {
+	return d.defaults().FinalizersRef(expected, actual)
+}
+
+func (d *extendedDiffer) ActionRef(expected, actual ActionRef) string {
+	return d.defaults().ActionRef(expected, actual)
+}
+
+func (d *extendedDiffer) ResourceMetadata(expected, actual ResourceMetadata) string {
+	return d.defaults().ResourceMetadata(expected, actual)
+}
+
+func (d *extendedDiffer) StatusConditions(expected, actual []metav1.Condition) string {
+	return d.defaults().StatusConditions(expected, actual)
+}
+
+func (d *extendedDiffer) DiscoveryRequest(expected, actual DiscoveryRequest) string {
+	return d.defaults().DiscoveryRequest(expected, actual)
+}
+
 // DefaultDiffer is a basic implementation of the Differ interface that is used by default unless
 // overridden for a specific test case or globally.
+//
+// Created, updated and stashed resources are compared ignoring the metadata fields managed by the
+// API Server, see IgnoreServerManagedFields.
 var DefaultDiffer Differ = &differ{}
 
-type differ struct{}
+var _ diff.Differ = (Differ)(nil)
+var _ ExtendedDiffer = (*differ)(nil)
+
+// NewDiffer creates a Differ that behaves like the DefaultDiffer with additional cmp options, like
+// EquateApproxTime. The options are used when comparing resources and stashed values.
+func NewDiffer(opts ...cmp.Option) Differ {
+	return &differ{opts: opts}
+}
+
+type differ struct {
+	opts []cmp.Option
+}
+
+// options returns the options for a comparison followed by the additional options of the differ
+func (d *differ) options(opts ...cmp.Option) []cmp.Option {
+	return append(opts, d.opts...)
+}
 
 func (*differ) Result(expected, actual reconcilers.Result) string {
 	return cmp.Diff(expected, actual)
@@ -64,7 +157,7 @@ func (*differ) ApplyRef(expected, actual ApplyRef) string {
 }
 
 func (*differ) PatchRef(expected, actual PatchRef) string {
-	return cmp.Diff(expected, actual)
+	return cmp.Diff(expected, actual, NormalizeJSONPatch)
 }
 
 func (*differ) DeleteRef(expected, actual DeleteRef) string {
@@ -75,47 +168,372 @@ func (*differ) DeleteCollectionRef(expected, actual DeleteCollectionRef) string
 	return cmp.Diff(expected, actual, NormalizeLabelSelector, NormalizeFieldSelector)
 }
 
-func (*differ) StashedValue(expected, actual any, key stash.Key) string {
-	return cmp.Diff(expected, actual, reconcilers.IgnoreAllUnexported,
+func (*differ) ListRef(expected, actual ListRef) string {
+	return cmp.Diff(expected, actual, NormalizeLabelSelector, NormalizeFieldSelector)
+}
+
+func (*differ) FinalizersRef(expected, actual FinalizersRef) string {
+	return cmp.Diff(expected, actual, cmpopts.EquateEmpty())
+}
+
+func (*differ) ActionRef(expected, actual ActionRef) string {
+	return cmp.Diff(expected, actual)
+}
+
+func (*differ) ResourceMetadata(expected, actual ResourceMetadata) string {
+	return cmp.Diff(expected, actual, cmpopts.EquateEmpty())
+}
+
+func (*differ) StatusConditions(expected, actual []metav1.Condition) string {
+	return cmp.Diff(expected, actual,
+		IgnoreLastTransitionTime,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y metav1.Condition) bool { return x.Type < y.Type }),
+	)
+}
+
+func (*differ) DiscoveryRequest(expected, actual DiscoveryRequest) string {
+	return cmp.Diff(expected, actual)
+}
+
+func (d *differ) StashedValue(expected, actual any, key stash.Key) string {
+	if e, ok := expected.(client.Object); ok {
+		if a, ok := actual.(client.Object); ok {
+			actual = ignoreGeneratedName(e, a)
+		}
+	}
+	return cmp.Diff(expected, actual, d.options(reconcilers.IgnoreAllUnexported,
 		IgnoreLastTransitionTime,
 		IgnoreTypeMeta,
-		IgnoreCreationTimestamp,
-		IgnoreResourceVersion,
-		cmpopts.EquateEmpty())
+		IgnoreServerManagedFields,
+		cmpopts.EquateEmpty())...)
 }
 
-func (*differ) Resource(expected, actual client.Object) string {
-	return cmp.Diff(expected, actual, reconcilers.IgnoreAllUnexported,
+func (d *differ) Resource(expected, actual client.Object) string {
+	actual = ignoreGeneratedName(expected, actual)
+	return cmp.Diff(expected, actual, d.options(reconcilers.IgnoreAllUnexported,
 		IgnoreLastTransitionTime,
 		IgnoreTypeMeta,
-		cmpopts.EquateEmpty())
+		cmpopts.EquateEmpty())...)
 }
 
-func (*differ) ResourceStatusUpdate(expected, actual client.Object) string {
-	return cmp.Diff(expected, actual, statusSubresourceOnly,
+func (d *differ) ResourceStatusUpdate(expected, actual client.Object) string {
+	return cmp.Diff(expected, actual, d.options(statusSubresourceOnly,
 		reconcilers.IgnoreAllUnexported,
 		IgnoreLastTransitionTime,
-		cmpopts.EquateEmpty())
+		cmpopts.EquateEmpty())...)
 }
 
-func (*differ) ResourceUpdate(expected, actual client.Object) string {
-	return cmp.Diff(expected, actual, reconcilers.IgnoreAllUnexported,
+func (d *differ) ResourceUpdate(expected, actual client.Object) string {
+	actual = ignoreGeneratedName(expected, actual)
+	return cmp.Diff(expected, actual, d.options(reconcilers.IgnoreAllUnexported,
 		IgnoreLastTransitionTime,
 		IgnoreTypeMeta,
-		IgnoreCreationTimestamp,
-		IgnoreResourceVersion,
-		cmpopts.EquateEmpty())
+		IgnoreServerManagedFields,
+		cmpopts.EquateEmpty())...)
 }
 
-func (*differ) ResourceCreate(expected, actual client.Object) string {
-	return cmp.Diff(expected, actual, reconcilers.IgnoreAllUnexported,
+func (d *differ) ResourceCreate(expected, actual client.Object) string {
+	actual = ignoreGeneratedName(expected, actual)
+	return cmp.Diff(expected, actual, d.options(reconcilers.IgnoreAllUnexported,
 		IgnoreLastTransitionTime,
 		IgnoreTypeMeta,
-		IgnoreCreationTimestamp,
-		IgnoreResourceVersion,
-		cmpopts.EquateEmpty())
+		IgnoreServerManagedFields,
+		cmpopts.EquateEmpty())...)
 }
 
 func (*differ) WebhookResponse(expected, actual admission.Response) string {
 	return cmp.Diff(expected, actual, reconcilers.IgnoreAllUnexported)
 }
+
+// ignoreGeneratedName returns a copy of the actual object without a name when the expected object
+// relies on a generated name and the actual name was generated from the same generateName prefix.
+// Otherwise, the actual object is returned as is.
+func ignoreGeneratedName(expected, actual client.Object) client.Object {
+	if internal.IsNil(expected) || internal.IsNil(actual) {
+		return actual
+	}
+	if expected.GetName() != "" || expected.GetGenerateName() == "" {
+		return actual
+	}
+	if actual.GetGenerateName() != expected.GetGenerateName() || !strings.HasPrefix(actual.GetName(), expected.GetGenerateName()) {
+		return actual
+	}
+	actual = actual.DeepCopyObject().(client.Object)
+	actual.SetName("")
+	return actual
+}
+
+// WithLooseOwnerReferences wraps a Differ so that resources are compared without the uid and
+// blockOwnerDeletion fields of their owner references. Ownership is still asserted by the
+// apiVersion, kind, name and controller fields. Combine with ExpectOwnedBy to assert a child is
+// controlled by its parent without pinning the parent's UID.
+//
+// Applies to Resource, ResourceCreate, ResourceUpdate and client.Object StashedValue comparisons,
+// all other comparisons are delegated unmodified.
+func WithLooseOwnerReferences(d Differ) Differ {
+	return &looseOwnerReferencesDiffer{ExtendedDiffer: ExtendDiffer(d)}
+}
+
+type looseOwnerReferencesDiffer struct {
+	ExtendedDiffer
+}
+
+func (d *looseOwnerReferencesDiffer) StashedValue(expected, actual any, key stash.Key) string {
+	if e, ok := expected.(client.Object); ok {
+		expected = loosenOwnerReferences(e)
+	}
+	if a, ok := actual.(client.Object); ok {
+		actual = loosenOwnerReferences(a)
+	}
+	return d.ExtendedDiffer.StashedValue(expected, actual, key)
+}
+
+func (d *looseOwnerReferencesDiffer) Resource(expected, actual client.Object) string {
+	return d.ExtendedDiffer.Resource(loosenOwnerReferences(expected), loosenOwnerReferences(actual))
+}
+
+func (d *looseOwnerReferencesDiffer) ResourceUpdate(expected, actual client.Object) string {
+	return d.ExtendedDiffer.ResourceUpdate(loosenOwnerReferences(expected), loosenOwnerReferences(actual))
+}
+
+func (d *looseOwnerReferencesDiffer) ResourceCreate(expected, actual client.Object) string {
+	return d.ExtendedDiffer.ResourceCreate(loosenOwnerReferences(expected), loosenOwnerReferences(actual))
+}
+
+// loosenOwnerReferences returns a copy of the object with the uid and blockOwnerDeletion fields
+// of each owner reference cleared
+func loosenOwnerReferences(obj client.Object) client.Object {
+	if obj == nil || len(obj.GetOwnerReferences()) == 0 {
+		return obj
+	}
+	obj = obj.DeepCopyObject().(client.Object)
+	refs := obj.GetOwnerReferences()
+	for i := range refs {
+		refs[i].UID = ""
+		refs[i].BlockOwnerDeletion = nil
+	}
+	obj.SetOwnerReferences(refs)
+	return obj
+}
+
+// ExpectOwnedBy returns a copy of the object with a controller owner reference to the owner. The
+// UID of the owner is included when defined, use WithLooseOwnerReferences to compare owner
+// references without the uid and blockOwnerDeletion fields.
+func ExpectOwnedBy(obj, owner client.Object, scheme *runtime.Scheme) client.Object {
+	obj = obj.DeepCopyObject().(client.Object)
+	gvk := owner.GetObjectKind().GroupVersionKind()
+	if gvks, _, err := scheme.ObjectKinds(owner); err == nil && len(gvks) != 0 {
+		gvk = gvks[0]
+	}
+	if gvk == (schema.GroupVersionKind{}) {
+		panic(fmt.Errorf("unable to determine the kind of owner %T", owner))
+	}
+	refs := obj.GetOwnerReferences()
+	refs = append(refs, *metav1.NewControllerRef(owner, gvk))
+	obj.SetOwnerReferences(refs)
+	return obj
+}
+
+// ResourceDiffStrategy renders the difference between an expected and actual resource. False is
+// returned when the strategy does not handle the resources, the next strategy is tried.
+type ResourceDiffStrategy func(expected, actual client.Object) (string, bool)
+
+// CompositeDiffer is a Differ that renders the difference between resources with alternate
+// strategies, falling back to the Differ. Whether resources differ is always decided by the Differ,
+// the strategies only render a difference already found. When no strategy handles the resources,
+// or a strategy is unable to render the difference, the diff from the Differ is returned.
+//
+// Strategies are configured for each resource method, all other comparisons are delegated to the
+// Differ.
+//
+//	Differ: &rtesting.CompositeDiffer{
+//		ResourceStrategies: []rtesting.ResourceDiffStrategy{
+//			rtesting.UnstructuredYAMLDiff,
+//		},
+//	},
+type CompositeDiffer struct {
+	// Differ decides if resources differ and renders all differences not handled by a strategy.
+	// Defaults to DefaultDiffer.
+	//
+	// +optional
+	Differ
+	// ResourceStrategies are tried in order to render differences for Resource.
+	//
+	// +optional
+	ResourceStrategies []ResourceDiffStrategy
+	// ResourceStatusUpdateStrategies are tried in order to render differences for
+	// ResourceStatusUpdate.
+	//
+	// +optional
+	ResourceStatusUpdateStrategies []ResourceDiffStrategy
+	// ResourceUpdateStrategies are tried in order to render differences for ResourceUpdate.
+	//
+	// +optional
+	ResourceUpdateStrategies []ResourceDiffStrategy
+	// ResourceCreateStrategies are tried in order to render differences for ResourceCreate.
+	//
+	// +optional
+	ResourceCreateStrategies []ResourceDiffStrategy
+}
+
+var _ ExtendedDiffer = (*CompositeDiffer)(nil)
+
+func (d *CompositeDiffer) differ() ExtendedDiffer {
+	if d.Differ == nil {
+		return ExtendDiffer(DefaultDiffer)
+	}
+	return ExtendDiffer(d.Differ)
+}
+
+func (d *CompositeDiffer) Result(expected, actual reconcilers.Result) string {
+	return d.differ().Result(expected, actual)
+}
+
+func (d *CompositeDiffer) TrackRequest(expected, actual TrackRequest) string {
+	return d.differ().TrackRequest(expected, actual)
+}
+
+func (d *CompositeDiffer) Event(expected, actual Event) string {
+	return d.differ().Event(expected, actual)
+}
+
+func (d *CompositeDiffer) ApplyRef(expected, actual ApplyRef) string {
+	return d.differ().ApplyRef(expected, actual)
+}
+
+func (d *CompositeDiffer) PatchRef(expected, actual PatchRef) string {
+	return d.differ().PatchRef(expected, actual)
+}
+
+func (d *CompositeDiffer) DeleteRef(expected, actual DeleteRef) string {
+	return d.differ().DeleteRef(expected, actual)
+}
+
+func (d *CompositeDiffer) DeleteCollectionRef(expected, actual DeleteCollectionRef) string {
+	return d.differ().DeleteCollectionRef(expected, actual)
+}
+
+func (d *CompositeDiffer) ListRef(expected, actual ListRef) string {
+	return d.differ().ListRef(expected, actual)
+}
+
+func (d *CompositeDiffer) FinalizersRef(expected, actual FinalizersRef) string {
+	return d.differ().FinalizersRef(expected, actual)
+}
+
+func (d *CompositeDiffer) ActionRef(expected, actual ActionRef) string {
+	return d.differ().ActionRef(expected, actual)
+}
+
+func (d *CompositeDiffer) ResourceMetadata(expected, actual ResourceMetadata) string {
+	return d.differ().ResourceMetadata(expected, actual)
+}
+
+func (d *CompositeDiffer) StatusConditions(expected, actual []metav1.Condition) string {
+	return d.differ().StatusConditions(expected, actual)
+}
+
+func (d *CompositeDiffer) DiscoveryRequest(expected, actual DiscoveryRequest) string {
+	return d.differ().DiscoveryRequest(expected, actual)
+}
+
+func (d *CompositeDiffer) StashedValue(expected, actual any, key stash.Key) string {
+	return d.differ().StashedValue(expected, actual, key)
+}
+
+func (d *CompositeDiffer) Resource(expected, actual client.Object) string {
+	return renderResourceDiff(d.differ().Resource(expected, actual), expected, actual, d.ResourceStrategies)
+}
+
+func (d *CompositeDiffer) ResourceStatusUpdate(expected, actual client.Object) string {
+	return renderResourceDiff(d.differ().ResourceStatusUpdate(expected, actual), expected, actual, d.ResourceStatusUpdateStrategies)
+}
+
+func (d *CompositeDiffer) ResourceUpdate(expected, actual client.Object) string {
+	return renderResourceDiff(d.differ().ResourceUpdate(expected, actual), expected, actual, d.ResourceUpdateStrategies)
+}
+
+func (d *CompositeDiffer) ResourceCreate(expected, actual client.Object) string {
+	return renderResourceDiff(d.differ().ResourceCreate(expected, actual), expected, actual, d.ResourceCreateStrategies)
+}
+
+func (d *CompositeDiffer) WebhookResponse(expected, actual admission.Response) string {
+	return d.differ().WebhookResponse(expected, actual)
+}
+
+// renderResourceDiff returns the difference rendered by the first strategy to handle the
+// resources, or the fallback when the resources do not differ or no strategy renders a difference
+func renderResourceDiff(fallback string, expected, actual client.Object, strategies []ResourceDiffStrategy) string {
+	if fallback == "" {
+		return ""
+	}
+	for _, strategy := range strategies {
+		if d, ok := strategy(expected, actual); ok {
+			if d == "" {
+				// the difference is not visible to the strategy
+				break
+			}
+			return d
+		}
+	}
+	return fallback
+}
+
+// UnstructuredYAMLDiff is a ResourceDiffStrategy that renders the difference between resources as
+// the lines of their YAML representation. Only unstructured resources are handled, the
+// difference between typed resources is rendered by the next strategy.
+//
+// Server populated metadata that is noisy for most assertions (creationTimestamp, managedFields
+// and resourceVersion) is omitted along with the lastTransitionTime of status conditions.
+func UnstructuredYAMLDiff(expected, actual client.Object) (string, bool) {
+	if !isUnstructured(expected) && !isUnstructured(actual) {
+		return "", false
+	}
+	expectedLines, err := normalizedYAMLLines(expected)
+	if err != nil {
+		return "", false
+	}
+	actualLines, err := normalizedYAMLLines(actual)
+	if err != nil {
+		return "", false
+	}
+	return cmp.Diff(expectedLines, actualLines), true
+}
+
+func isUnstructured(obj client.Object) bool {
+	_, ok := obj.(runtime.Unstructured)
+	return ok
+}
+
+// normalizedYAMLLines returns the lines of the YAML representation of the object without noisy
+// server populated fields
+func normalizedYAMLLines(obj client.Object) ([]string, error) {
+	if internal.IsNil(obj) {
+		return []string{}, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	// the content of an unstructured object is not copied by the converter
+	content = runtime.DeepCopyJSON(content)
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(content, "metadata", "managedFields")
+	unstructured.RemoveNestedField(content, "metadata", "resourceVersion")
+	if conditions, found, err := unstructured.NestedSlice(content, "status", "conditions"); err == nil && found {
+		for _, condition := range conditions {
+			if condition, ok := condition.(map[string]interface{}); ok {
+				delete(condition, "lastTransitionTime")
+			}
+		}
+		if err := unstructured.SetNestedSlice(content, conditions, "status", "conditions"); err != nil {
+			return nil, err
+		}
+	}
+	b, err := yaml.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(string(b), "\n"), "\n"), nil
+}
@@ -17,6 +17,19 @@ limitations under the License.
 package testing
 
 import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"reconciler.io/runtime/internal/resources"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/stash"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,6 +68,30 @@ func (d *staticDiffer) DeleteCollectionRef(expected, actual DeleteCollectionRef)
 	return d.diff
 }
 
+func (d *staticDiffer) ListRef(expected, actual ListRef) string {
+	return d.diff
+}
+
+func (d *staticDiffer) FinalizersRef(expected, actual FinalizersRef) string {
+	return d.diff
+}
+
+func (d *staticDiffer) ActionRef(expected, actual ActionRef) string {
+	return d.diff
+}
+
+func (d *staticDiffer) ResourceMetadata(expected, actual ResourceMetadata) string {
+	return d.diff
+}
+
+func (d *staticDiffer) StatusConditions(expected, actual []metav1.Condition) string {
+	return d.diff
+}
+
+func (d *staticDiffer) DiscoveryRequest(expected, actual DiscoveryRequest) string {
+	return d.diff
+}
+
 func (d *staticDiffer) StashedValue(expected, actual any, key stash.Key) string {
 	return d.diff
 }
@@ -78,3 +115,441 @@ func (d *staticDiffer) ResourceCreate(expected, actual client.Object) string {
 func (d *staticDiffer) WebhookResponse(expected, actual admission.Response) string {
 	return d.diff
 }
+
+func TestWithLooseOwnerReferences(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	parent := &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "parent",
+		},
+	}
+	child := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "child",
+		},
+	}
+	owned := child.DeepCopy()
+	owned.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion:         resources.GroupVersion.String(),
+			Kind:               "TestResource",
+			Name:               "parent",
+			UID:                types.UID("6ee0d3b1-7d9c-4b41-9bd0-d8b8c1d6d0a1"),
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+
+	tests := map[string]struct {
+		expected client.Object
+		actual   client.Object
+		hasDiff  bool
+	}{
+		"owned by parent": {
+			expected: ExpectOwnedBy(child, parent, scheme),
+			actual:   owned,
+		},
+		"not owned": {
+			expected: ExpectOwnedBy(child, parent, scheme),
+			actual:   child,
+			hasDiff:  true,
+		},
+		"owned by other": {
+			expected: ExpectOwnedBy(child, &resources.TestResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace",
+					Name:      "other",
+				},
+			}, scheme),
+			actual:  owned,
+			hasDiff: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := WithLooseOwnerReferences(DefaultDiffer)
+			for method, diff := range map[string]string{
+				"Resource":       d.Resource(tc.expected, tc.actual),
+				"ResourceCreate": d.ResourceCreate(tc.expected, tc.actual),
+				"ResourceUpdate": d.ResourceUpdate(tc.expected, tc.actual),
+				"StashedValue":   d.StashedValue(tc.expected, tc.actual, "key"),
+			} {
+				if actual, expected := diff != "", tc.hasDiff; actual != expected {
+					t.Errorf("%s: unexpected diff: %s", method, diff)
+				}
+			}
+			if diff := DefaultDiffer.ResourceCreate(tc.expected, tc.actual); diff == "" {
+				t.Errorf("expected default differ to compare owner reference uids")
+			}
+		})
+	}
+}
+
+func TestDiffer_GeneratedName(t *testing.T) {
+	generated := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    "test-namespace",
+			GenerateName: "child-",
+		},
+	}
+	named := func(cm *corev1.ConfigMap, name string) *corev1.ConfigMap {
+		cm = cm.DeepCopy()
+		cm.Name = name
+		return cm
+	}
+
+	tests := map[string]struct {
+		expected client.Object
+		actual   client.Object
+		hasDiff  bool
+	}{
+		"generated name": {
+			expected: generated,
+			actual:   named(generated, "child-001"),
+		},
+		"not yet generated": {
+			expected: generated,
+			actual:   generated,
+		},
+		"different prefix": {
+			expected: generated,
+			actual: named(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    "test-namespace",
+					GenerateName: "other-",
+				},
+			}, "other-001"),
+			hasDiff: true,
+		},
+		"name without matching prefix": {
+			expected: generated,
+			actual:   named(generated, "not-child-001"),
+			hasDiff:  true,
+		},
+		"expected name": {
+			expected: named(generated, "child-001"),
+			actual:   named(generated, "child-002"),
+			hasDiff:  true,
+		},
+		"nil expected": {
+			expected: nil,
+			actual:   named(generated, "child-001"),
+			hasDiff:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := DefaultDiffer
+			for method, diff := range map[string]string{
+				"Resource":       d.Resource(tc.expected, tc.actual),
+				"ResourceCreate": d.ResourceCreate(tc.expected, tc.actual),
+				"ResourceUpdate": d.ResourceUpdate(tc.expected, tc.actual),
+				"StashedValue":   d.StashedValue(tc.expected, tc.actual, "key"),
+			} {
+				if actual, expected := diff != "", tc.hasDiff; actual != expected {
+					t.Errorf("%s: unexpected diff: %s", method, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffer_GeneratedNameCollisions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	expected := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    "test-namespace",
+			GenerateName: "child-",
+		},
+	}
+
+	c := &ExpectConfig{
+		Scheme: scheme,
+		ExpectCreates: []client.Object{
+			expected,
+			expected,
+		},
+	}
+	config := c.Config()
+
+	names := []string{}
+	for i := 0; i < 2; i++ {
+		actual := expected.DeepCopy()
+		if err := config.Create(context.TODO(), actual); err != nil {
+			t.Fatalf("unexpected create error: %v", err)
+		}
+		if diff := DefaultDiffer.StashedValue(expected, actual, "child"); diff != "" {
+			t.Errorf("unexpected diff for %q: %s", actual.Name, diff)
+		}
+		names = append(names, actual.Name)
+	}
+	if names[0] == names[1] {
+		t.Errorf("expected generated names to be unique, got %v", names)
+	}
+	if diff := cmp.Diff([]string{"child-001", "child-002"}, names); diff != "" {
+		t.Errorf("unexpected generated names (-expected, +actual): %s", diff)
+	}
+
+	c.AssertExpectations(t)
+}
+
+func TestNewDiffer_EquateApproxTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	pod := func(startTime time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-namespace",
+				Name:      "test-pod",
+			},
+			Status: corev1.PodStatus{
+				StartTime: ptr.To(metav1.NewTime(startTime)),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		actual  *corev1.Pod
+		hasDiff bool
+	}{
+		"same time": {
+			actual: pod(now),
+		},
+		"time within margin": {
+			actual: pod(now.Add(500 * time.Millisecond)),
+		},
+		"time beyond margin": {
+			actual:  pod(now.Add(2 * time.Second)),
+			hasDiff: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			expected := pod(now)
+			d := NewDiffer(EquateApproxTime(time.Second))
+			for method, diff := range map[string]string{
+				"Resource":             d.Resource(expected, tc.actual),
+				"ResourceCreate":       d.ResourceCreate(expected, tc.actual),
+				"ResourceUpdate":       d.ResourceUpdate(expected, tc.actual),
+				"ResourceStatusUpdate": d.ResourceStatusUpdate(expected, tc.actual),
+				"StashedValue":         d.StashedValue(expected, tc.actual, "key"),
+			} {
+				if actual, expected := diff != "", tc.hasDiff; actual != expected {
+					t.Errorf("%s: unexpected diff: %s", method, diff)
+				}
+			}
+			if !expected.Status.StartTime.Equal(tc.actual.Status.StartTime) {
+				if diff := DefaultDiffer.ResourceUpdate(expected, tc.actual); diff == "" {
+					t.Errorf("expected default differ to compare times exactly")
+				}
+			}
+		})
+	}
+}
+
+func TestExtendDiffer(t *testing.T) {
+	extended := &staticDiffer{diff: "static"}
+	if d := ExtendDiffer(extended); d != extended {
+		t.Errorf("expected an ExtendedDiffer to be returned as is")
+	}
+
+	// a Differ that only implements the Differ interface
+	basic := struct{ Differ }{Differ: &staticDiffer{diff: "static"}}
+	d := ExtendDiffer(basic)
+	if diff := d.Result(reconcilers.Result{}, reconcilers.Result{}); diff != "static" {
+		t.Errorf("expected Result to be delegated to the Differ, got %q", diff)
+	}
+	if diff := d.ListRef(ListRef{}, ListRef{}); diff != "" {
+		t.Errorf("expected ListRef to use the DefaultDiffer, got %q", diff)
+	}
+	if diff := d.FinalizersRef(FinalizersRef{}, FinalizersRef{Finalizers: []string{}}); diff != "" {
+		t.Errorf("expected FinalizersRef to use the DefaultDiffer, got %q", diff)
+	}
+	if diff := d.ActionRef(ActionRef{Verb: "create"}, ActionRef{Verb: "update"}); diff == "" {
+		t.Errorf("expected ActionRef to use the DefaultDiffer and find a difference")
+	}
+	if diff := d.ResourceMetadata(ResourceMetadata{}, ResourceMetadata{}); diff != "" {
+		t.Errorf("expected ResourceMetadata to use the DefaultDiffer, got %q", diff)
+	}
+	if diff := d.StatusConditions(nil, []metav1.Condition{}); diff != "" {
+		t.Errorf("expected StatusConditions to use the DefaultDiffer, got %q", diff)
+	}
+	if diff := d.DiscoveryRequest(DiscoveryRequest{}, DiscoveryRequest{}); diff != "" {
+		t.Errorf("expected DiscoveryRequest to use the DefaultDiffer, got %q", diff)
+	}
+
+	// wrapped differs preserve the extended comparisons of the wrapped Differ
+	if diff := ExtendDiffer(WithLooseOwnerReferences(extended)).ListRef(ListRef{}, ListRef{}); diff != "static" {
+		t.Errorf("expected ListRef to be delegated to the wrapped Differ, got %q", diff)
+	}
+	if diff := (&CompositeDiffer{Differ: extended}).ListRef(ListRef{}, ListRef{}); diff != "static" {
+		t.Errorf("expected ListRef to be delegated to the composed Differ, got %q", diff)
+	}
+}
+
+func TestCompositeDiffer(t *testing.T) {
+	typed := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "test-resource",
+		},
+		Data: map[string]string{
+			"foo": "bar",
+		},
+	}
+	typedChanged := typed.DeepCopy()
+	typedChanged.Data["foo"] = "baz"
+
+	toUnstructured := func(obj client.Object) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		u := &unstructured.Unstructured{Object: content}
+		u.SetAPIVersion("v1")
+		u.SetKind("ConfigMap")
+		return u
+	}
+	u := toUnstructured(typed)
+	uChanged := toUnstructured(typedChanged)
+	uVersioned := u.DeepCopy()
+	uVersioned.SetResourceVersion("1000")
+
+	d := &CompositeDiffer{
+		ResourceStrategies: []ResourceDiffStrategy{
+			UnstructuredYAMLDiff,
+		},
+		ResourceUpdateStrategies: []ResourceDiffStrategy{
+			UnstructuredYAMLDiff,
+		},
+	}
+
+	t.Run("typed resources fall back to the differ", func(t *testing.T) {
+		if expected, actual := DefaultDiffer.Resource(typed, typedChanged), d.Resource(typed, typedChanged); expected != actual {
+			t.Errorf("unexpected diff, expected %q, actual %q", expected, actual)
+		}
+		if diff := d.Resource(typed, typed.DeepCopy()); diff != "" {
+			t.Errorf("unexpected diff: %s", diff)
+		}
+	})
+
+	t.Run("unstructured resources are rendered as yaml", func(t *testing.T) {
+		diff := d.Resource(u, uChanged)
+		expected := cmp.Diff(
+			[]string{"apiVersion: v1", "data:", "  foo: bar", "kind: ConfigMap", "metadata:", "  name: test-resource", "  namespace: test-namespace"},
+			[]string{"apiVersion: v1", "data:", "  foo: baz", "kind: ConfigMap", "metadata:", "  name: test-resource", "  namespace: test-namespace"},
+		)
+		if diff != expected {
+			t.Errorf("unexpected diff, expected %q, actual %q", expected, diff)
+		}
+	})
+
+	t.Run("equivalence is decided by the differ", func(t *testing.T) {
+		if diff := d.ResourceUpdate(u, uVersioned); diff != "" {
+			t.Errorf("unexpected diff: %s", diff)
+		}
+		// the resource version is not rendered by the yaml strategy
+		if expected, actual := DefaultDiffer.Resource(u, uVersioned), d.Resource(u, uVersioned); expected == "" || expected != actual {
+			t.Errorf("unexpected diff, expected %q, actual %q", expected, actual)
+		}
+	})
+
+	t.Run("unstructured resources are not mutated", func(t *testing.T) {
+		expected := uVersioned.DeepCopy()
+		expected.SetCreationTimestamp(metav1.NewTime(time.Now().Truncate(time.Second)))
+		expected.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "test"}})
+		if err := unstructured.SetNestedSlice(expected.Object, []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": "2026-10-15T10:00:00Z"},
+		}, "status", "conditions"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actual := expected.DeepCopy()
+		originalExpected, originalActual := expected.DeepCopy(), actual.DeepCopy()
+
+		UnstructuredYAMLDiff(expected, actual)
+
+		if diff := cmp.Diff(originalExpected, expected); diff != "" {
+			t.Errorf("unexpected mutation of expected (-expected, +actual): %s", diff)
+		}
+		if diff := cmp.Diff(originalActual, actual); diff != "" {
+			t.Errorf("unexpected mutation of actual (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("methods without strategies fall back to the differ", func(t *testing.T) {
+		if expected, actual := DefaultDiffer.ResourceCreate(u, uChanged), d.ResourceCreate(u, uChanged); expected != actual {
+			t.Errorf("unexpected diff, expected %q, actual %q", expected, actual)
+		}
+	})
+
+	t.Run("other comparisons are delegated", func(t *testing.T) {
+		d := &CompositeDiffer{
+			Differ: &staticDiffer{diff: "always different"},
+		}
+		if diff := d.Result(reconcilers.Result{}, reconcilers.Result{}); diff != "always different" {
+			t.Errorf("unexpected diff: %s", diff)
+		}
+		if diff := d.Event(Event{}, Event{}); diff != "always different" {
+			t.Errorf("unexpected diff: %s", diff)
+		}
+	})
+}
+
+func TestDiffer_StatusConditions(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	ready := metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: now}
+	deployed := metav1.Condition{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed", LastTransitionTime: now}
+
+	tests := map[string]struct {
+		expected []metav1.Condition
+		actual   []metav1.Condition
+		differs  bool
+	}{
+		"equal": {
+			expected: []metav1.Condition{deployed, ready},
+			actual:   []metav1.Condition{deployed, ready},
+		},
+		"reordered": {
+			expected: []metav1.Condition{ready, deployed},
+			actual:   []metav1.Condition{deployed, ready},
+		},
+		"ignores last transition time": {
+			expected: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+			},
+			actual: []metav1.Condition{ready},
+		},
+		"empty and nil": {
+			expected: []metav1.Condition{},
+			actual:   nil,
+		},
+		"different status": {
+			expected: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Ready"},
+			},
+			actual:  []metav1.Condition{ready},
+			differs: true,
+		},
+		"missing condition": {
+			expected: []metav1.Condition{ready},
+			actual:   []metav1.Condition{deployed, ready},
+			differs:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := ExtendDiffer(DefaultDiffer).StatusConditions(tc.expected, tc.actual)
+			if tc.differs != (diff != "") {
+				t.Errorf("expected differs %t, got diff: %s", tc.differs, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+)
+
+// DiscoveryRequest records a call to the discovery client.
+type DiscoveryRequest struct {
+	// Method is the name of the discovery client method called, like "ServerVersion" or
+	// "ServerResourcesForGroupVersion"
+	Method string
+	// GroupVersion is the group version requested. Only set for ServerResourcesForGroupVersion.
+	GroupVersion string
+}
+
+// discoveryWrapper records each call to the fake discovery client
+type discoveryWrapper struct {
+	*fakediscovery.FakeDiscovery
+
+	m        sync.Mutex
+	requests []DiscoveryRequest
+}
+
+var _ discovery.DiscoveryInterface = (*discoveryWrapper)(nil)
+
+func (w *discoveryWrapper) record(request DiscoveryRequest) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.requests = append(w.requests, request)
+}
+
+func (w *discoveryWrapper) getRequests() []DiscoveryRequest {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return append([]DiscoveryRequest{}, w.requests...)
+}
+
+func (w *discoveryWrapper) ServerGroups() (*metav1.APIGroupList, error) {
+	w.record(DiscoveryRequest{Method: "ServerGroups"})
+	return w.FakeDiscovery.ServerGroups()
+}
+
+func (w *discoveryWrapper) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	w.record(DiscoveryRequest{Method: "ServerResourcesForGroupVersion", GroupVersion: groupVersion})
+	return w.FakeDiscovery.ServerResourcesForGroupVersion(groupVersion)
+}
+
+func (w *discoveryWrapper) ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error) {
+	w.record(DiscoveryRequest{Method: "ServerGroupsAndResources"})
+	return w.FakeDiscovery.ServerGroupsAndResources()
+}
+
+func (w *discoveryWrapper) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	w.record(DiscoveryRequest{Method: "ServerPreferredResources"})
+	return w.FakeDiscovery.ServerPreferredResources()
+}
+
+func (w *discoveryWrapper) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	w.record(DiscoveryRequest{Method: "ServerPreferredNamespacedResources"})
+	return w.FakeDiscovery.ServerPreferredNamespacedResources()
+}
+
+func (w *discoveryWrapper) ServerVersion() (*version.Info, error) {
+	w.record(DiscoveryRequest{Method: "ServerVersion"})
+	return w.FakeDiscovery.ServerVersion()
+}
+
+func (w *discoveryWrapper) WithLegacy() discovery.DiscoveryInterface {
+	return w
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+)
+
+// LogEntry is a message logged during reconciliation
+type LogEntry struct {
+	// Name of the logger, the names the logger was created with joined by a "/"
+	Name string
+	// Error is the error logged with logr.Logger#Error, nil for info entries
+	Error error
+	// Level is the verbosity of an info entry
+	Level int
+	// Message of the entry
+	Message string
+	// KeysAndValues holds the key value pairs of the entry, including the values the logger was
+	// created with
+	KeysAndValues map[string]interface{}
+}
+
+func (e LogEntry) String() string {
+	if e.Error != nil {
+		return fmt.Sprintf("error %q (%s) %v", e.Message, e.Error, e.KeysAndValues)
+	}
+	return fmt.Sprintf("info(%d) %q %v", e.Level, e.Message, e.KeysAndValues)
+}
+
+// LogMatcher loosely matches a logged entry. Empty fields match any value.
+type LogMatcher struct {
+	// Error when true matches entries logged with logr.Logger#Error, otherwise info entries are
+	// matched
+	Error bool
+	// Level is the verbosity of an info entry, like 1 for entries logged with log.V(1).Info.
+	// Ignored for error entries.
+	Level int
+	// MessagePattern is a regular expression matched against the entry's message. Unanchored
+	// patterns match a substring of the message.
+	MessagePattern string
+	// KeysAndValues each key must be logged with an equal value
+	KeysAndValues map[string]interface{}
+	// Absent when true asserts that no logged entry matches
+	Absent bool
+}
+
+// Matches returns true when the entry matches the kind, level, message pattern and keys and
+// values. An error is returned if the message pattern is not a valid regular expression.
+func (m LogMatcher) Matches(entry LogEntry) (bool, error) {
+	if m.Error != (entry.Error != nil) {
+		return false, nil
+	}
+	if !m.Error && m.Level != entry.Level {
+		return false, nil
+	}
+	for k, v := range m.KeysAndValues {
+		if actual, ok := entry.KeysAndValues[k]; !ok || !reflect.DeepEqual(v, actual) {
+			return false, nil
+		}
+	}
+	if m.MessagePattern == "" {
+		return true, nil
+	}
+	pattern, err := regexp.Compile(m.MessagePattern)
+	if err != nil {
+		return false, err
+	}
+	return pattern.MatchString(entry.Message), nil
+}
+
+// logRecorder captures the entries logged by each logger derived from its logger
+type logRecorder struct {
+	m       sync.Mutex
+	entries []LogEntry
+}
+
+// newLogRecorder returns a logger that records each entry, regardless of verbosity, before
+// logging the entry to the test.
+func newLogRecorder(t *testing.T) (logr.Logger, *logRecorder) {
+	r := &logRecorder{}
+	return logr.New(&recordingLogSink{
+		recorder: r,
+		delegate: testr.New(t).GetSink(),
+		helper:   t.Helper,
+	}), r
+}
+
+func (r *logRecorder) record(entry LogEntry) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.entries = append(r.entries, entry)
+}
+
+// AssertExpectations asserts each matcher against the recorded entries
+func (r *logRecorder) AssertExpectations(t *testing.T, matchers []LogMatcher) {
+	t.Helper()
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, exp := range matchers {
+		var matched []LogEntry
+		for _, entry := range r.entries {
+			ok, err := exp.Matches(entry)
+			if err != nil {
+				t.Errorf("ExpectLogs[%d] has an invalid MessagePattern: %s", i, err)
+				break
+			}
+			if ok {
+				matched = append(matched, entry)
+			}
+		}
+		if exp.Absent && len(matched) != 0 {
+			t.Errorf("ExpectLogs[%d] unexpected entry observed: %s", i, matched[0])
+		}
+		if !exp.Absent && len(matched) == 0 {
+			t.Errorf("ExpectLogs[%d] not observed: %+v", i, exp)
+		}
+	}
+}
+
+var (
+	_ logr.LogSink                = (*recordingLogSink)(nil)
+	_ logr.CallStackHelperLogSink = (*recordingLogSink)(nil)
+)
+
+type recordingLogSink struct {
+	recorder *logRecorder
+	delegate logr.LogSink
+	helper   func()
+	name     string
+	values   []interface{}
+}
+
+// GetCallStackHelper marks the logger as a test helper so the test log reports the location of
+// the caller
+func (s *recordingLogSink) GetCallStackHelper() func() {
+	return s.helper
+}
+
+func (s *recordingLogSink) Init(info logr.RuntimeInfo) {
+	s.delegate.Init(info)
+}
+
+// Enabled for every level, so that entries are recorded regardless of the delegate's verbosity
+func (s *recordingLogSink) Enabled(level int) bool {
+	return true
+}
+
+func (s *recordingLogSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.helper()
+	s.recorder.record(LogEntry{
+		Name:          s.name,
+		Level:         level,
+		Message:       msg,
+		KeysAndValues: s.keysAndValues(keysAndValues),
+	})
+	if s.delegate.Enabled(level) {
+		s.delegate.Info(level, msg, keysAndValues...)
+	}
+}
+
+func (s *recordingLogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.helper()
+	recordedErr := err
+	if recordedErr == nil {
+		// a nil error is recorded so the entry is recognized as an error
+		recordedErr = fmt.Errorf("<nil>")
+	}
+	s.recorder.record(LogEntry{
+		Name:          s.name,
+		Error:         recordedErr,
+		Message:       msg,
+		KeysAndValues: s.keysAndValues(keysAndValues),
+	})
+	s.delegate.Error(err, msg, keysAndValues...)
+}
+
+func (s *recordingLogSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	values := make([]interface{}, 0, len(s.values)+len(keysAndValues))
+	values = append(values, s.values...)
+	values = append(values, keysAndValues...)
+	return &recordingLogSink{
+		recorder: s.recorder,
+		delegate: s.delegate.WithValues(keysAndValues...),
+		helper:   s.helper,
+		name:     s.name,
+		values:   values,
+	}
+}
+
+func (s *recordingLogSink) WithName(name string) logr.LogSink {
+	names := []string{}
+	if s.name != "" {
+		names = append(names, s.name)
+	}
+	return &recordingLogSink{
+		recorder: s.recorder,
+		delegate: s.delegate.WithName(name),
+		helper:   s.helper,
+		name:     strings.Join(append(names, name), "/"),
+		values:   s.values,
+	}
+}
+
+// keysAndValues returns the values of the logger and the entry by key, later values replace
+// earlier values for the same key
+func (s *recordingLogSink) keysAndValues(keysAndValues []interface{}) map[string]interface{} {
+	kv := map[string]interface{}{}
+	all := append(append([]interface{}{}, s.values...), keysAndValues...)
+	for i := 0; i+1 < len(all); i += 2 {
+		kv[fmt.Sprint(all[i])] = all[i+1]
+	}
+	return kv
+}
@@ -18,19 +18,26 @@ package testing
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/go-logr/logr/testr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/stash"
 	rtime "reconciler.io/runtime/time"
 	"reconciler.io/runtime/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -53,8 +60,30 @@ type ReconcilerTestCase struct {
 	// WithReactors installs each ReactionFunc into each fake clientset. ReactionFuncs intercept
 	// each call to the clientset providing the ability to mutate the resource or inject an error.
 	WithReactors []ReactionFunc
+	// WithReactorsFor installs each ScopedReactor into each fake clientset. The ReactionFunc is
+	// only called for requests matching the reactor's verb and resource. Reactors from
+	// WithReactors are called before reactors from WithReactorsFor.
+	WithReactorsFor []ScopedReactor
+	// WithClientInterceptors wraps the client with each interceptor. Interceptors are called before
+	// requests are recorded and before reactors. See ExpectConfig.WithClientInterceptors for
+	// details.
+	WithClientInterceptors []interceptor.Funcs
+	// Defaulter simulates a defaulting admission webhook for given and created objects. See
+	// ExpectConfig.Defaulter for details.
+	Defaulter func(obj client.Object)
+	// GivenFieldIndexes registers each index with the fake client, enabling the reconciler to list
+	// resources with a field selector for an indexed field.
+	//
+	// +optional
+	GivenFieldIndexes []FieldIndex
 	// WithClientBuilder allows a test to modify the fake client initialization.
 	WithClientBuilder func(*fake.ClientBuilder) *fake.ClientBuilder
+	// NameGenerator returns the name for a created object that defines a generateName without a
+	// name. See ExpectConfig.NameGenerator for details.
+	NameGenerator func(obj client.Object) string
+	// UIDGenerator returns the UID for a created object that does not define a UID. See
+	// ExpectConfig.UIDGenerator for details.
+	UIDGenerator func(obj client.Object) types.UID
 	// StatusSubResourceTypes is a set of object types that support the status sub-resource. For
 	// these types, the only way to modify the resource's status is update or patch the status
 	// sub-resource. Patching or updating the main resource will not mutated the status field.
@@ -64,12 +93,31 @@ type ReconcilerTestCase struct {
 	// Interacting with a status sub-resource for a type not enumerated as having a status
 	// sub-resource will return a not found error.
 	StatusSubResourceTypes []client.Object
+	// NoStatusSubResourceTypes is a set of object types that do not support the status
+	// sub-resource. The status of these types is modified with the main resource. See
+	// ExpectConfig.NoStatusSubResourceTypes.
+	//
+	// +optional
+	NoStatusSubResourceTypes []client.Object
 	// GivenObjects build the kubernetes objects which are present at the onset of reconciliation
 	GivenObjects []client.Object
 	// APIGivenObjects contains objects that are only available via an API reader instead of the normal cache
 	APIGivenObjects []client.Object
+	// ShareGivenObjects skips copying given objects that are ready to be loaded into the fake
+	// client as is. Given objects must not be mutated while the test case runs. See
+	// ExpectConfig.ShareGivenObjects for details.
+	ShareGivenObjects bool
 	// GivenAPIResources populates the fake discovery client and RESTMapper
 	GivenAPIResources []*metav1.APIResourceList
+	// ServerVersion is the version reported by the fake discovery client. Defaults to an empty
+	// version.
+	ServerVersion *version.Info
+	// WithDiscoveryReactors installs each ReactionFunc into the fake discovery client. Discovery
+	// requests are made with the "get" verb for the "version", "group" and "resource" resources,
+	// a discovery failure is induced with:
+	//
+	//	rtesting.InduceFailure("get", "version")
+	WithDiscoveryReactors []ReactionFunc
 	// GivenTracks provide a set of tracked resources to seed the tracker with
 	GivenTracks []TrackRequest
 
@@ -79,6 +127,18 @@ type ReconcilerTestCase struct {
 	ExpectTracks []TrackRequest
 	// ExpectEvents holds the ordered list of events recorded during the reconciliation
 	ExpectEvents []Event
+	// ExpectEventsMatch holds the ordered list of matchers for events recorded during the
+	// reconciliation, see ExpectConfig.ExpectEventsMatch
+	ExpectEventsMatch []EventMatcher
+	// ExpectEventsSource restricts the asserted events to those recorded by the named reconciler,
+	// see ExpectConfig.ExpectEventsSource
+	ExpectEventsSource string
+	// ExpectLogs holds matchers for the entries logged during reconciliation. Each matcher must
+	// match at least one logged entry, or none when Absent. Entries are logged at any verbosity
+	// and are not required to be matched.
+	//
+	// +optional
+	ExpectLogs []LogMatcher
 	// ExpectApplies builds the ordered list of objects expected to be applied during reconciliation
 	ExpectApplies []ApplyRef
 	// ExpectCreates builds the ordered list of objects expected to be created during reconciliation
@@ -87,16 +147,86 @@ type ReconcilerTestCase struct {
 	ExpectUpdates []client.Object
 	// ExpectPatches builds the ordered list of objects expected to be patched during reconciliation
 	ExpectPatches []PatchRef
+	// ExpectPatchResults builds the ordered list of objects expected to result from applying each
+	// patch during reconciliation to the stored resource. When ExpectPatches is not defined, the
+	// raw patches are not asserted.
+	ExpectPatchResults []client.Object
 	// ExpectDeletes holds the ordered list of objects expected to be deleted during reconciliation
 	ExpectDeletes []DeleteRef
 	// ExpectDeleteCollections holds the ordered list of collections expected to be deleted during reconciliation
 	ExpectDeleteCollections []DeleteCollectionRef
+	// ExpectLists holds the ordered list of list requests expected to be made with the client,
+	// see ExpectConfig.ExpectLists
+	//
+	// +optional
+	ExpectLists []ListRef
 	// ExpectStatusUpdates builds the ordered list of objects whose status is updated during reconciliation
 	ExpectStatusUpdates []client.Object
 	// ExpectStatusPatches builds the ordered list of objects whose status is patched during reconciliation
 	ExpectStatusPatches []PatchRef
+	// ExpectStatusPatchResults builds the ordered list of objects expected to result from applying
+	// each status patch during reconciliation to the stored resource. When ExpectStatusPatches is
+	// not defined, the raw status patches are not asserted.
+	ExpectStatusPatchResults []client.Object
+	// ExpectScaleUpdates builds the ordered list of scale sub-resources updated during
+	// reconciliation. The scale sub-resource is modeled for built-in types (e.g. Deployment,
+	// StatefulSet, etc).
+	ExpectScaleUpdates []client.Object
+	// ExpectScalePatches builds the ordered list of objects whose scale sub-resource is patched
+	// during reconciliation
+	ExpectScalePatches []PatchRef
+	// ExpectSubResourceCreates builds the ordered list of objects created for a sub-resource other
+	// than status and scale during reconciliation, like a Pod's eviction
+	ExpectSubResourceCreates []SubResourceRef
+	// ExpectSubResourceUpdates builds the ordered list of objects updated for a sub-resource other
+	// than status and scale during reconciliation
+	ExpectSubResourceUpdates []SubResourceRef
+	// ExpectSubResourcePatches builds the ordered list of objects whose sub-resource, other than
+	// status and scale, is patched during reconciliation
+	ExpectSubResourcePatches []PatchRef
 	// ExpectStatusApplies builds the ordered list of objects whose status is applied during reconciliation
 	ExpectStatusApplies []ApplyRef
+	// ExpectResourceMetadata is the expected labels, annotations and finalizers of the reconciled
+	// resource as persisted by the updates and patches made during reconciliation. The reconciled
+	// resource is the given object identified by the Request. The metadata is not asserted when
+	// nil.
+	//
+	// +optional
+	ExpectResourceMetadata *ResourceMetadata
+	// ExpectFinalizers holds the finalizers expected on objects after reconciliation, see
+	// ExpectConfig.ExpectFinalizers
+	ExpectFinalizers []FinalizersRef
+	// ExpectActions holds the ordered timeline of mutating requests expected across all verbs,
+	// see ExpectConfig.ExpectActions
+	//
+	// +optional
+	ExpectActions []ActionRef
+	// ExpectDiscoveryRequests holds the ordered list of calls expected to the discovery client,
+	// see ExpectConfig.ExpectDiscoveryRequests
+	//
+	// +optional
+	ExpectDiscoveryRequests []DiscoveryRequest
+	// ExpectResources holds objects expected to be stored by the fake client after
+	// reconciliation, see ExpectConfig.ExpectResources
+	//
+	// +optional
+	ExpectResources []client.Object
+	// ExpectNoAPIReaderAccess asserts that no objects are read with the APIReader, see
+	// ExpectConfig.ExpectNoAPIReaderAccess
+	ExpectNoAPIReaderAccess bool
+	// ExpectAPIReaderReads is the exact number of reads expected against the APIReader, see
+	// ExpectConfig.ExpectAPIReaderReads
+	ExpectAPIReaderReads *int
+	// RestrictToNamespace asserts that each request made with the client or APIReader targets
+	// this namespace, see ExpectConfig.RestrictToNamespace
+	//
+	// +optional
+	RestrictToNamespace string
+	// ExpectReactorCalls is the exact number of times each named reactor from WithReactorsFor is
+	// expected to be called, see ExpectConfig.ExpectReactorCalls
+	//
+	// +optional
+	ExpectReactorCalls map[string]int
 
 	// AdditionalConfigs holds ExceptConfigs that are available to the test case and will have
 	// their expectations checked again the observed config interactions. The key in this map is
@@ -107,10 +237,23 @@ type ReconcilerTestCase struct {
 
 	// ShouldErr is true if and only if reconciliation is expected to return an error
 	ShouldErr bool
+	// ShouldRecoverPanic is true if and only if reconciliation is expected to return a
+	// reconcilers.PanicError, as returned by a RecoverReconciler that recovered from a panic in the
+	// reconciler it wraps. ShouldErr is implied.
+	ShouldRecoverPanic bool
 	// ExpectedResult is compared to the result returned from the reconciler if there was no error
 	ExpectedResult reconcilers.Result
 	// Verify provides the reconciliation Result and error for custom assertions
 	Verify VerifyFunc
+	// Repeat is the number of times the reconciler is run for the request, defaults to 1. Each run
+	// observes the state left in the fake client by the prior run. The expectations are asserted
+	// against the first run. A reconciler may need several runs to reach the reconciled state, like
+	// adding a finalizer before creating a child, so the reconciler is run again until a run makes
+	// no mutating request with any config, at most 10 more times. Every following run, up to
+	// Repeat runs in total, is expected to be idempotent and must not make any mutating request.
+	//
+	// +optional
+	Repeat int
 
 	// lifecycle
 
@@ -127,11 +270,29 @@ type ReconcilerTestCase struct {
 	Now time.Time
 	// Differ methods to use to compare expected and actual values. An empty string is returned for equivalent items.
 	Differ Differ
+	// DisableColorDiff prints diffs in assertion messages without color, regardless of the
+	// terminal and environment. See the package level DisableColorDiff.
+	DisableColorDiff bool
+	// ColorDiff prints diffs in assertion messages with color, regardless of the terminal.
+	// DisableColorDiff takes precedence.
+	ColorDiff bool
 }
 
 // VerifyFunc is a verification function for a reconciler's result
 type VerifyFunc func(t *testing.T, result reconcilers.Result, err error)
 
+// VerifyReadOnlyViolation returns a VerifyFunc asserting the reconciler failed because a mutating
+// request was made with a read-only client, see reconcilers.ReadOnly. The test case must also set
+// ShouldErr.
+func VerifyReadOnlyViolation() VerifyFunc {
+	return func(t *testing.T, result reconcilers.Result, err error) {
+		t.Helper()
+		if !errors.Is(err, reconcilers.ErrReadOnly) {
+			t.Errorf("expected a read-only violation, got error %v", err)
+		}
+	}
+}
+
 // VerifyStashedValueFunc is a verification function for the entries in the stash
 type VerifyStashedValueFunc func(t *testing.T, key stash.Key, expected, actual interface{})
 
@@ -165,7 +326,9 @@ func (tc *ReconcilerTestCase) Run(t *testing.T, scheme *runtime.Scheme, factory
 		tc.Now = time.Now()
 	}
 	ctx = rtime.StashNow(ctx, tc.Now)
-	ctx = logr.NewContext(ctx, testr.New(t))
+	ctx = apis.WithConditionReasonValidation(ctx)
+	log, logs := newLogRecorder(t)
+	ctx = logr.NewContext(ctx, log)
 	if deadline, ok := t.Deadline(); ok {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithDeadline(ctx, deadline)
@@ -194,32 +357,67 @@ func (tc *ReconcilerTestCase) Run(t *testing.T, scheme *runtime.Scheme, factory
 	}
 
 	expectConfig := &ExpectConfig{
-		Name:                    "default",
-		Scheme:                  scheme,
-		StatusSubResourceTypes:  tc.StatusSubResourceTypes,
-		Differ:                  tc.Differ,
-		GivenObjects:            tc.GivenObjects,
-		APIGivenObjects:         tc.APIGivenObjects,
-		WithClientBuilder:       tc.WithClientBuilder,
-		WithReactors:            tc.WithReactors,
-		GivenAPIResources:       tc.GivenAPIResources,
-		GivenTracks:             tc.GivenTracks,
-		ExpectTracks:            tc.ExpectTracks,
-		ExpectEvents:            tc.ExpectEvents,
-		ExpectApplies:           tc.ExpectApplies,
-		ExpectCreates:           tc.ExpectCreates,
-		ExpectUpdates:           tc.ExpectUpdates,
-		ExpectPatches:           tc.ExpectPatches,
-		ExpectDeletes:           tc.ExpectDeletes,
-		ExpectDeleteCollections: tc.ExpectDeleteCollections,
-		ExpectStatusUpdates:     tc.ExpectStatusUpdates,
-		ExpectStatusPatches:     tc.ExpectStatusPatches,
-		ExpectStatusApplies:     tc.ExpectStatusApplies,
+		Name:                     "default",
+		Scheme:                   scheme,
+		StatusSubResourceTypes:   tc.StatusSubResourceTypes,
+		NoStatusSubResourceTypes: tc.NoStatusSubResourceTypes,
+		Differ:                   tc.Differ,
+		DisableColorDiff:         tc.DisableColorDiff,
+		ColorDiff:                tc.ColorDiff,
+		GivenObjects:             tc.GivenObjects,
+		APIGivenObjects:          tc.APIGivenObjects,
+		ShareGivenObjects:        tc.ShareGivenObjects,
+		Defaulter:                tc.Defaulter,
+		GivenFieldIndexes:        tc.GivenFieldIndexes,
+		WithClientBuilder:        tc.WithClientBuilder,
+		NameGenerator:            tc.NameGenerator,
+		UIDGenerator:             tc.UIDGenerator,
+		WithReactors:             tc.WithReactors,
+		WithReactorsFor:          tc.WithReactorsFor,
+		WithClientInterceptors:   tc.WithClientInterceptors,
+		GivenAPIResources:        tc.GivenAPIResources,
+		ServerVersion:            tc.ServerVersion,
+		WithDiscoveryReactors:    tc.WithDiscoveryReactors,
+		GivenTracks:              tc.GivenTracks,
+		ExpectTracks:             tc.ExpectTracks,
+		ExpectEvents:             tc.ExpectEvents,
+		ExpectEventsMatch:        tc.ExpectEventsMatch,
+		ExpectEventsSource:       tc.ExpectEventsSource,
+		ExpectApplies:            tc.ExpectApplies,
+		ExpectCreates:            tc.ExpectCreates,
+		ExpectUpdates:            tc.ExpectUpdates,
+		ExpectPatches:            tc.ExpectPatches,
+		ExpectPatchResults:       tc.ExpectPatchResults,
+		ExpectDeletes:            tc.ExpectDeletes,
+		ExpectDeleteCollections:  tc.ExpectDeleteCollections,
+		ExpectLists:              tc.ExpectLists,
+		ExpectStatusUpdates:      tc.ExpectStatusUpdates,
+		ExpectStatusPatches:      tc.ExpectStatusPatches,
+		ExpectStatusPatchResults: tc.ExpectStatusPatchResults,
+		ExpectScaleUpdates:       tc.ExpectScaleUpdates,
+		ExpectScalePatches:       tc.ExpectScalePatches,
+		ExpectSubResourceCreates: tc.ExpectSubResourceCreates,
+		ExpectSubResourceUpdates: tc.ExpectSubResourceUpdates,
+		ExpectSubResourcePatches: tc.ExpectSubResourcePatches,
+		ExpectStatusApplies:      tc.ExpectStatusApplies,
+		ExpectFinalizers:         tc.ExpectFinalizers,
+		ExpectActions:            tc.ExpectActions,
+		ExpectDiscoveryRequests:  tc.ExpectDiscoveryRequests,
+		ExpectResources:          tc.ExpectResources,
+		ExpectNoAPIReaderAccess:  tc.ExpectNoAPIReaderAccess,
+		RestrictToNamespace:      tc.RestrictToNamespace,
+		ExpectReactorCalls:       tc.ExpectReactorCalls,
+		ExpectAPIReaderReads:     tc.ExpectAPIReaderReads,
 	}
 
+	// retain each additional config so the observed interactions are asserted
+	additionalConfigs := make(map[string]*ExpectConfig, len(tc.AdditionalConfigs))
 	configs := make(map[string]reconcilers.Config, len(tc.AdditionalConfigs))
 	for k, v := range tc.AdditionalConfigs {
 		v.Name = k
+		v.DisableColorDiff = v.DisableColorDiff || tc.DisableColorDiff
+		v.ColorDiff = v.ColorDiff || tc.ColorDiff
+		additionalConfigs[k] = &v
 		configs[k] = v.Config()
 	}
 	ctx = reconcilers.StashAdditionalConfigs(ctx, configs)
@@ -235,13 +433,16 @@ func (tc *ReconcilerTestCase) Run(t *testing.T, scheme *runtime.Scheme, factory
 	// Run the Reconcile we're testing.
 	result, err := r.Reconcile(ctx, tc.Request)
 
-	if (err != nil) != tc.ShouldErr {
-		t.Errorf("Reconcile() error = %v, ShouldErr %v", err, tc.ShouldErr)
+	if (err != nil) != (tc.ShouldErr || tc.ShouldRecoverPanic) {
+		t.Errorf("Reconcile() error = %v, ShouldErr %v", err, tc.ShouldErr || tc.ShouldRecoverPanic)
+	}
+	if panicErr := (*reconcilers.PanicError)(nil); errors.As(err, &panicErr) != tc.ShouldRecoverPanic {
+		t.Errorf("Reconcile() error = %v, ShouldRecoverPanic %v", err, tc.ShouldRecoverPanic)
 	}
 	if err == nil {
 		// result is only significant if there wasn't an error
 		if diff := tc.Differ.Result(normalizeResult(tc.ExpectedResult), normalizeResult(result)); diff != "" {
-			t.Errorf("ExpectedResult differs (%s, %s): %s", DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			t.Errorf("ExpectedResult differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
 		}
 	}
 
@@ -249,10 +450,104 @@ func (tc *ReconcilerTestCase) Run(t *testing.T, scheme *runtime.Scheme, factory
 		tc.Verify(t, result, err)
 	}
 
+	// compare persisted resource metadata
+	if tc.ExpectResourceMetadata != nil {
+		if actual, err := tc.observedResourceMetadata(expectConfig); err != nil {
+			t.Errorf("ExpectResourceMetadata unable to get the reconciled resource: %s", err)
+		} else if diff := ExtendDiffer(tc.Differ).ResourceMetadata(*tc.ExpectResourceMetadata, actual); diff != "" {
+			t.Errorf("ExpectResourceMetadata differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
+		}
+	}
+
 	expectConfig.AssertExpectations(t)
-	for _, config := range tc.AdditionalConfigs {
+	for _, config := range additionalConfigs {
 		config.AssertExpectations(t)
 	}
+	logs.AssertExpectations(t, tc.ExpectLogs)
+
+	// rerun the reconciler against the reconciled state
+	if tc.Repeat > 1 {
+		configs := []*ExpectConfig{expectConfig}
+		for _, name := range slices.Sorted(maps.Keys(additionalConfigs)) {
+			configs = append(configs, additionalConfigs[name])
+		}
+		repeatReconcile(tc.Repeat, configs, func() error {
+			if _, err := r.Reconcile(ctx, tc.Request); err != nil && !(tc.ShouldErr || tc.ShouldRecoverPanic) {
+				return err
+			}
+			return nil
+		}, t.Errorf)
+	}
+}
+
+// maxRepeatConvergenceRuns is the number of runs a Repeat test case may make after the first run to
+// reach the reconciled state.
+const maxRepeatConvergenceRuns = 10
+
+// repeatReconcile reruns the reconciler until a run makes no mutating request with any of the
+// configs, then asserts each of the remaining runs, up to repeat runs in total including the first
+// run, makes no mutating request.
+func repeatReconcile(repeat int, configs []*ExpectConfig, reconcile func() error, errorf func(format string, args ...interface{})) {
+	observe := func() []int {
+		observed := make([]int, len(configs))
+		for i, c := range configs {
+			observed[i] = len(c.client.MutatingActions)
+		}
+		return observed
+	}
+	mutated := func(observed []int) bool {
+		for i, c := range configs {
+			if len(c.client.MutatingActions) != observed[i] {
+				return true
+			}
+		}
+		return false
+	}
+
+	run := 1
+	for converged := false; !converged; {
+		if run > maxRepeatConvergenceRuns {
+			errorf("Repeat reconciler did not converge, each of the %d runs after the first made a mutating request", maxRepeatConvergenceRuns)
+			return
+		}
+		observed := observe()
+		if err := reconcile(); err != nil {
+			errorf("Repeat[%d] Reconcile() error = %v", run, err)
+		}
+		converged = !mutated(observed)
+		run++
+	}
+
+	for ; run < repeat; run++ {
+		observed := observe()
+		if err := reconcile(); err != nil {
+			errorf("Repeat[%d] Reconcile() error = %v", run, err)
+		}
+		for i, c := range configs {
+			for _, action := range c.client.MutatingActions[observed[i]:] {
+				errorf("Repeat[%d] unexpected mutation observed%s: %s", run, c.configNameMsg(), describeAction(action))
+			}
+		}
+	}
+}
+
+// observedResourceMetadata returns the persisted metadata of the given object identified by the
+// request
+func (tc *ReconcilerTestCase) observedResourceMetadata(c *ExpectConfig) (ResourceMetadata, error) {
+	var resource client.Object
+	for _, obj := range tc.GivenObjects {
+		if obj.GetNamespace() != tc.Request.Namespace || obj.GetName() != tc.Request.Name {
+			continue
+		}
+		if resource != nil {
+			return ResourceMetadata{}, fmt.Errorf("multiple given objects found for request %s", tc.Request.NamespacedName)
+		}
+		resource = obj.DeepCopyObject().(client.Object)
+	}
+	if resource == nil {
+		return ResourceMetadata{}, fmt.Errorf("no given object found for request %s", tc.Request.NamespacedName)
+	}
+	return c.observedResourceMetadata(resource)
 }
 
 func normalizeResult(result reconcilers.Result) reconcilers.Result {
@@ -18,12 +18,23 @@ package testing
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgotesting "k8s.io/client-go/testing"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
 	"reconciler.io/runtime/reconcilers"
 	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -73,3 +84,264 @@ func TestReconcilerTestCase_Now(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcilerTestCase_ExpectResourceMetadata(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-resource")
+			d.AddLabel("app", "my-app")
+		})
+
+	rtc := &ReconcilerTestCase{
+		Request: reconcilers.Request{
+			NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-resource"},
+		},
+		GivenObjects: []client.Object{
+			resource,
+		},
+		ExpectUpdates: []client.Object{
+			resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("example.com/hash", "abc123")
+					d.Finalizers("example.com/finalizer")
+				}),
+		},
+		ExpectResourceMetadata: &ResourceMetadata{
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+			Annotations: map[string]string{
+				"example.com/hash": "abc123",
+			},
+			Finalizers: []string{
+				"example.com/finalizer",
+			},
+		},
+	}
+	rtc.Run(t, scheme, func(t *testing.T, rtc *ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			actual := &resources.TestResource{}
+			if err := c.Get(ctx, req.NamespacedName, actual); err != nil {
+				return reconcile.Result{}, err
+			}
+			// mutations made in memory are not persisted
+			actual.Labels["ignored"] = "true"
+			update := actual.DeepCopy()
+			delete(update.Labels, "ignored")
+			update.Annotations = map[string]string{"example.com/hash": "abc123"}
+			update.Finalizers = []string{"example.com/finalizer"}
+			return reconcile.Result{}, c.Update(ctx, update)
+		})
+	})
+}
+
+func TestReconcilerTestCase_Repeat(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-resource")
+		})
+
+	runs := 0
+	rtc := &ReconcilerTestCase{
+		Request: reconcilers.Request{
+			NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-resource"},
+		},
+		GivenObjects: []client.Object{
+			resource,
+		},
+		ExpectUpdates: []client.Object{
+			resource.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation("example.com/hash", "abc123")
+				}),
+		},
+		Repeat: 3,
+		CleanUp: func(t *testing.T, ctx context.Context, tc *ReconcilerTestCase) error {
+			if expected, actual := 3, runs; expected != actual {
+				t.Errorf("expected reconciler to run %d times, ran %d times", expected, actual)
+			}
+			return nil
+		},
+	}
+	rtc.Run(t, scheme, func(t *testing.T, rtc *ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			runs++
+			actual := &resources.TestResource{}
+			if err := c.Get(ctx, req.NamespacedName, actual); err != nil {
+				return reconcile.Result{}, err
+			}
+			// only update when the resource is not already reconciled
+			if actual.Annotations["example.com/hash"] == "abc123" {
+				return reconcile.Result{}, nil
+			}
+			actual.Annotations = map[string]string{"example.com/hash": "abc123"}
+			return reconcile.Result{}, c.Update(ctx, actual)
+		})
+	})
+}
+
+func TestRepeatReconcile(t *testing.T) {
+	mutation := clientgotesting.NewCreateAction(schema.GroupVersionResource{Resource: "testresources"}, "my-namespace", &resources.TestResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-namespace", Name: "my-resource"},
+	})
+
+	tests := map[string]struct {
+		repeat int
+		// mutations made by each run, indexed by run and then by config
+		mutations    [][]int
+		err          error
+		expectRuns   int
+		expectErrors []string
+	}{
+		"converges immediately": {
+			repeat:     3,
+			expectRuns: 2,
+		},
+		"converges after several runs": {
+			repeat: 2,
+			mutations: [][]int{
+				{1, 0},
+				{0, 1},
+			},
+			expectRuns: 3,
+		},
+		"asserts remaining runs after converging": {
+			repeat: 5,
+			mutations: [][]int{
+				{1, 0},
+			},
+			expectRuns: 4,
+		},
+		"never converges": {
+			repeat: 2,
+			mutations: [][]int{
+				{1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0}, {1, 0},
+			},
+			expectRuns: 10,
+			expectErrors: []string{
+				"Repeat reconciler did not converge, each of the 10 runs after the first made a mutating request",
+			},
+		},
+		"mutation after converging": {
+			repeat: 4,
+			mutations: [][]int{
+				{0, 0},
+				{1, 0},
+			},
+			expectRuns: 3,
+			expectErrors: []string{
+				"Repeat[2] unexpected mutation observed: create testresources my-namespace/my-resource",
+			},
+		},
+		"mutation in additional config after converging": {
+			repeat: 3,
+			mutations: [][]int{
+				{0, 0},
+				{0, 1},
+			},
+			expectRuns: 2,
+			expectErrors: []string{
+				`Repeat[2] unexpected mutation observed for config "other": create testresources my-namespace/my-resource`,
+			},
+		},
+		"reconcile error": {
+			repeat:     2,
+			err:        fmt.Errorf("reconcile failed"),
+			expectRuns: 1,
+			expectErrors: []string{
+				"Repeat[1] Reconcile() error = reconcile failed",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			configs := []*ExpectConfig{
+				{client: &clientWrapper{}},
+				{Name: "other", client: &clientWrapper{}},
+			}
+			runs := 0
+			reconcile := func() error {
+				if runs < len(tc.mutations) {
+					for i, count := range tc.mutations[runs] {
+						for j := 0; j < count; j++ {
+							configs[i].client.MutatingActions = append(configs[i].client.MutatingActions, mutation)
+						}
+					}
+				}
+				runs++
+				return tc.err
+			}
+			errs := []string{}
+			errorf := func(format string, args ...interface{}) {
+				errs = append(errs, fmt.Sprintf(format, args...))
+			}
+
+			repeatReconcile(tc.repeat, configs, reconcile, errorf)
+
+			if expected, actual := tc.expectRuns, runs; expected != actual {
+				t.Errorf("expected reconciler to run %d times, ran %d times", expected, actual)
+			}
+			if diff := cmp.Diff(append([]string{}, tc.expectErrors...), errs); diff != "" {
+				t.Errorf("unexpected errors (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerTestCase_ExpectLogs(t *testing.T) {
+	tests := map[string]struct {
+		quiet      bool
+		expectLogs []LogMatcher
+	}{
+		"matches info entries": {
+			expectLogs: []LogMatcher{
+				{MessagePattern: "^reconciling$"},
+				{Level: 1, MessagePattern: "detail", KeysAndValues: map[string]interface{}{"resource": "my-resource", "count": 2}},
+			},
+		},
+		"matches error entries": {
+			expectLogs: []LogMatcher{
+				{Error: true, MessagePattern: "unable to sync", KeysAndValues: map[string]interface{}{"resource": "my-resource"}},
+			},
+		},
+		"asserts absent entries": {
+			quiet: true,
+			expectLogs: []LogMatcher{
+				{Error: true, Absent: true},
+				{Level: 2, Absent: true},
+				{MessagePattern: "detail", Absent: true},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rtc := &ReconcilerTestCase{
+				Request: reconcilers.Request{
+					NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-resource"},
+				},
+				ExpectLogs: tc.expectLogs,
+			}
+			rtc.Run(t, runtime.NewScheme(), func(t *testing.T, rtc *ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+				return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+					log := logr.FromContextOrDiscard(ctx).
+						WithName("test").
+						WithValues("resource", req.Name)
+					log.Info("reconciling")
+					log.V(1).Info("detail", "count", 2)
+					if !tc.quiet {
+						log.Error(fmt.Errorf("sync error"), "unable to sync")
+					}
+					return reconcile.Result{}, nil
+				})
+			})
+		})
+	}
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package testing
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/tools/record"
 	ref "k8s.io/client-go/tools/reference"
+	"reconciler.io/runtime/reconcilers"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -39,6 +45,12 @@ type Event struct {
 	Message string
 	Action  string
 	Note    string
+	// Source is the name of the logger in the context of the reconciler that recorded the event,
+	// the names of the reconciler and the reconcilers it is nested within joined by a "/". The
+	// source of a recorded event is only compared when the expected event defines a source.
+	//
+	// +optional
+	Source string
 }
 
 // Deprecated, prefer NewEventf
@@ -95,41 +107,164 @@ func NewEventf(regarding, related client.Object, scheme *runtime.Scheme, eventty
 	}
 }
 
+// EventMatcher loosely matches a recorded event. Unlike an Event, the message does not need to be
+// pinned exactly, which is useful when the message includes dynamic data. Empty fields match any
+// value.
+type EventMatcher struct {
+	// Type of the event, typically Normal or Warning
+	Type string
+	// Reason of the event
+	Reason string
+	// MessagePattern is a regular expression matched against the event's message, or the note for
+	// events recorded with Eventf. Unanchored patterns match a substring of the message, use
+	// regexp.QuoteMeta to match a literal substring containing special characters.
+	MessagePattern string
+	// Source is the name of a reconciler, matching events recorded by the reconciler or a
+	// reconciler nested within it, see Event.Source
+	Source string
+}
+
+// Matches returns true when the event matches the type, reason, source and message pattern. An
+// error is returned if the message pattern is not a valid regular expression.
+func (m EventMatcher) Matches(event Event) (bool, error) {
+	if m.Type != "" && m.Type != event.Type {
+		return false, nil
+	}
+	if m.Source != "" && !matchesSource(event, m.Source) {
+		return false, nil
+	}
+	if m.Reason != "" && m.Reason != event.Reason {
+		return false, nil
+	}
+	if m.MessagePattern == "" {
+		return true, nil
+	}
+	pattern, err := regexp.Compile(m.MessagePattern)
+	if err != nil {
+		return false, err
+	}
+	message := event.Message
+	if message == "" {
+		message = event.Note
+	}
+	return pattern.MatchString(message), nil
+}
+
 type deprecatedEventRecorder struct {
 	recorder *eventRecorder
+	source   string
 }
 
 var (
-	_ record.EventRecorder = (*deprecatedEventRecorder)(nil)
+	_ record.EventRecorder           = (*deprecatedEventRecorder)(nil)
+	_ reconcilers.ContextualRecorder = (*deprecatedEventRecorder)(nil)
 )
 
+func (r *deprecatedEventRecorder) ForContext(ctx context.Context) record.EventRecorder {
+	return &deprecatedEventRecorder{
+		recorder: r.recorder,
+		source:   eventSource(ctx),
+	}
+}
+
 func (r *deprecatedEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
 	r.Eventf(object, eventtype, reason, "%s", message)
 }
 
 func (r *deprecatedEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
-	r.recorder.events = append(r.recorder.events, NewEvent(object.(client.Object), r.recorder.scheme, eventtype, reason, messageFmt, args...))
+	event := NewEvent(object.(client.Object), r.recorder.scheme, eventtype, reason, messageFmt, args...)
+	event.Source = r.source
+	r.recorder.record(event)
 }
 
 func (r *deprecatedEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
 	r.Eventf(object, eventtype, reason, messageFmt, args...)
 }
 
+// eventRecorder captures events in the order they are recorded, including events recorded
+// concurrently.
 type eventRecorder struct {
+	m      sync.Mutex
 	events []Event
 	scheme *runtime.Scheme
 }
 
 var (
-	_ events.EventRecorder = (*eventRecorder)(nil)
+	_ events.EventRecorder                = (*eventRecorder)(nil)
+	_ reconcilers.ContextualEventRecorder = (*eventRecorder)(nil)
 )
 
+func (r *eventRecorder) record(event Event) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.events = append(r.events, event)
+}
+
+// recorded returns a copy of the recorded events
+func (r *eventRecorder) recorded() []Event {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return append([]Event{}, r.events...)
+}
+
+func (r *eventRecorder) ForContext(ctx context.Context) events.EventRecorder {
+	return &sourcedEventRecorder{
+		recorder: r,
+		source:   eventSource(ctx),
+	}
+}
+
 func (r *eventRecorder) Eventf(regarding runtime.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	r.eventf("", regarding, related, eventtype, reason, action, note, args...)
+}
+
+func (r *eventRecorder) eventf(source string, regarding runtime.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
 	var regardingObj, relatedObj client.Object
 	regardingObj = regarding.(client.Object)
 	if related != nil {
 		relatedObj = related.(client.Object)
 	}
 
-	r.events = append(r.events, NewEventf(regardingObj, relatedObj, r.scheme, eventtype, reason, action, note, args...))
+	event := NewEventf(regardingObj, relatedObj, r.scheme, eventtype, reason, action, note, args...)
+	event.Source = source
+	r.record(event)
+}
+
+// sourcedEventRecorder records events attributed to a source
+type sourcedEventRecorder struct {
+	recorder *eventRecorder
+	source   string
+}
+
+var (
+	_ events.EventRecorder                = (*sourcedEventRecorder)(nil)
+	_ reconcilers.ContextualEventRecorder = (*sourcedEventRecorder)(nil)
+)
+
+func (r *sourcedEventRecorder) ForContext(ctx context.Context) events.EventRecorder {
+	return r.recorder.ForContext(ctx)
+}
+
+func (r *sourcedEventRecorder) Eventf(regarding runtime.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	r.recorder.eventf(r.source, regarding, related, eventtype, reason, action, note, args...)
+}
+
+// eventSource returns the name of the test logger in the context, or an empty string for other
+// loggers.
+func eventSource(ctx context.Context) string {
+	if sink, ok := logr.FromContextOrDiscard(ctx).GetSink().(*recordingLogSink); ok {
+		return sink.name
+	}
+	return ""
+}
+
+// matchesSource returns true when the event was recorded by the named reconciler or a reconciler
+// nested within it.
+func matchesSource(event Event, name string) bool {
+	for _, n := range strings.Split(event.Source, "/") {
+		if n == name {
+			return true
+		}
+	}
+	return false
 }
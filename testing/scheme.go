@@ -0,0 +1,82 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatusSubResourceTypesFromScheme returns an empty object for each kind registered with the
+// scheme that has a Status field, for use as StatusSubResourceTypes. Only kinds for which the
+// predicate returns true are included, a nil predicate includes every kind. List types and
+// internal versions are never included. Objects are ordered by their group, version and kind, a
+// type registered for multiple kinds is included once.
+func StatusSubResourceTypesFromScheme(s *runtime.Scheme, predicate func(gvk schema.GroupVersionKind) bool) []client.Object {
+	gvks := []schema.GroupVersionKind{}
+	for gvk, t := range s.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal {
+			continue
+		}
+		if t.Kind() != reflect.Struct {
+			continue
+		}
+		if _, hasStatus := t.FieldByName("Status"); !hasStatus {
+			continue
+		}
+		if predicate != nil && !predicate(gvk) {
+			continue
+		}
+		gvks = append(gvks, gvk)
+	}
+	sort.Slice(gvks, func(i, j int) bool {
+		return gvks[i].String() < gvks[j].String()
+	})
+
+	objs := []client.Object{}
+	seen := map[reflect.Type]bool{}
+	for _, gvk := range gvks {
+		t := s.AllKnownTypes()[gvk]
+		if seen[t] {
+			// the same type registered for multiple kinds
+			continue
+		}
+		seen[t] = true
+		obj, ok := reflect.New(t).Interface().(client.Object)
+		if !ok || meta.IsListType(obj) {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+// AllCRDStatusTypes returns an empty object for each custom kind registered with the scheme that
+// has a Status field, for use as StatusSubResourceTypes. Built-in Kubernetes kinds are excluded,
+// their status sub-resource is already accounted for. Kinds whose CustomResourceDefinition does
+// not enable the status sub-resource can be listed in NoStatusSubResourceTypes.
+func AllCRDStatusTypes(s *runtime.Scheme) []client.Object {
+	return StatusSubResourceTypesFromScheme(s, func(gvk schema.GroupVersionKind) bool {
+		return !scheme.Scheme.Recognizes(gvk)
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"reconciler.io/runtime/internal/resources"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAllCRDStatusTypes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = resources.AddToScheme(scheme)
+
+	expected := []string{
+		"*resources.TestResource",
+		"*resources.TestResourceEmptyStatus",
+		"*resources.TestResourceNilableStatus",
+		"*resources.TestResourceUnexportedFields",
+		"*resources.TestResourceWithDefault",
+		"*resources.TestResourceWithLegacyDefault",
+		"*resources.TestResourceWithObjectDefault",
+	}
+	if diff := cmp.Diff(expected, typeNames(AllCRDStatusTypes(scheme))); diff != "" {
+		t.Errorf("unexpected types (-expected, +actual): %s", diff)
+	}
+}
+
+func TestStatusSubResourceTypesFromScheme(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = resources.AddToScheme(scheme)
+
+	t.Run("predicate", func(t *testing.T) {
+		objs := StatusSubResourceTypesFromScheme(scheme, func(gvk schema.GroupVersionKind) bool {
+			return gvk.Group == "" && (gvk.Kind == "Pod" || gvk.Kind == "ConfigMap" || gvk.Kind == "PodList")
+		})
+		// ConfigMap has no status and PodList is a list
+		expected := []string{"*v1.Pod"}
+		if diff := cmp.Diff(expected, typeNames(objs)); diff != "" {
+			t.Errorf("unexpected types (-expected, +actual): %s", diff)
+		}
+	})
+
+	t.Run("nil predicate", func(t *testing.T) {
+		names := typeNames(StatusSubResourceTypesFromScheme(scheme, nil))
+		for _, name := range []string{"*v1.Pod", "*resources.TestResource"} {
+			found := false
+			for _, n := range names {
+				found = found || n == name
+			}
+			if !found {
+				t.Errorf("expected %s to be included in %v", name, names)
+			}
+		}
+		for _, name := range []string{"*resources.TestResourceNoStatus", "*resources.TestResourceList", "*v1.ConfigMap"} {
+			for _, n := range names {
+				if n == name {
+					t.Errorf("expected %s to not be included", name)
+				}
+			}
+		}
+	})
+}
+
+func typeNames(objs []client.Object) []string {
+	names := []string{}
+	for _, obj := range objs {
+		names = append(names, fmt.Sprintf("%T", obj))
+	}
+	return names
+}
@@ -18,15 +18,18 @@ package testing
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/go-logr/logr/testr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/internal"
 	"reconciler.io/runtime/reconcilers"
@@ -35,6 +38,7 @@ import (
 	"reconciler.io/runtime/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // SubReconcilerTestCase holds a single testcase of a sub reconciler test.
@@ -55,11 +59,34 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	Resource Type
 	// GivenStashedValues adds these items to the stash passed into the reconciler. Factories are resolved to their object.
 	GivenStashedValues map[stash.Key]interface{}
+	// Defaulter simulates a defaulting admission webhook for given and created objects. The
+	// Resource is also defaulted before it is passed to the reconciler. See ExpectConfig.Defaulter
+	// for details.
+	Defaulter func(obj client.Object)
+	// GivenFieldIndexes registers each index with the fake client, enabling the reconciler to list
+	// resources with a field selector for an indexed field.
+	//
+	// +optional
+	GivenFieldIndexes []FieldIndex
 	// WithClientBuilder allows a test to modify the fake client initialization.
 	WithClientBuilder func(*fake.ClientBuilder) *fake.ClientBuilder
+	// NameGenerator returns the name for a created object that defines a generateName without a
+	// name. See ExpectConfig.NameGenerator for details.
+	NameGenerator func(obj client.Object) string
+	// UIDGenerator returns the UID for a created object that does not define a UID. See
+	// ExpectConfig.UIDGenerator for details.
+	UIDGenerator func(obj client.Object) types.UID
 	// WithReactors installs each ReactionFunc into each fake clientset. ReactionFuncs intercept
 	// each call to the clientset providing the ability to mutate the resource or inject an error.
 	WithReactors []ReactionFunc
+	// WithReactorsFor installs each ScopedReactor into each fake clientset. The ReactionFunc is
+	// only called for requests matching the reactor's verb and resource. Reactors from
+	// WithReactors are called before reactors from WithReactorsFor.
+	WithReactorsFor []ScopedReactor
+	// WithClientInterceptors wraps the client with each interceptor. Interceptors are called before
+	// requests are recorded and before reactors. See ExpectConfig.WithClientInterceptors for
+	// details.
+	WithClientInterceptors []interceptor.Funcs
 	// StatusSubResourceTypes is a set of object types that support the status sub-resource. For
 	// these types, the only way to modify the resource's status is update or patch the status
 	// sub-resource. Patching or updating the main resource will not mutated the status field.
@@ -69,19 +96,53 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	// Interacting with a status sub-resource for a type not enumerated as having a status
 	// sub-resource will return a not found error.
 	StatusSubResourceTypes []client.Object
+	// NoStatusSubResourceTypes is a set of object types that do not support the status
+	// sub-resource. The status of these types is modified with the main resource. See
+	// ExpectConfig.NoStatusSubResourceTypes.
+	//
+	// +optional
+	NoStatusSubResourceTypes []client.Object
 	// GivenObjects build the kubernetes objects which are present at the onset of reconciliation
 	GivenObjects []client.Object
 	// APIGivenObjects contains objects that are only available via an API reader instead of the normal cache
 	APIGivenObjects []client.Object
+	// ShareGivenObjects skips copying given objects that are ready to be loaded into the fake
+	// client as is. Given objects must not be mutated while the test case runs. See
+	// ExpectConfig.ShareGivenObjects for details.
+	ShareGivenObjects bool
 	// GivenAPIResources populates the fake discovery client and RESTMapper
 	GivenAPIResources []*metav1.APIResourceList
+	// ServerVersion is the version reported by the fake discovery client. Defaults to an empty
+	// version.
+	ServerVersion *version.Info
+	// WithDiscoveryReactors installs each ReactionFunc into the fake discovery client. Discovery
+	// requests are made with the "get" verb for the "version", "group" and "resource" resources,
+	// a discovery failure is induced with:
+	//
+	//	rtesting.InduceFailure("get", "version")
+	WithDiscoveryReactors []ReactionFunc
 	// GivenTracks provide a set of tracked resources to seed the tracker with
 	GivenTracks []TrackRequest
+	// ReconcilerName is the name of the top-level reconciler available to the sub reconciler via
+	// reconcilers.RetrieveReconcilerName. Defaults to the name of the test case.
+	ReconcilerName string
 
 	// side effects
 
 	// ExpectResource is the expected reconciled resource as mutated after the sub reconciler, or nil if no modification
 	ExpectResource Type
+	// ExpectResourceMetadata is the expected labels, annotations and finalizers of the reconciled
+	// resource as persisted by the updates and patches made during reconciliation, rather than as
+	// mutated in memory. The metadata is not asserted when nil.
+	//
+	// +optional
+	ExpectResourceMetadata *ResourceMetadata
+	// ExpectStatusConditions is the expected set of conditions on the status of the reconciled
+	// resource as mutated after the sub reconciler. Conditions are matched by type regardless of
+	// order, and the LastTransitionTime is ignored. The conditions are not asserted when nil.
+	//
+	// +optional
+	ExpectStatusConditions []metav1.Condition
 	// ExpectStashedValues ensures each value is stashed. Values in the stash that are not expected are ignored. Factories are resolved to their object.
 	ExpectStashedValues map[stash.Key]interface{}
 	// VerifyStashedValue is an optional, custom verification function for stashed values
@@ -90,6 +151,18 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	ExpectTracks []TrackRequest
 	// ExpectEvents holds the ordered list of events recorded during the reconciliation
 	ExpectEvents []Event
+	// ExpectEventsMatch holds the ordered list of matchers for events recorded during the
+	// reconciliation, see ExpectConfig.ExpectEventsMatch
+	ExpectEventsMatch []EventMatcher
+	// ExpectEventsSource restricts the asserted events to those recorded by the named reconciler,
+	// see ExpectConfig.ExpectEventsSource
+	ExpectEventsSource string
+	// ExpectLogs holds matchers for the entries logged during reconciliation. Each matcher must
+	// match at least one logged entry, or none when Absent. Entries are logged at any verbosity
+	// and are not required to be matched.
+	//
+	// +optional
+	ExpectLogs []LogMatcher
 	// ExpectApplies builds the ordered list of objects expected to be applied during reconciliation
 	ExpectApplies []ApplyRef
 	// ExpectCreates builds the ordered list of objects expected to be created during reconciliation
@@ -98,10 +171,69 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	ExpectUpdates []client.Object
 	// ExpectPatches builds the ordered list of objects expected to be patched during reconciliation
 	ExpectPatches []PatchRef
+	// ExpectPatchResults builds the ordered list of objects expected to result from applying each
+	// patch during reconciliation to the stored resource. When ExpectPatches is not defined, the
+	// raw patches are not asserted.
+	ExpectPatchResults []client.Object
+	// ExpectScaleUpdates builds the ordered list of scale sub-resources updated during
+	// reconciliation. The scale sub-resource is modeled for built-in types (e.g. Deployment,
+	// StatefulSet, etc).
+	ExpectScaleUpdates []client.Object
+	// ExpectScalePatches builds the ordered list of objects whose scale sub-resource is patched
+	// during reconciliation
+	ExpectScalePatches []PatchRef
+	// ExpectSubResourceCreates builds the ordered list of objects created for a sub-resource other
+	// than status and scale during reconciliation, like a Pod's eviction
+	ExpectSubResourceCreates []SubResourceRef
+	// ExpectSubResourceUpdates builds the ordered list of objects updated for a sub-resource other
+	// than status and scale during reconciliation
+	ExpectSubResourceUpdates []SubResourceRef
+	// ExpectSubResourcePatches builds the ordered list of objects whose sub-resource, other than
+	// status and scale, is patched during reconciliation
+	ExpectSubResourcePatches []PatchRef
 	// ExpectDeletes holds the ordered list of objects expected to be deleted during reconciliation
 	ExpectDeletes []DeleteRef
 	// ExpectDeleteCollections holds the ordered list of collections expected to be deleted during reconciliation
 	ExpectDeleteCollections []DeleteCollectionRef
+	// ExpectLists holds the ordered list of list requests expected to be made with the client,
+	// see ExpectConfig.ExpectLists
+	//
+	// +optional
+	ExpectLists []ListRef
+	// ExpectFinalizers holds the finalizers expected on objects after reconciliation, see
+	// ExpectConfig.ExpectFinalizers
+	ExpectFinalizers []FinalizersRef
+	// ExpectActions holds the ordered timeline of mutating requests expected across all verbs,
+	// see ExpectConfig.ExpectActions
+	//
+	// +optional
+	ExpectActions []ActionRef
+	// ExpectDiscoveryRequests holds the ordered list of calls expected to the discovery client,
+	// see ExpectConfig.ExpectDiscoveryRequests
+	//
+	// +optional
+	ExpectDiscoveryRequests []DiscoveryRequest
+	// ExpectResources holds objects expected to be stored by the fake client after
+	// reconciliation, see ExpectConfig.ExpectResources
+	//
+	// +optional
+	ExpectResources []client.Object
+	// ExpectNoAPIReaderAccess asserts that no objects are read with the APIReader, see
+	// ExpectConfig.ExpectNoAPIReaderAccess
+	ExpectNoAPIReaderAccess bool
+	// ExpectAPIReaderReads is the exact number of reads expected against the APIReader, see
+	// ExpectConfig.ExpectAPIReaderReads
+	ExpectAPIReaderReads *int
+	// RestrictToNamespace asserts that each request made with the client or APIReader targets
+	// this namespace, see ExpectConfig.RestrictToNamespace
+	//
+	// +optional
+	RestrictToNamespace string
+	// ExpectReactorCalls is the exact number of times each named reactor from WithReactorsFor is
+	// expected to be called, see ExpectConfig.ExpectReactorCalls
+	//
+	// +optional
+	ExpectReactorCalls map[string]int
 
 	// AdditionalConfigs holds configs that are available to the test case and will have their
 	// expectations checked again the observed config interactions. The key in this map is set as
@@ -115,6 +247,10 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	// ShouldPanic is true if and only if reconciliation is expected to panic. A panic should only be
 	// used to indicate the reconciler is misconfigured.
 	ShouldPanic bool
+	// ShouldRecoverPanic is true if and only if reconciliation is expected to return a
+	// reconcilers.PanicError, as returned by a RecoverReconciler that recovered from a panic in the
+	// reconciler it wraps. ShouldErr is implied.
+	ShouldRecoverPanic bool
 	// ExpectedResult is compared to the result returned from the reconciler if there was no error
 	ExpectedResult reconcilers.Result
 	// Verify provides the reconciliation Result and error for custom assertions
@@ -135,6 +271,12 @@ type SubReconcilerTestCase[Type client.Object] struct {
 	Now time.Time
 	// Differ methods to use to compare expected and actual values. An empty string is returned for equivalent items.
 	Differ Differ
+	// DisableColorDiff prints diffs in assertion messages without color, regardless of the
+	// terminal and environment. See the package level DisableColorDiff.
+	DisableColorDiff bool
+	// ColorDiff prints diffs in assertion messages with color, regardless of the terminal.
+	// DisableColorDiff takes precedence.
+	ColorDiff bool
 
 	// AdditionalReconciles runs additional reconcile requests with the same reconciler instance.
 	// It should be used to test state that is stored on the reconciler. This is not common.
@@ -172,7 +314,9 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 		tc.Now = time.Now()
 	}
 	ctx = rtime.StashNow(ctx, tc.Now)
-	ctx = logr.NewContext(ctx, testr.New(t))
+	ctx = apis.WithConditionReasonValidation(ctx)
+	log, logs := newLogRecorder(t)
+	ctx = logr.NewContext(ctx, log)
 	if deadline, ok := t.Deadline(); ok {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithDeadline(ctx, deadline)
@@ -193,7 +337,7 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 				return
 			}
 			if diff := tc.Differ.StashedValue(expected, actual, key); diff != "" {
-				t.Errorf("ExpectStashedValues[%q] differs (%s, %s): %s", key, DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+				t.Errorf("ExpectStashedValues[%q] differs %s: %s", key, diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
 			}
 		}
 	}
@@ -223,24 +367,53 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 	}
 
 	expectConfig := &ExpectConfig{
-		Name:                    "default",
-		Scheme:                  scheme,
-		StatusSubResourceTypes:  tc.StatusSubResourceTypes,
-		Differ:                  tc.Differ,
-		GivenObjects:            append(tc.GivenObjects, givenResource),
-		APIGivenObjects:         append(tc.APIGivenObjects, givenResource),
-		WithClientBuilder:       tc.WithClientBuilder,
-		WithReactors:            tc.WithReactors,
-		GivenAPIResources:       tc.GivenAPIResources,
-		GivenTracks:             tc.GivenTracks,
-		ExpectTracks:            tc.ExpectTracks,
-		ExpectEvents:            tc.ExpectEvents,
-		ExpectApplies:           tc.ExpectApplies,
-		ExpectCreates:           tc.ExpectCreates,
-		ExpectUpdates:           tc.ExpectUpdates,
-		ExpectPatches:           tc.ExpectPatches,
-		ExpectDeletes:           tc.ExpectDeletes,
-		ExpectDeleteCollections: tc.ExpectDeleteCollections,
+		Name:                     "default",
+		Scheme:                   scheme,
+		StatusSubResourceTypes:   tc.StatusSubResourceTypes,
+		NoStatusSubResourceTypes: tc.NoStatusSubResourceTypes,
+		Differ:                   tc.Differ,
+		DisableColorDiff:         tc.DisableColorDiff,
+		ColorDiff:                tc.ColorDiff,
+		GivenObjects:             append(tc.GivenObjects, givenResource),
+		APIGivenObjects:          append(tc.APIGivenObjects, givenResource),
+		ShareGivenObjects:        tc.ShareGivenObjects,
+		Defaulter:                tc.Defaulter,
+		GivenFieldIndexes:        tc.GivenFieldIndexes,
+		WithClientBuilder:        tc.WithClientBuilder,
+		NameGenerator:            tc.NameGenerator,
+		UIDGenerator:             tc.UIDGenerator,
+		WithReactors:             tc.WithReactors,
+		WithReactorsFor:          tc.WithReactorsFor,
+		WithClientInterceptors:   tc.WithClientInterceptors,
+		GivenAPIResources:        tc.GivenAPIResources,
+		ServerVersion:            tc.ServerVersion,
+		WithDiscoveryReactors:    tc.WithDiscoveryReactors,
+		GivenTracks:              tc.GivenTracks,
+		ExpectTracks:             tc.ExpectTracks,
+		ExpectEvents:             tc.ExpectEvents,
+		ExpectEventsMatch:        tc.ExpectEventsMatch,
+		ExpectEventsSource:       tc.ExpectEventsSource,
+		ExpectApplies:            tc.ExpectApplies,
+		ExpectCreates:            tc.ExpectCreates,
+		ExpectUpdates:            tc.ExpectUpdates,
+		ExpectPatches:            tc.ExpectPatches,
+		ExpectPatchResults:       tc.ExpectPatchResults,
+		ExpectScaleUpdates:       tc.ExpectScaleUpdates,
+		ExpectScalePatches:       tc.ExpectScalePatches,
+		ExpectSubResourceCreates: tc.ExpectSubResourceCreates,
+		ExpectSubResourceUpdates: tc.ExpectSubResourceUpdates,
+		ExpectSubResourcePatches: tc.ExpectSubResourcePatches,
+		ExpectDeletes:            tc.ExpectDeletes,
+		ExpectDeleteCollections:  tc.ExpectDeleteCollections,
+		ExpectLists:              tc.ExpectLists,
+		ExpectFinalizers:         tc.ExpectFinalizers,
+		ExpectActions:            tc.ExpectActions,
+		ExpectDiscoveryRequests:  tc.ExpectDiscoveryRequests,
+		ExpectResources:          tc.ExpectResources,
+		ExpectNoAPIReaderAccess:  tc.ExpectNoAPIReaderAccess,
+		RestrictToNamespace:      tc.RestrictToNamespace,
+		ExpectReactorCalls:       tc.ExpectReactorCalls,
+		ExpectAPIReaderReads:     tc.ExpectAPIReaderReads,
 	}
 	c := expectConfig.Config()
 
@@ -257,6 +430,9 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 	ctx = reconcilers.StashOriginalConfig(ctx, c)
 
 	resource := tc.Resource.DeepCopyObject().(T)
+	if tc.Defaulter != nil {
+		tc.Defaulter(resource)
+	}
 	if resource.GetResourceVersion() == "" {
 		// this value is also set by the test client when resource are added as givens
 		resource.SetResourceVersion("999")
@@ -264,12 +440,23 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 	ctx = reconcilers.StashRequest(ctx, reconcilers.Request{
 		NamespacedName: types.NamespacedName{Namespace: resource.GetNamespace(), Name: resource.GetName()},
 	})
+	reconcilerName := tc.ReconcilerName
+	if reconcilerName == "" {
+		reconcilerName = tc.Name
+	}
+	ctx = reconcilers.StashReconcilerName(ctx, reconcilerName)
 	ctx = reconcilers.StashOriginalResourceType(ctx, resource.DeepCopyObject().(T))
 	ctx = reconcilers.StashResourceType(ctx, resource.DeepCopyObject().(T))
+	ctx = reconcilers.StashOriginalResource(ctx, resource.DeepCopyObject().(T))
 
+	// retain each additional config so the observed interactions are asserted
+	additionalConfigs := make(map[string]*ExpectConfig, len(tc.AdditionalConfigs))
 	configs := make(map[string]reconcilers.Config, len(tc.AdditionalConfigs))
 	for k, v := range tc.AdditionalConfigs {
 		v.Name = k
+		v.DisableColorDiff = v.DisableColorDiff || tc.DisableColorDiff
+		v.ColorDiff = v.ColorDiff || tc.ColorDiff
+		additionalConfigs[k] = &v
 		configs[k] = v.Config()
 	}
 	ctx = reconcilers.StashAdditionalConfigs(ctx, configs)
@@ -294,13 +481,16 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 		return r.Reconcile(ctx, resource)
 	}(ctx, resource)
 
-	if (err != nil) != tc.ShouldErr {
-		t.Errorf("Reconcile() error = %v, ShouldErr %v", err, tc.ShouldErr)
+	if (err != nil) != (tc.ShouldErr || tc.ShouldRecoverPanic) {
+		t.Errorf("Reconcile() error = %v, ShouldErr %v", err, tc.ShouldErr || tc.ShouldRecoverPanic)
+	}
+	if panicErr := (*reconcilers.PanicError)(nil); errors.As(err, &panicErr) != tc.ShouldRecoverPanic {
+		t.Errorf("Reconcile() error = %v, ShouldRecoverPanic %v", err, tc.ShouldRecoverPanic)
 	}
 	if err == nil {
 		// result is only significant if there wasn't an error
 		if diff := tc.Differ.Result(normalizeResult(tc.ExpectedResult), normalizeResult(result)); diff != "" {
-			t.Errorf("ExpectedResult differs (%s, %s): %s", DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+			t.Errorf("ExpectedResult differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
 		}
 	}
 
@@ -318,7 +508,25 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 		expectedResource.SetResourceVersion("999")
 	}
 	if diff := tc.Differ.Resource(expectedResource, resource); diff != "" {
-		t.Errorf("ExpectResource differs (%s, %s): %s", DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+		t.Errorf("ExpectResource differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
+	}
+
+	// compare persisted resource metadata
+	if tc.ExpectResourceMetadata != nil {
+		if actual, err := expectConfig.observedResourceMetadata(tc.Resource); err != nil {
+			t.Errorf("ExpectResourceMetadata unable to get the reconciled resource: %s", err)
+		} else if diff := ExtendDiffer(tc.Differ).ResourceMetadata(*tc.ExpectResourceMetadata, actual); diff != "" {
+			t.Errorf("ExpectResourceMetadata differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
+		}
+	}
+
+	// compare status conditions
+	if tc.ExpectStatusConditions != nil {
+		if actual, err := statusConditions(resource); err != nil {
+			t.Errorf("ExpectStatusConditions unable to get the conditions of the reconciled resource: %s", err)
+		} else if diff := ExtendDiffer(tc.Differ).StatusConditions(tc.ExpectStatusConditions, actual); diff != "" {
+			t.Errorf("ExpectStatusConditions differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
+		}
 	}
 
 	// compare stashed
@@ -331,9 +539,10 @@ func (tc *SubReconcilerTestCase[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 	}
 
 	expectConfig.AssertExpectations(t)
-	for _, config := range tc.AdditionalConfigs {
+	for _, config := range additionalConfigs {
 		config.AssertExpectations(t)
 	}
+	logs.AssertExpectations(t, tc.ExpectLogs)
 
 	for _, ar := range tc.AdditionalReconciles {
 		if ar.Skip {
@@ -379,3 +588,27 @@ func (ts SubReconcilerTestSuite[T]) Run(t *testing.T, scheme *runtime.Scheme, fa
 // ActionRecorderList/EventList to capture k8s actions/events produced during reconciliation
 // and FakeStatsReporter to capture stats.
 type SubReconcilerFactory[Type client.Object] func(t *testing.T, rtc *SubReconcilerTestCase[Type], c reconcilers.Config) reconcilers.SubReconciler[Type]
+
+// statusConditions returns the conditions on the status of the resource, for both structured and
+// unstructured resources.
+func statusConditions(resource client.Object) ([]metav1.Condition, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := unstructured.NestedSlice(u, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+	conditions := make([]metav1.Condition, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("condition %d is not an object", i)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &conditions[i]); err != nil {
+			return nil, err
+		}
+	}
+	return conditions, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/internal/resources"
+	"reconciler.io/runtime/internal/resources/dies"
+	"reconciler.io/runtime/reconcilers"
+)
+
+func TestSubReconcilerTestCase_ExpectStatusConditions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-resource")
+		})
+
+	rtc := &SubReconcilerTestCase[*resources.TestResource]{
+		Resource: resource.DieReleasePtr(),
+		ExpectResource: resource.
+			StatusDie(func(d *dies.TestResourceStatusDie) {
+				d.ConditionsDie(
+					diemetav1.ConditionBlank.Type("Ready").Status(metav1.ConditionTrue).Reason("Ready"),
+					diemetav1.ConditionBlank.Type("Deployed").Status(metav1.ConditionFalse).Reason("Pending").Message("waiting"),
+				)
+			}).
+			DieReleasePtr(),
+		// conditions are matched regardless of order
+		ExpectStatusConditions: []metav1.Condition{
+			{Type: "Deployed", Status: metav1.ConditionFalse, Reason: "Pending", Message: "waiting"},
+			{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+		},
+	}
+	rtc.Run(t, scheme, func(t *testing.T, rtc *SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		return &reconcilers.SyncReconciler[*resources.TestResource]{
+			Sync: func(ctx context.Context, resource *resources.TestResource) error {
+				resource.Status.Conditions = []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: metav1.Now()},
+					{Type: "Deployed", Status: metav1.ConditionFalse, Reason: "Pending", Message: "waiting", LastTransitionTime: metav1.Now()},
+				}
+				return nil
+			},
+		}
+	})
+}
+
+func TestSubReconcilerTestCase_ExpectEventsSource(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = resources.AddToScheme(scheme)
+
+	resource := dies.TestResourceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-resource")
+		})
+
+	rtc := &SubReconcilerTestCase[*resources.TestResource]{
+		Resource:           resource.DieReleasePtr(),
+		ExpectEventsSource: "Second",
+		ExpectEvents: []Event{
+			NewEvent(resource, scheme, corev1.EventTypeNormal, "Synced", "second"),
+		},
+	}
+	rtc.Run(t, scheme, func(t *testing.T, rtc *SubReconcilerTestCase[*resources.TestResource], c reconcilers.Config) reconcilers.SubReconciler[*resources.TestResource] {
+		sync := func(name string) reconcilers.SubReconciler[*resources.TestResource] {
+			return &reconcilers.SyncReconciler[*resources.TestResource]{
+				Name: name,
+				Sync: func(ctx context.Context, resource *resources.TestResource) error {
+					c := reconcilers.RetrieveConfigOrDie(ctx)
+					c.RecorderFor(ctx).Event(resource, corev1.EventTypeNormal, "Synced", strings.ToLower(name))
+					return nil
+				},
+			}
+		}
+		return reconcilers.Sequence[*resources.TestResource]{
+			sync("First"),
+			sync("Second"),
+		}
+	})
+}
+
+func TestStatusConditions(t *testing.T) {
+	tests := map[string]struct {
+		resource *unstructured.Unstructured
+		expected []metav1.Condition
+	}{
+		"no status": {
+			resource: &unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			expected: []metav1.Condition{},
+		},
+		"conditions": {
+			resource: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"status": map[string]interface{}{
+						"conditions": []interface{}{
+							map[string]interface{}{
+								"type":               "Ready",
+								"status":             "True",
+								"reason":             "Ready",
+								"lastTransitionTime": "2026-01-01T00:00:00Z",
+							},
+						},
+					},
+				},
+			},
+			expected: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := statusConditions(tc.resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := ExtendDiffer(DefaultDiffer).StatusConditions(tc.expected, actual); diff != "" {
+				t.Errorf("unexpected conditions (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -75,17 +76,20 @@ func CreateTrackRequest(trackedObjGroup, trackedObjKind, trackedObjNamespace, tr
 	}
 }
 
+// NewTrackRequest creates a TrackRequest for the tracked object by the tracking object. The group
+// and kind of the tracked object are resolved from the scheme.
 func NewTrackRequest(t, b client.Object, scheme *runtime.Scheme) TrackRequest {
-	tracked, by := t.DeepCopyObject().(client.Object), b.DeepCopyObject().(client.Object)
+	by := b.DeepCopyObject().(client.Object)
 
-	gvk := tracked.GetObjectKind().GroupVersionKind()
-	if !duck.IsDuck(tracked, scheme) {
-		gvks, _, err := scheme.ObjectKinds(tracked)
-		if err != nil {
-			panic(err)
-		}
-		gvk = gvks[0]
-	}
+	return NewTrackRequestBy(t, types.NamespacedName{Namespace: by.GetNamespace(), Name: by.GetName()}, scheme)
+}
+
+// NewTrackRequestBy creates a TrackRequest for the tracked object by the tracking object's
+// namespace and name. This is useful when the tracking object is not otherwise defined by the test.
+// The group and kind of the tracked object are resolved from the scheme.
+func NewTrackRequestBy(t client.Object, by types.NamespacedName, scheme *runtime.Scheme) TrackRequest {
+	tracked := t.DeepCopyObject().(client.Object)
+	gvk := trackedGroupVersionKind(tracked, scheme)
 
 	return TrackRequest{
 		TrackedReference: tracker.Reference{
@@ -94,10 +98,40 @@ func NewTrackRequest(t, b client.Object, scheme *runtime.Scheme) TrackRequest {
 			Namespace: tracked.GetNamespace(),
 			Name:      tracked.GetName(),
 		},
+		Tracker: by,
+	}
+}
+
+// NewSelectorTrackRequest creates a TrackRequest for every object of the tracked type whose labels
+// match the selector. The namespace of the tracked object scopes the request, an empty namespace
+// tracks objects in all namespaces. Only the type and namespace of the tracked object are used.
+func NewSelectorTrackRequest(t client.Object, selector labels.Selector, b client.Object, scheme *runtime.Scheme) TrackRequest {
+	tracked, by := t.DeepCopyObject().(client.Object), b.DeepCopyObject().(client.Object)
+	gvk := trackedGroupVersionKind(tracked, scheme)
+
+	return TrackRequest{
+		TrackedReference: tracker.Reference{
+			APIGroup:  gvk.Group,
+			Kind:      gvk.Kind,
+			Namespace: tracked.GetNamespace(),
+			Selector:  selector,
+		},
 		Tracker: types.NamespacedName{Namespace: by.GetNamespace(), Name: by.GetName()},
 	}
 }
 
+func trackedGroupVersionKind(tracked client.Object, scheme *runtime.Scheme) schema.GroupVersionKind {
+	gvk := tracked.GetObjectKind().GroupVersionKind()
+	if !duck.IsDuck(tracked, scheme) {
+		gvks, _, err := scheme.ObjectKinds(tracked)
+		if err != nil {
+			panic(err)
+		}
+		gvk = gvks[0]
+	}
+	return gvk
+}
+
 func createTracker(given []TrackRequest, scheme *runtime.Scheme) *mockTracker {
 	t := &mockTracker{
 		Tracker: tracker.New(scheme, 24*time.Hour),
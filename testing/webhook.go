@@ -24,15 +24,18 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/go-logr/logr/testr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/stash"
 	rtime "reconciler.io/runtime/time"
 	"reconciler.io/runtime/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -54,11 +57,33 @@ type AdmissionWebhookTestCase struct {
 	Request *admission.Request
 	// HTTPRequest is the http request used to create the admission request object. If not defined, a minimal request is provided.
 	HTTPRequest *http.Request
+	// Defaulter simulates a defaulting admission webhook for given and created objects. See
+	// ExpectConfig.Defaulter for details.
+	Defaulter func(obj client.Object)
+	// GivenFieldIndexes registers each index with the fake client, enabling the reconciler to list
+	// resources with a field selector for an indexed field.
+	//
+	// +optional
+	GivenFieldIndexes []FieldIndex
 	// WithClientBuilder allows a test to modify the fake client initialization.
 	WithClientBuilder func(*fake.ClientBuilder) *fake.ClientBuilder
+	// NameGenerator returns the name for a created object that defines a generateName without a
+	// name. See ExpectConfig.NameGenerator for details.
+	NameGenerator func(obj client.Object) string
+	// UIDGenerator returns the UID for a created object that does not define a UID. See
+	// ExpectConfig.UIDGenerator for details.
+	UIDGenerator func(obj client.Object) types.UID
 	// WithReactors installs each ReactionFunc into each fake clientset. ReactionFuncs intercept
 	// each call to the clientset providing the ability to mutate the resource or inject an error.
 	WithReactors []ReactionFunc
+	// WithReactorsFor installs each ScopedReactor into each fake clientset. The ReactionFunc is
+	// only called for requests matching the reactor's verb and resource. Reactors from
+	// WithReactors are called before reactors from WithReactorsFor.
+	WithReactorsFor []ScopedReactor
+	// WithClientInterceptors wraps the client with each interceptor. Interceptors are called before
+	// requests are recorded and before reactors. See ExpectConfig.WithClientInterceptors for
+	// details.
+	WithClientInterceptors []interceptor.Funcs
 	// StatusSubResourceTypes is a set of object types that support the status sub-resource. For
 	// these types, the only way to modify the resource's status is update or patch the status
 	// sub-resource. Patching or updating the main resource will not mutated the status field.
@@ -68,12 +93,31 @@ type AdmissionWebhookTestCase struct {
 	// Interacting with a status sub-resource for a type not enumerated as having a status
 	// sub-resource will return a not found error.
 	StatusSubResourceTypes []client.Object
+	// NoStatusSubResourceTypes is a set of object types that do not support the status
+	// sub-resource. The status of these types is modified with the main resource. See
+	// ExpectConfig.NoStatusSubResourceTypes.
+	//
+	// +optional
+	NoStatusSubResourceTypes []client.Object
 	// GivenObjects build the kubernetes objects which are present at the onset of reconciliation
 	GivenObjects []client.Object
 	// APIGivenObjects contains objects that are only available via an API reader instead of the normal cache
 	APIGivenObjects []client.Object
+	// ShareGivenObjects skips copying given objects that are ready to be loaded into the fake
+	// client as is. Given objects must not be mutated while the test case runs. See
+	// ExpectConfig.ShareGivenObjects for details.
+	ShareGivenObjects bool
 	// GivenAPIResources populates the fake discovery client and RESTMapper
 	GivenAPIResources []*metav1.APIResourceList
+	// ServerVersion is the version reported by the fake discovery client. Defaults to an empty
+	// version.
+	ServerVersion *version.Info
+	// WithDiscoveryReactors installs each ReactionFunc into the fake discovery client. Discovery
+	// requests are made with the "get" verb for the "version", "group" and "resource" resources,
+	// a discovery failure is induced with:
+	//
+	//	rtesting.InduceFailure("get", "version")
+	WithDiscoveryReactors []ReactionFunc
 	// GivenTracks provide a set of tracked resources to seed the tracker with
 	GivenTracks []TrackRequest
 
@@ -83,6 +127,18 @@ type AdmissionWebhookTestCase struct {
 	ExpectTracks []TrackRequest
 	// ExpectEvents holds the ordered list of events recorded during the reconciliation
 	ExpectEvents []Event
+	// ExpectEventsMatch holds the ordered list of matchers for events recorded during the
+	// reconciliation, see ExpectConfig.ExpectEventsMatch
+	ExpectEventsMatch []EventMatcher
+	// ExpectEventsSource restricts the asserted events to those recorded by the named reconciler,
+	// see ExpectConfig.ExpectEventsSource
+	ExpectEventsSource string
+	// ExpectLogs holds matchers for the entries logged during the request. Each matcher must
+	// match at least one logged entry, or none when Absent. Entries are logged at any verbosity
+	// and are not required to be matched.
+	//
+	// +optional
+	ExpectLogs []LogMatcher
 	// ExpectApplies builds the ordered list of objects expected to be applied during reconciliation
 	ExpectApplies []ApplyRef
 	// ExpectCreates builds the ordered list of objects expected to be created during reconciliation
@@ -91,16 +147,79 @@ type AdmissionWebhookTestCase struct {
 	ExpectUpdates []client.Object
 	// ExpectPatches builds the ordered list of objects expected to be patched during reconciliation
 	ExpectPatches []PatchRef
+	// ExpectPatchResults builds the ordered list of objects expected to result from applying each
+	// patch during reconciliation to the stored resource. When ExpectPatches is not defined, the
+	// raw patches are not asserted.
+	ExpectPatchResults []client.Object
 	// ExpectDeletes holds the ordered list of objects expected to be deleted during reconciliation
 	ExpectDeletes []DeleteRef
 	// ExpectDeleteCollections holds the ordered list of collections expected to be deleted during reconciliation
 	ExpectDeleteCollections []DeleteCollectionRef
+	// ExpectLists holds the ordered list of list requests expected to be made with the client,
+	// see ExpectConfig.ExpectLists
+	//
+	// +optional
+	ExpectLists []ListRef
 	// ExpectStatusUpdates builds the ordered list of objects whose status is updated during reconciliation
 	ExpectStatusUpdates []client.Object
 	// ExpectStatusPatches builds the ordered list of objects whose status is patched during reconciliation
 	ExpectStatusPatches []PatchRef
+	// ExpectStatusPatchResults builds the ordered list of objects expected to result from applying
+	// each status patch during reconciliation to the stored resource. When ExpectStatusPatches is
+	// not defined, the raw status patches are not asserted.
+	ExpectStatusPatchResults []client.Object
+	// ExpectScaleUpdates builds the ordered list of scale sub-resources updated during
+	// reconciliation. The scale sub-resource is modeled for built-in types (e.g. Deployment,
+	// StatefulSet, etc).
+	ExpectScaleUpdates []client.Object
+	// ExpectScalePatches builds the ordered list of objects whose scale sub-resource is patched
+	// during reconciliation
+	ExpectScalePatches []PatchRef
+	// ExpectSubResourceCreates builds the ordered list of objects created for a sub-resource other
+	// than status and scale during reconciliation, like a Pod's eviction
+	ExpectSubResourceCreates []SubResourceRef
+	// ExpectSubResourceUpdates builds the ordered list of objects updated for a sub-resource other
+	// than status and scale during reconciliation
+	ExpectSubResourceUpdates []SubResourceRef
+	// ExpectSubResourcePatches builds the ordered list of objects whose sub-resource, other than
+	// status and scale, is patched during reconciliation
+	ExpectSubResourcePatches []PatchRef
 	// ExpectStatusApplies builds the ordered list of objects whose status is applied during reconciliation
 	ExpectStatusApplies []ApplyRef
+	// ExpectFinalizers holds the finalizers expected on objects after reconciliation, see
+	// ExpectConfig.ExpectFinalizers
+	ExpectFinalizers []FinalizersRef
+	// ExpectActions holds the ordered timeline of mutating requests expected across all verbs,
+	// see ExpectConfig.ExpectActions
+	//
+	// +optional
+	ExpectActions []ActionRef
+	// ExpectDiscoveryRequests holds the ordered list of calls expected to the discovery client,
+	// see ExpectConfig.ExpectDiscoveryRequests
+	//
+	// +optional
+	ExpectDiscoveryRequests []DiscoveryRequest
+	// ExpectResources holds objects expected to be stored by the fake client after
+	// reconciliation, see ExpectConfig.ExpectResources
+	//
+	// +optional
+	ExpectResources []client.Object
+	// ExpectNoAPIReaderAccess asserts that no objects are read with the APIReader, see
+	// ExpectConfig.ExpectNoAPIReaderAccess
+	ExpectNoAPIReaderAccess bool
+	// ExpectAPIReaderReads is the exact number of reads expected against the APIReader, see
+	// ExpectConfig.ExpectAPIReaderReads
+	ExpectAPIReaderReads *int
+	// RestrictToNamespace asserts that each request made with the client or APIReader targets
+	// this namespace, see ExpectConfig.RestrictToNamespace
+	//
+	// +optional
+	RestrictToNamespace string
+	// ExpectReactorCalls is the exact number of times each named reactor from WithReactorsFor is
+	// expected to be called, see ExpectConfig.ExpectReactorCalls
+	//
+	// +optional
+	ExpectReactorCalls map[string]int
 
 	// outputs
 
@@ -125,6 +244,12 @@ type AdmissionWebhookTestCase struct {
 	Now time.Time
 	// Differ methods to use to compare expected and actual values. An empty string is returned for equivalent items.
 	Differ Differ
+	// DisableColorDiff prints diffs in assertion messages without color, regardless of the
+	// terminal and environment. See the package level DisableColorDiff.
+	DisableColorDiff bool
+	// ColorDiff prints diffs in assertion messages with color, regardless of the terminal.
+	// DisableColorDiff takes precedence.
+	ColorDiff bool
 }
 
 // AdmissionWebhookTests represents a map of reconciler test cases. The map key is the name of each
@@ -177,7 +302,9 @@ func (tc *AdmissionWebhookTestCase) RunWithContext(t *testing.T, scheme *runtime
 		tc.Now = time.Now()
 	}
 	ctx = rtime.StashNow(ctx, tc.Now)
-	ctx = logr.NewContext(ctx, testr.New(t))
+	ctx = apis.WithConditionReasonValidation(ctx)
+	log, logs := newLogRecorder(t)
+	ctx = logr.NewContext(ctx, log)
 	if deadline, ok := t.Deadline(); ok {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithDeadline(ctx, deadline)
@@ -207,27 +334,57 @@ func (tc *AdmissionWebhookTestCase) RunWithContext(t *testing.T, scheme *runtime
 	}
 
 	expectConfig := &ExpectConfig{
-		Name:                    "default",
-		Scheme:                  scheme,
-		StatusSubResourceTypes:  tc.StatusSubResourceTypes,
-		Differ:                  tc.Differ,
-		GivenObjects:            tc.GivenObjects,
-		APIGivenObjects:         tc.APIGivenObjects,
-		WithClientBuilder:       tc.WithClientBuilder,
-		WithReactors:            tc.WithReactors,
-		GivenAPIResources:       tc.GivenAPIResources,
-		GivenTracks:             tc.GivenTracks,
-		ExpectTracks:            tc.ExpectTracks,
-		ExpectEvents:            tc.ExpectEvents,
-		ExpectApplies:           tc.ExpectApplies,
-		ExpectCreates:           tc.ExpectCreates,
-		ExpectUpdates:           tc.ExpectUpdates,
-		ExpectPatches:           tc.ExpectPatches,
-		ExpectDeletes:           tc.ExpectDeletes,
-		ExpectDeleteCollections: tc.ExpectDeleteCollections,
-		ExpectStatusUpdates:     tc.ExpectStatusUpdates,
-		ExpectStatusPatches:     tc.ExpectStatusPatches,
-		ExpectStatusApplies:     tc.ExpectStatusApplies,
+		Name:                     "default",
+		Scheme:                   scheme,
+		StatusSubResourceTypes:   tc.StatusSubResourceTypes,
+		NoStatusSubResourceTypes: tc.NoStatusSubResourceTypes,
+		Differ:                   tc.Differ,
+		DisableColorDiff:         tc.DisableColorDiff,
+		ColorDiff:                tc.ColorDiff,
+		GivenObjects:             tc.GivenObjects,
+		APIGivenObjects:          tc.APIGivenObjects,
+		ShareGivenObjects:        tc.ShareGivenObjects,
+		Defaulter:                tc.Defaulter,
+		GivenFieldIndexes:        tc.GivenFieldIndexes,
+		WithClientBuilder:        tc.WithClientBuilder,
+		NameGenerator:            tc.NameGenerator,
+		UIDGenerator:             tc.UIDGenerator,
+		WithReactors:             tc.WithReactors,
+		WithReactorsFor:          tc.WithReactorsFor,
+		WithClientInterceptors:   tc.WithClientInterceptors,
+		GivenAPIResources:        tc.GivenAPIResources,
+		ServerVersion:            tc.ServerVersion,
+		WithDiscoveryReactors:    tc.WithDiscoveryReactors,
+		GivenTracks:              tc.GivenTracks,
+		ExpectTracks:             tc.ExpectTracks,
+		ExpectEvents:             tc.ExpectEvents,
+		ExpectEventsMatch:        tc.ExpectEventsMatch,
+		ExpectEventsSource:       tc.ExpectEventsSource,
+		ExpectApplies:            tc.ExpectApplies,
+		ExpectCreates:            tc.ExpectCreates,
+		ExpectUpdates:            tc.ExpectUpdates,
+		ExpectPatches:            tc.ExpectPatches,
+		ExpectPatchResults:       tc.ExpectPatchResults,
+		ExpectDeletes:            tc.ExpectDeletes,
+		ExpectDeleteCollections:  tc.ExpectDeleteCollections,
+		ExpectLists:              tc.ExpectLists,
+		ExpectStatusUpdates:      tc.ExpectStatusUpdates,
+		ExpectStatusPatches:      tc.ExpectStatusPatches,
+		ExpectStatusPatchResults: tc.ExpectStatusPatchResults,
+		ExpectScaleUpdates:       tc.ExpectScaleUpdates,
+		ExpectScalePatches:       tc.ExpectScalePatches,
+		ExpectSubResourceCreates: tc.ExpectSubResourceCreates,
+		ExpectSubResourceUpdates: tc.ExpectSubResourceUpdates,
+		ExpectSubResourcePatches: tc.ExpectSubResourcePatches,
+		ExpectStatusApplies:      tc.ExpectStatusApplies,
+		ExpectFinalizers:         tc.ExpectFinalizers,
+		ExpectActions:            tc.ExpectActions,
+		ExpectDiscoveryRequests:  tc.ExpectDiscoveryRequests,
+		ExpectResources:          tc.ExpectResources,
+		ExpectNoAPIReaderAccess:  tc.ExpectNoAPIReaderAccess,
+		RestrictToNamespace:      tc.RestrictToNamespace,
+		ExpectReactorCalls:       tc.ExpectReactorCalls,
+		ExpectAPIReaderReads:     tc.ExpectAPIReaderReads,
 	}
 
 	c := expectConfig.Config()
@@ -268,10 +425,11 @@ func (tc *AdmissionWebhookTestCase) RunWithContext(t *testing.T, scheme *runtime
 
 	tc.ExpectedResponse.Complete(*tc.Request)
 	if diff := tc.Differ.WebhookResponse(tc.ExpectedResponse, response); diff != "" {
-		t.Errorf("ExpectedResponse differs (%s, %s): %s", DiffRemovedColor.Sprint("-expected"), DiffAddedColor.Sprint("+actual"), ColorizeDiff(diff))
+		t.Errorf("ExpectedResponse differs %s: %s", diffLegend(tc.DisableColorDiff, tc.ColorDiff), colorizeDiff(diff, tc.DisableColorDiff, tc.ColorDiff))
 	}
 
 	expectConfig.AssertExpectations(t)
+	logs.AssertExpectations(t, tc.ExpectLogs)
 }
 
 // Deprecated use RunWithContext instead